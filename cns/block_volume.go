@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cnstypes "github.com/vmware/govmomi/cns/types"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/task"
+	vimtypes "github.com/vmware/govmomi/vim25/types"
+)
+
+// BlockVolumeOptions are the options used by Client.CreateBlockVolume.
+type BlockVolumeOptions struct {
+	Name            string                            // Name of the volume
+	CapacityInMb    int64                             // CapacityInMb is the size of the volume, must be greater than 0
+	Datastores      []vimtypes.ManagedObjectReference // Datastores on which the volume may be placed, at least one is required
+	StoragePolicyID string                            // StoragePolicyID is the optional storage policy (SPBM profile) ID
+	Metadata        cnstypes.CnsVolumeMetadata        // Metadata such as the ContainerCluster, optional
+}
+
+// CreateBlockVolume creates a block volume as specified by opts and waits for the CNS task to complete,
+// returning the ID of the new volume.
+func (c *Client) CreateBlockVolume(ctx context.Context, opts BlockVolumeOptions) (string, error) {
+	if opts.CapacityInMb <= 0 {
+		return "", fmt.Errorf("invalid volume capacity: %d MB", opts.CapacityInMb)
+	}
+	if len(opts.Datastores) == 0 {
+		return "", errors.New("at least one datastore is required")
+	}
+
+	spec := cnstypes.CnsVolumeCreateSpec{
+		Name:       opts.Name,
+		VolumeType: string(cnstypes.CnsVolumeTypeBlock),
+		Datastores: opts.Datastores,
+		Metadata:   opts.Metadata,
+		BackingObjectDetails: &cnstypes.CnsBlockBackingDetails{
+			CnsBackingObjectDetails: cnstypes.CnsBackingObjectDetails{
+				CapacityInMb: opts.CapacityInMb,
+			},
+		},
+	}
+
+	if opts.StoragePolicyID != "" {
+		spec.Profile = []vimtypes.BaseVirtualMachineProfileSpec{
+			&vimtypes.VirtualMachineDefinedProfileSpec{
+				ProfileId: opts.StoragePolicyID,
+			},
+		}
+	}
+
+	task, err := c.CreateVolume(ctx, []cnstypes.CnsVolumeCreateSpec{spec})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.waitVolumeOperation(ctx, task)
+	if err != nil {
+		return "", err
+	}
+
+	return res.VolumeId.Id, nil
+}
+
+// DeleteBlockVolume deletes the volume with the given ID and waits for the CNS task to complete.
+// If deleteDisk is true, the volume's backing disk is deleted as well.
+func (c *Client) DeleteBlockVolume(ctx context.Context, id string, deleteDisk bool) error {
+	task, err := c.DeleteVolume(ctx, []cnstypes.CnsVolumeId{{Id: id}}, deleteDisk)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.waitVolumeOperation(ctx, task)
+	return err
+}
+
+// waitVolumeOperation waits for the given CNS task and returns its volume operation result,
+// converting a fault in the result to an error.
+func (c *Client) waitVolumeOperation(ctx context.Context, t *object.Task) (*cnstypes.CnsVolumeOperationResult, error) {
+	info, err := GetTaskInfo(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := GetTaskResult(ctx, info)
+	if err != nil {
+		return nil, err
+	}
+
+	res := result.GetCnsVolumeOperationResult()
+	if res.Fault != nil {
+		return nil, task.Error{LocalizedMethodFault: res.Fault}
+	}
+
+	return res, nil
+}
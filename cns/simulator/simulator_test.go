@@ -585,3 +585,78 @@ func TestSimulator(t *testing.T) {
 	}
 
 }
+
+func TestBlockVolume(t *testing.T) {
+	ctx := context.Background()
+
+	model := simulator.VPX()
+	defer model.Remove()
+
+	if err := model.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	s := model.Service.NewServer()
+	defer s.Close()
+
+	model.Service.RegisterSDK(New())
+
+	c, err := govmomi.NewClient(ctx, s.URL, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cnsClient, err := cns.NewClient(ctx, c.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	datastore := simulator.Map.Any("Datastore").(*simulator.Datastore)
+
+	opts := cns.BlockVolumeOptions{
+		Name:            "test-block",
+		StoragePolicyID: uuid.New().String(),
+	}
+
+	if _, err = cnsClient.CreateBlockVolume(ctx, opts); err == nil {
+		t.Error("expected error with zero capacity")
+	}
+
+	opts.CapacityInMb = 1024
+	if _, err = cnsClient.CreateBlockVolume(ctx, opts); err == nil {
+		t.Error("expected error with no datastores")
+	}
+
+	opts.Datastores = []vim25types.ManagedObjectReference{datastore.Self}
+	id, err := cnsClient.CreateBlockVolume(ctx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id == "" {
+		t.Fatal("empty volume ID")
+	}
+
+	res, err := cnsClient.QueryVolume(ctx, cnstypes.CnsQueryFilter{
+		VolumeIds: []cnstypes.CnsVolumeId{{Id: id}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Volumes) != 1 {
+		t.Fatalf("expected 1 volume, got %d", len(res.Volumes))
+	}
+
+	if err = cnsClient.DeleteBlockVolume(ctx, id, true); err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = cnsClient.QueryVolume(ctx, cnstypes.CnsQueryFilter{
+		VolumeIds: []cnstypes.CnsVolumeId{{Id: id}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Volumes) != 0 {
+		t.Errorf("expected 0 volumes, got %d", len(res.Volumes))
+	}
+}
@@ -17,7 +17,9 @@ limitations under the License.
 package types
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -29,6 +31,22 @@ const (
 	AgencyVMPlacementPolicyVMAntiAffinitySoft = AgencyVMPlacementPolicyVMAntiAffinity("soft")
 )
 
+func (e AgencyVMPlacementPolicyVMAntiAffinity) String() string {
+	return string(e)
+}
+
+func ParseAgencyVMPlacementPolicyVMAntiAffinity(s string) (AgencyVMPlacementPolicyVMAntiAffinity, error) {
+	for _, e := range []AgencyVMPlacementPolicyVMAntiAffinity{
+		AgencyVMPlacementPolicyVMAntiAffinityNone,
+		AgencyVMPlacementPolicyVMAntiAffinitySoft,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AgencyVMPlacementPolicyVMAntiAffinity: %q", s)
+}
+
 func init() {
 	types.Add("eam:AgencyVMPlacementPolicyVMAntiAffinity", reflect.TypeOf((*AgencyVMPlacementPolicyVMAntiAffinity)(nil)).Elem())
 }
@@ -40,6 +58,22 @@ const (
 	AgencyVMPlacementPolicyVMDataAffinitySoft = AgencyVMPlacementPolicyVMDataAffinity("soft")
 )
 
+func (e AgencyVMPlacementPolicyVMDataAffinity) String() string {
+	return string(e)
+}
+
+func ParseAgencyVMPlacementPolicyVMDataAffinity(s string) (AgencyVMPlacementPolicyVMDataAffinity, error) {
+	for _, e := range []AgencyVMPlacementPolicyVMDataAffinity{
+		AgencyVMPlacementPolicyVMDataAffinityNone,
+		AgencyVMPlacementPolicyVMDataAffinitySoft,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AgencyVMPlacementPolicyVMDataAffinity: %q", s)
+}
+
 func init() {
 	types.Add("eam:AgencyVMPlacementPolicyVMDataAffinity", reflect.TypeOf((*AgencyVMPlacementPolicyVMDataAffinity)(nil)).Elem())
 }
@@ -52,6 +86,23 @@ const (
 	AgentConfigInfoOvfDiskProvisioningThick = AgentConfigInfoOvfDiskProvisioning("thick")
 )
 
+func (e AgentConfigInfoOvfDiskProvisioning) String() string {
+	return string(e)
+}
+
+func ParseAgentConfigInfoOvfDiskProvisioning(s string) (AgentConfigInfoOvfDiskProvisioning, error) {
+	for _, e := range []AgentConfigInfoOvfDiskProvisioning{
+		AgentConfigInfoOvfDiskProvisioningNone,
+		AgentConfigInfoOvfDiskProvisioningThin,
+		AgentConfigInfoOvfDiskProvisioningThick,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AgentConfigInfoOvfDiskProvisioning: %q", s)
+}
+
 func init() {
 	types.Add("eam:AgentConfigInfoOvfDiskProvisioning", reflect.TypeOf((*AgentConfigInfoOvfDiskProvisioning)(nil)).Elem())
 }
@@ -64,6 +115,23 @@ const (
 	AgentVmHookVmStatePrePowerOn  = AgentVmHookVmState("prePowerOn")
 )
 
+func (e AgentVmHookVmState) String() string {
+	return string(e)
+}
+
+func ParseAgentVmHookVmState(s string) (AgentVmHookVmState, error) {
+	for _, e := range []AgentVmHookVmState{
+		AgentVmHookVmStateProvisioned,
+		AgentVmHookVmStatePoweredOn,
+		AgentVmHookVmStatePrePowerOn,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AgentVmHookVmState: %q", s)
+}
+
 func init() {
 	types.Add("eam:AgentVmHookVmState", reflect.TypeOf((*AgentVmHookVmState)(nil)).Elem())
 }
@@ -76,6 +144,23 @@ const (
 	EamObjectRuntimeInfoGoalStateUninstalled = EamObjectRuntimeInfoGoalState("uninstalled")
 )
 
+func (e EamObjectRuntimeInfoGoalState) String() string {
+	return string(e)
+}
+
+func ParseEamObjectRuntimeInfoGoalState(s string) (EamObjectRuntimeInfoGoalState, error) {
+	for _, e := range []EamObjectRuntimeInfoGoalState{
+		EamObjectRuntimeInfoGoalStateEnabled,
+		EamObjectRuntimeInfoGoalStateDisabled,
+		EamObjectRuntimeInfoGoalStateUninstalled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EamObjectRuntimeInfoGoalState: %q", s)
+}
+
 func init() {
 	types.Add("eam:EamObjectRuntimeInfoGoalState", reflect.TypeOf((*EamObjectRuntimeInfoGoalState)(nil)).Elem())
 }
@@ -88,6 +173,23 @@ const (
 	EamObjectRuntimeInfoStatusRed    = EamObjectRuntimeInfoStatus("red")
 )
 
+func (e EamObjectRuntimeInfoStatus) String() string {
+	return string(e)
+}
+
+func ParseEamObjectRuntimeInfoStatus(s string) (EamObjectRuntimeInfoStatus, error) {
+	for _, e := range []EamObjectRuntimeInfoStatus{
+		EamObjectRuntimeInfoStatusGreen,
+		EamObjectRuntimeInfoStatusYellow,
+		EamObjectRuntimeInfoStatusRed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EamObjectRuntimeInfoStatus: %q", s)
+}
+
 func init() {
 	types.Add("eam:EamObjectRuntimeInfoStatus", reflect.TypeOf((*EamObjectRuntimeInfoStatus)(nil)).Elem())
 }
@@ -99,6 +201,22 @@ const (
 	EsxAgentManagerMaintenanceModePolicyMultipleHosts = EsxAgentManagerMaintenanceModePolicy("multipleHosts")
 )
 
+func (e EsxAgentManagerMaintenanceModePolicy) String() string {
+	return string(e)
+}
+
+func ParseEsxAgentManagerMaintenanceModePolicy(s string) (EsxAgentManagerMaintenanceModePolicy, error) {
+	for _, e := range []EsxAgentManagerMaintenanceModePolicy{
+		EsxAgentManagerMaintenanceModePolicySingleHost,
+		EsxAgentManagerMaintenanceModePolicyMultipleHosts,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EsxAgentManagerMaintenanceModePolicy: %q", s)
+}
+
 func init() {
 	types.Add("eam:EsxAgentManagerMaintenanceModePolicy", reflect.TypeOf((*EsxAgentManagerMaintenanceModePolicy)(nil)).Elem())
 }
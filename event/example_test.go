@@ -54,3 +54,50 @@ func ExampleManager_Events() {
 	// *types.VmStartingEvent
 	// *types.VmPoweredOnEvent
 }
+
+func ExampleManager_LatestEvents() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		m := event.NewManager(c)
+
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		events, err := m.LatestEvents(ctx, vm.Reference(), 2)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			fmt.Printf("%T\n", e)
+		}
+
+		since := events[len(events)-1].GetEvent().CreatedTime
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		events, err = m.EventsSince(ctx, vm.Reference(), since)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			fmt.Printf("%T\n", e)
+		}
+
+		return nil
+	})
+	// Output:
+	// *types.VmStartingEvent
+	// *types.VmPoweredOnEvent
+	// *types.VmPoweredOnEvent
+	// *types.VmStoppingEvent
+	// *types.VmPoweredOffEvent
+}
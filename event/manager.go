@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
@@ -115,6 +116,83 @@ func (m Manager) QueryEvents(ctx context.Context, filter types.EventFilterSpec)
 	return res.Returnval, nil
 }
 
+// LatestEvents returns up to max of the most recent events for the given entity, excluding its children,
+// sorted in ascending order of CreatedTime. An error is returned if max is not greater than 0.
+func (m Manager) LatestEvents(ctx context.Context, entity types.ManagedObjectReference, max int) ([]types.BaseEvent, error) {
+	if max <= 0 {
+		return nil, fmt.Errorf("invalid max event count: %d", max)
+	}
+
+	filter := types.EventFilterSpec{
+		Entity: &types.EventFilterSpecByEntity{
+			Entity:    entity,
+			Recursion: types.EventFilterSpecRecursionOptionSelf,
+		},
+		MaxCount: int32(max),
+	}
+
+	events, err := m.QueryEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	SortByCreatedTime(events)
+
+	if len(events) > max {
+		events = events[len(events)-max:]
+	}
+
+	return events, nil
+}
+
+// EventsSince returns all events for the given entity, excluding its children, created at or after the given time,
+// sorted in ascending order of CreatedTime.
+// When polling with the CreatedTime of the last event seen, that event is returned again and can be skipped by Key.
+func (m Manager) EventsSince(ctx context.Context, entity types.ManagedObjectReference, since time.Time) ([]types.BaseEvent, error) {
+	filter := types.EventFilterSpec{
+		Entity: &types.EventFilterSpecByEntity{
+			Entity:    entity,
+			Recursion: types.EventFilterSpecRecursionOptionSelf,
+		},
+		Time: &types.EventFilterSpecByTime{
+			BeginTime: &since,
+		},
+	}
+
+	collector, err := m.CreateCollectorForEvents(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		// ctx may have been cancelled, the collector is destroyed regardless
+		_ = collector.Destroy(context.Background())
+	}()
+
+	// a new collector is positioned at its latest page, rewind to read from the oldest event
+	if err = collector.Rewind(ctx); err != nil {
+		return nil, err
+	}
+
+	var events []types.BaseEvent
+
+	for {
+		page, err := collector.ReadNextEvents(ctx, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			break
+		}
+
+		events = append(events, page...)
+	}
+
+	SortByCreatedTime(events)
+
+	return events, nil
+}
+
 func (m Manager) RetrieveArgumentDescription(ctx context.Context, eventTypeID string) ([]types.EventArgDesc, error) {
 	req := types.RetrieveArgumentDescription{
 		This:        m.r,
@@ -43,3 +43,14 @@ func (d baseEvent) Less(i, j int) bool {
 func (d baseEvent) Swap(i, j int) {
 	d[i], d[j] = d[j], d[i]
 }
+
+// SortByCreatedTime sorts events in ascending order of CreatedTime, using Key to order events created at the same time.
+func SortByCreatedTime(events []types.BaseEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].GetEvent(), events[j].GetEvent()
+		if a.CreatedTime.Equal(b.CreatedTime) {
+			return a.Key < b.Key
+		}
+		return a.CreatedTime.Before(b.CreatedTime)
+	})
+}
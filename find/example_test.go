@@ -57,3 +57,38 @@ func ExampleMultipleFoundError() {
 	// /DC0/network/DC0_DVPG0
 	// /DC0/network/DC0_DVPG1
 }
+
+func ExampleFinder_ElementByPath() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+
+		paths := []string{
+			"/DC0/vm/DC0_H0_VM0",
+			"/DC0/host/DC0_C0/DC0_C0_H0",
+			"/DC0/host/DC0_C0/Resources",
+			"/DC0/datastore/LocalDS_0",
+			"/DC0/network/VM Network",
+		}
+
+		for _, p := range paths {
+			obj, err := finder.ElementByPath(ctx, p)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%T\n", obj)
+		}
+
+		_, err := finder.ElementByPath(ctx, "/DC0/vm/DC0_H0_VM9")
+		_, ok := err.(*find.NotFoundError)
+		fmt.Println(ok)
+
+		return nil
+	})
+	// Output:
+	// *object.VirtualMachine
+	// *object.HostSystem
+	// *object.ResourcePool
+	// *object.Datastore
+	// *object.Network
+	// true
+}
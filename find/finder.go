@@ -19,6 +19,7 @@ package find
 import (
 	"context"
 	"errors"
+	"fmt"
 	"path"
 	"strings"
 
@@ -336,6 +337,60 @@ func (f *Finder) ObjectReference(ctx context.Context, ref types.ManagedObjectRef
 	return r, nil
 }
 
+// ElementByPath resolves the absolute inventory path p to a type from the object package,
+// walking the inventory one path segment at a time, including the Datacenter vm, host, network and datastore folders.
+// Unlike the List methods, path segments are not glob patterns and must match the child name exactly.
+// A NotFoundError is returned if a segment matches no children and a MultipleFoundError if it matches more than one.
+func (f *Finder) ElementByPath(ctx context.Context, p string) (object.Reference, error) {
+	if !strings.HasPrefix(p, "/") {
+		return nil, fmt.Errorf("inventory path '%s' is not absolute", p)
+	}
+
+	e := list.Element{
+		Object: object.NewRootFolder(f.client),
+		Path:   "/",
+	}
+
+	for _, name := range list.ToParts(p) {
+		l := list.Lister{
+			Collector: f.r.Collector,
+			Reference: e.Object.Reference(),
+			Prefix:    e.Path,
+		}
+
+		children, err := l.List(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var matches []list.Element
+		for _, child := range children {
+			if path.Base(child.Path) == name {
+				matches = append(matches, child)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return nil, &NotFoundError{"object", p}
+		case 1:
+			e = matches[0]
+		default:
+			return nil, &MultipleFoundError{"object", p}
+		}
+	}
+
+	r := object.NewReference(f.client, e.Object.Reference())
+
+	type common interface {
+		SetInventoryPath(string)
+	}
+
+	r.(common).SetInventoryPath(e.Path)
+
+	return r, nil
+}
+
 func (f *Finder) ManagedObjectList(ctx context.Context, path string, include ...string) ([]list.Element, error) {
 	return f.managedObjectList(ctx, path, false, include)
 }
@@ -406,10 +406,33 @@ class SimpleType < Simple
       EnumValue.new(self, n["value"])
     end
 
-    io.print "type %s string\n\n" % ucfirst(name)
+    type_name = ucfirst(name)
+
+    io.print "type %s string\n\n" % type_name
     io.print "const (\n"
     enums.each { |e| e.dump(io) }
     io.print ")\n\n"
+
+    dump_helpers(io, type_name, enums)
+  end
+
+  # dump_helpers generates String and Parse functions for the enum type.
+  # Parse ignores case when matching s to an enum value.
+  def dump_helpers(io, type_name, enums)
+    io.print "func (e %s) String() string {\n" % type_name
+    io.print "return string(e)\n"
+    io.print "}\n\n"
+
+    io.print "func Parse%s(s string) (%s, error) {\n" % [type_name, type_name]
+    io.print "for _, e := range []%s{\n" % type_name
+    enums.each { |e| io.print "%s,\n" % e.var_name }
+    io.print "} {\n"
+    io.print "if strings.EqualFold(s, string(e)) {\n"
+    io.print "return e, nil\n"
+    io.print "}\n"
+    io.print "}\n"
+    io.print "return \"\", fmt.Errorf(\"invalid %s: %%q\", s)\n" % type_name
+    io.print "}\n\n"
   end
 
   def dump_init(io)
@@ -11,6 +11,7 @@ require (
 	github.com/kr/pretty v0.1.0
 	github.com/kr/text v0.1.0 // indirect
 	github.com/vmware/vmw-guestinfo v0.0.0-20170707015358-25eff159a728
+	golang.org/x/time v0.0.0-20210220033141-f8bda1e9f3ba
 )
 
 go 1.14
@@ -0,0 +1,124 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Session provides the guest operations of a single VM to the task function of BatchRun.
+type Session struct {
+	VM   types.ManagedObjectReference
+	Auth types.BaseGuestAuthentication
+
+	AuthManager    *AuthManager
+	FileManager    *FileManager
+	ProcessManager *ProcessManager
+}
+
+// BatchResult is the outcome of a BatchRun task for a single VM.
+type BatchResult struct {
+	VM  types.ManagedObjectReference
+	Err error // Err is the error validating credentials or returned by the task, nil on success
+}
+
+// BatchRun runs task in a guest Session for each of the given VMs, with at most parallelism sessions at a time.
+// The credentials returned by auth for each VM are validated before the task is called.
+// The returned results are in the same order as vms, each including any error for that VM,
+// such that a failure on one VM does not prevent the task running on the others.
+// The FileManagers of all sessions share a TransferHostCache.
+// If ctx is canceled, VMs for which the task has not yet started fail with the context error.
+// An error is only returned if the guest operations managers could not be retrieved.
+func BatchRun(ctx context.Context, c *vim25.Client, vms []types.ManagedObjectReference,
+	auth func(types.ManagedObjectReference) types.BaseGuestAuthentication,
+	task func(context.Context, *Session) error, parallelism int) ([]BatchResult, error) {
+	var g mo.GuestOperationsManager
+
+	pc := property.DefaultCollector(c)
+	err := pc.RetrieveOne(ctx, *c.ServiceContent.GuestOperationsManager, []string{"authManager", "fileManager", "processManager"}, &g)
+	if err != nil {
+		return nil, err
+	}
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	results := make([]BatchResult, len(vms))
+	jobs := make(chan int)
+	hosts := NewTransferHostCache(0)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < parallelism && i < len(vms); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				results[j].Err = batchRunOne(ctx, c, &g, hosts, vms[j], auth, task)
+			}
+		}()
+	}
+
+	for i, vm := range vms {
+		results[i].VM = vm
+
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+
+	return results, nil
+}
+
+func batchRunOne(ctx context.Context, c *vim25.Client, g *mo.GuestOperationsManager, hosts *TransferHostCache, vm types.ManagedObjectReference,
+	auth func(types.ManagedObjectReference) types.BaseGuestAuthentication,
+	task func(context.Context, *Session) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s := &Session{
+		VM:             vm,
+		Auth:           auth(vm),
+		AuthManager:    &AuthManager{*g.AuthManager, vm, c},
+		ProcessManager: &ProcessManager{*g.ProcessManager, vm, c},
+		FileManager: &FileManager{
+			ManagedObjectReference: *g.FileManager,
+			vm:                     vm,
+			c:                      c,
+			hosts:                  hosts,
+		},
+	}
+
+	if err := s.AuthManager.ValidateCredentials(ctx, s.Auth); err != nil {
+		return err
+	}
+
+	return task(ctx, s)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guest_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/vmware/govmomi/guest"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestBatchRun(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		var vms []types.ManagedObjectReference
+		for _, vm := range simulator.Map.All("VirtualMachine") {
+			vms = append(vms, vm.Reference())
+		}
+
+		off := vms[1]
+		task, err := object.NewVirtualMachine(c, off).PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		enoent := types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"}
+		vms = append(vms, enoent) // unknown to the inventory
+
+		auth := func(types.ManagedObjectReference) types.BaseGuestAuthentication {
+			return &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+		}
+
+		var mu sync.Mutex
+		running, max := 0, 0
+		ran := make(map[types.ManagedObjectReference]bool)
+
+		results, err := guest.BatchRun(ctx, c, vms, auth, func(ctx context.Context, s *guest.Session) error {
+			mu.Lock()
+			running++
+			if running > max {
+				max = running
+			}
+			ran[s.VM] = true
+			mu.Unlock()
+
+			_, err := s.ProcessManager.ListProcesses(ctx, s.Auth, nil)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+
+			return err
+		}, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(results) != len(vms) {
+			t.Fatalf("%d results", len(results))
+		}
+
+		for i, res := range results {
+			if res.VM != vms[i] {
+				t.Errorf("results[%d].VM=%s", i, res.VM)
+			}
+			if res.VM == enoent {
+				if !soap.IsSoapFault(res.Err) {
+					t.Errorf("%s: %v", enoent, res.Err)
+				} else if _, ok := soap.ToSoapFault(res.Err).VimFault().(types.ManagedObjectNotFound); !ok {
+					t.Errorf("%s: %v", enoent, res.Err)
+				}
+				continue
+			}
+			if res.VM == off {
+				if res.Err == nil || ran[res.VM] {
+					t.Errorf("expected %s to fail credential validation", off)
+				}
+				continue
+			}
+			if res.Err != nil || !ran[res.VM] {
+				t.Errorf("%s: %v", res.VM, res.Err)
+			}
+		}
+
+		if max > 2 {
+			t.Errorf("%d tasks ran concurrently", max)
+		}
+	})
+}
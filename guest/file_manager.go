@@ -39,8 +39,22 @@ type FileManager struct {
 
 	c *vim25.Client
 
-	mu    *sync.Mutex
-	hosts map[string]string
+	preferIP *bool
+
+	hosts *TransferHostCache
+}
+
+// PreferHostTransferIP sets whether TransferURL prefers the ESX host management IP over the host name,
+// overriding the GOVMOMI_USE_GUEST_TRANSFER_IP environment variable default for this FileManager.
+func (m *FileManager) PreferHostTransferIP(prefer bool) {
+	m.preferIP = &prefer
+}
+
+func (m FileManager) useHostTransferIP() bool {
+	if m.preferIP != nil {
+		return *m.preferIP
+	}
+	return useGuestTransferIP
 }
 
 func (m FileManager) Reference() types.ManagedObjectReference {
@@ -124,9 +138,104 @@ func (m FileManager) DeleteFile(ctx context.Context, auth types.BaseGuestAuthent
 // escape hatch to disable the preference to use ESX host management IP for guest file transfer
 var useGuestTransferIP = os.Getenv("GOVMOMI_USE_GUEST_TRANSFER_IP") != "false"
 
+// transferHost is the address and thumbprint of an ESX host, as resolved by TransferURL.
+type transferHost struct {
+	address    string
+	thumbprint string
+}
+
+// DefaultTransferHostCacheSize is the number of ESX hosts held by a TransferHostCache created with size 0.
+const DefaultTransferHostCacheSize = 256
+
+// TransferHostCache caches the ESX host addresses and thumbprints resolved by FileManager.TransferURL,
+// keyed by host name, such that FileManagers for VMs on the same host do not need to resolve the host again.
+// A TransferHostCache is safe for concurrent use, but must only be shared by FileManagers of the same vCenter.
+// When full, the least recently added host is evicted.
+type TransferHostCache struct {
+	mu    sync.Mutex
+	size  int
+	names []string
+	hosts map[string]transferHost
+}
+
+// NewTransferHostCache creates a TransferHostCache holding at most size hosts,
+// or DefaultTransferHostCacheSize if size is 0.
+func NewTransferHostCache(size int) *TransferHostCache {
+	if size <= 0 {
+		size = DefaultTransferHostCacheSize
+	}
+
+	return &TransferHostCache{
+		size:  size,
+		hosts: make(map[string]transferHost),
+	}
+}
+
+func (c *TransferHostCache) lookup(name string) (transferHost, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.hosts[name]
+	return h, ok
+}
+
+func (c *TransferHostCache) store(name string, h transferHost) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.hosts[name]; !ok {
+		if len(c.names) == c.size {
+			delete(c.hosts, c.names[0])
+			c.names = c.names[1:]
+		}
+		c.names = append(c.names, name)
+	}
+
+	c.hosts[name] = h
+}
+
+// remove removes the given host name from the cache, or all hosts if name is empty, returning the removed hosts.
+func (c *TransferHostCache) remove(name string) []transferHost {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var removed []transferHost
+	names := c.names[:0]
+
+	for _, hname := range c.names {
+		if name == "" || name == hname {
+			removed = append(removed, c.hosts[hname])
+			delete(c.hosts, hname)
+			continue
+		}
+		names = append(names, hname)
+	}
+
+	c.names = names
+
+	return removed
+}
+
+// InvalidateTransferHost removes the given ESX host name from the cache used by TransferURL,
+// along with the client's thumbprint for the host address.
+// This is needed when a host is reconnected with a new certificate or management address.
+// All hosts are removed if name is empty.
+func (m FileManager) InvalidateTransferHost(name string) {
+	if m.hosts == nil {
+		return
+	}
+
+	for _, h := range m.hosts.remove(name) {
+		m.c.SetThumbprint(h.address, "")
+	}
+}
+
 // TransferURL rewrites the url with a valid hostname and adds the host's thumbprint.
 // The InitiateFileTransfer{From,To}Guest methods return a URL with the host set to "*" when connected directly to ESX,
 // but return the address of VM's runtime host when connected to vCenter.
+// Guest file transfers are always served by the ESX host: the VMware Tools RPC channel is only reachable
+// from within the guest, so there is no alternate transfer path for clients that cannot reach the host.
+// See PreferHostTransferIP for hosts whose name does not resolve from the client.
 func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error) {
 	turl, err := url.Parse(u)
 	if err != nil {
@@ -144,13 +253,16 @@ func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error
 	name := turl.Hostname()
 	port := turl.Port()
 
-	m.mu.Lock()
-	mname, ok := m.hosts[name]
-	m.mu.Unlock()
+	useIP := m.useHostTransferIP()
 
-	if ok {
-		turl.Host = net.JoinHostPort(mname, port)
-		return turl, nil
+	if m.hosts != nil && useIP {
+		if h, ok := m.hosts.lookup(name); ok {
+			turl.Host = net.JoinHostPort(h.address, port)
+			if m.c.Thumbprint(turl.Host) == "" {
+				m.c.SetThumbprint(turl.Host, h.thumbprint)
+			}
+			return turl, nil
+		}
 	}
 
 	c := property.DefaultCollector(m.c)
@@ -188,13 +300,16 @@ func (m FileManager) TransferURL(ctx context.Context, u string) (*url.URL, error
 	// This name was used to add the host to vCenter and cannot be changed (unless the host is removed from inventory and added back with another name).
 	// The name used when adding to VC may not resolvable by this client's DNS, so we prefer an ESX management IP.
 	// However, if there is more than one management vNIC, we don't know which IP(s) the client has a route to.
-	// Leave the hostname as-is in that case or if the env var has disabled the preference.
+	// Leave the hostname as-is in that case or if the preference is disabled, see PreferHostTransferIP.
 	ips := internal.HostSystemManagementIPs(host.Config.VirtualNicManagerInfo.NetConfig)
-	if len(ips) == 1 && useGuestTransferIP {
-		mname = ips[0].String()
-		m.mu.Lock()
-		m.hosts[name] = mname
-		m.mu.Unlock()
+	if len(ips) == 1 && useIP {
+		mname := ips[0].String()
+		if m.hosts != nil {
+			m.hosts.store(name, transferHost{
+				address:    mname,
+				thumbprint: host.Summary.Config.SslThumbprint,
+			})
+		}
 
 		name = mname
 	}
@@ -18,11 +18,15 @@ package guest_test
 
 import (
 	"context"
+	"io/ioutil"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/vmware/govmomi/guest"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -77,3 +81,244 @@ func TestTranferURL(t *testing.T) {
 		t.Log(err)
 	})
 }
+
+func TestTransferURLPreferHostTransferIP(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := simulator.Map.Any("VirtualMachine")
+
+		m, err := guest.NewOperationsManager(c, vm.Reference()).FileManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		turl := "https://esx:443/foo/bar"
+
+		// hostname should be returned by TransferURL when the IP preference is disabled, regardless of the cache
+		for _, prefer := range []bool{true, false, true} {
+			m.PreferHostTransferIP(prefer)
+
+			u, err := m.TransferURL(ctx, turl)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expect := "esx"
+			if prefer {
+				expect = "127.0.0.1"
+			}
+			if u.Hostname() != expect {
+				t.Errorf("prefer=%t hostname=%s", prefer, u.Hostname())
+			}
+		}
+	})
+}
+
+func TestTransferURLSharedCache(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		host := simulator.Map.Get(*vm.Runtime.Host).(*simulator.HostSystem)
+
+		var vms []types.ManagedObjectReference
+		for _, ref := range host.Vm {
+			vms = append(vms, ref)
+		}
+		if len(vms) < 2 {
+			t.Skipf("host %s has %d VMs", host.Name, len(vms))
+		}
+
+		cache := guest.NewTransferHostCache(1)
+
+		fileManager := func(vm types.ManagedObjectReference) *guest.FileManager {
+			ops := guest.NewOperationsManager(c, vm)
+			ops.SetTransferHostCache(cache)
+			m, err := ops.FileManager(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return m
+		}
+
+		turl := "https://esx-shared:443/foo/bar"
+		u, err := fileManager(vms[0]).TransferURL(ctx, turl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Hostname() != "127.0.0.1" {
+			t.Errorf("hostname=%s", u.Hostname())
+		}
+
+		// A FileManager for another VM on the same host should use the cached address
+		config := host.Config
+		host.Config = nil
+
+		u, err = fileManager(vms[1]).TransferURL(ctx, turl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Hostname() != "127.0.0.1" {
+			t.Errorf("hostname=%s", u.Hostname())
+		}
+
+		fileManager(vms[0]).InvalidateTransferHost("esx-shared")
+
+		if c.Thumbprint(u.Host) != "" {
+			t.Error("expected thumbprint to be removed")
+		}
+
+		_, err = fileManager(vms[1]).TransferURL(ctx, turl)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		host.Config = config
+
+		// The cache holds 1 host, so resolving another host name evicts esx-shared
+		if _, err = fileManager(vms[0]).TransferURL(ctx, turl); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = fileManager(vms[0]).TransferURL(ctx, "https://esx-other:443/foo/bar"); err != nil {
+			t.Fatal(err)
+		}
+
+		host.Config = nil
+
+		if _, err = fileManager(vms[1]).TransferURL(ctx, turl); err == nil {
+			t.Error("expected error")
+		}
+
+		host.Config = config
+	})
+}
+
+func TestFileManagerMemory(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+
+		ops := guest.NewOperationsManager(c, vm.Reference())
+		m, err := ops.FileManager(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		auth := &types.NamePasswordAuthentication{Username: "user", Password: "pass"}
+
+		err = m.MakeDirectory(ctx, auth, "/tmp/foo/bar", false)
+		if !isFault(err, &types.FileNotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		err = m.MakeDirectory(ctx, auth, "/tmp/foo/bar", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name := "/tmp/foo/bar/hello.txt"
+		content := "hello world\n"
+
+		turl, err := m.InitiateFileTransferToGuest(ctx, auth, name, new(types.GuestPosixFileAttributes), int64(len(content)), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := m.TransferURL(ctx, turl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := soap.DefaultUpload
+		p.ContentLength = int64(len(content))
+		if err = c.Client.Upload(ctx, strings.NewReader(content), u, &p); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = m.InitiateFileTransferToGuest(ctx, auth, name, new(types.GuestPosixFileAttributes), int64(len(content)), false)
+		if !isFault(err, &types.FileAlreadyExists{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		res, err := m.ListFiles(ctx, auth, "/tmp/foo/bar", 0, 0, "*.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 1 || res.Files[0].Path != name || res.Files[0].Size != int64(len(content)) {
+			t.Errorf("files=%#v", res.Files)
+		}
+
+		info, err := m.InitiateFileTransferFromGuest(ctx, auth, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Size != int64(len(content)) {
+			t.Errorf("size=%d", info.Size)
+		}
+		u, err = m.TransferURL(ctx, info.Url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		f, _, err := c.Client.Download(ctx, u, &soap.DefaultDownload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := ioutil.ReadAll(f)
+		_ = f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != content {
+			t.Errorf("content=%q", b)
+		}
+
+		// a directory cannot be moved into its own subdirectory
+		err = m.MoveDirectory(ctx, auth, "/tmp/foo", "/tmp/foo/bar/baz")
+		if !isFault(err, &types.InvalidArgument{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		// all descendants move with the directory, exactly once
+		if err = m.MoveDirectory(ctx, auth, "/tmp/foo", "/tmp/foo2"); err != nil {
+			t.Fatal(err)
+		}
+		res, err = m.ListFiles(ctx, auth, "/tmp/foo2/bar", 0, 0, "*.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 1 || res.Files[0].Path != "/tmp/foo2/bar/hello.txt" {
+			t.Errorf("files=%#v", res.Files)
+		}
+		if err = m.MoveDirectory(ctx, auth, "/tmp/foo2", "/tmp/foo"); err != nil {
+			t.Fatal(err)
+		}
+
+		// a file cannot overwrite a directory, which would orphan the directory's children
+		err = m.MoveFile(ctx, auth, name, "/tmp/foo", true)
+		if !isFault(err, &types.NotAFile{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		err = m.DeleteDirectory(ctx, auth, "/tmp/foo", false)
+		if !isFault(err, &types.DirectoryNotEmpty{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = m.DeleteFile(ctx, auth, name); err != nil {
+			t.Fatal(err)
+		}
+		if err = m.DeleteFile(ctx, auth, name); !isFault(err, &types.FileNotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = m.DeleteDirectory(ctx, auth, "/tmp/foo", true); err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = m.ListFiles(ctx, auth, "/tmp/foo", 0, 0, "")
+		if !isFault(err, &types.FileNotFound{}) {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
+
+func isFault(err error, fault types.BaseMethodFault) bool {
+	if err == nil || !soap.IsSoapFault(err) {
+		return false
+	}
+	return reflect.TypeOf(soap.ToSoapFault(err).VimFault()) == reflect.TypeOf(fault).Elem()
+}
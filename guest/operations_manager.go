@@ -18,7 +18,6 @@ package guest
 
 import (
 	"context"
-	"sync"
 
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
@@ -29,10 +28,18 @@ import (
 type OperationsManager struct {
 	c  *vim25.Client
 	vm types.ManagedObjectReference
+
+	hosts *TransferHostCache
 }
 
 func NewOperationsManager(c *vim25.Client, vm types.ManagedObjectReference) *OperationsManager {
-	return &OperationsManager{c, vm}
+	return &OperationsManager{c: c, vm: vm, hosts: NewTransferHostCache(0)}
+}
+
+// SetTransferHostCache sets the TransferHostCache used by FileManagers of this OperationsManager,
+// such that OperationsManagers for VMs of the same vCenter can share resolved ESX host addresses.
+func (m *OperationsManager) SetTransferHostCache(c *TransferHostCache) {
+	m.hosts = c
 }
 
 func (m OperationsManager) retrieveOne(ctx context.Context, p string, dst *mo.GuestOperationsManager) error {
@@ -63,8 +70,7 @@ func (m OperationsManager) FileManager(ctx context.Context) (*FileManager, error
 		ManagedObjectReference: *g.FileManager,
 		vm:                     m.vm,
 		c:                      m.c,
-		mu:                     new(sync.Mutex),
-		hosts:                  make(map[string]string),
+		hosts:                  m.hosts,
 	}, nil
 }
 
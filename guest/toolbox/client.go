@@ -42,6 +42,7 @@ type Client struct {
 	FileManager    *guest.FileManager
 	Authentication types.BaseGuestAuthentication
 	GuestFamily    types.VirtualMachineGuestOsFamily
+	RateLimit      int64 // Default maximum Upload and Download rate in bytes per second, 0 for no limit
 }
 
 // NewClient initializes a Client's ProcessManager, FileManager and GuestFamily
@@ -283,6 +284,7 @@ func (c *Client) Download(ctx context.Context, src string) (io.ReadCloser, int64
 	}
 
 	p := soap.DefaultDownload
+	p.RateLimit = c.RateLimit
 
 	f, n, err := vc.Download(ctx, u, &p)
 	if err != nil {
@@ -341,5 +343,85 @@ func (c *Client) Upload(ctx context.Context, src io.Reader, dst string, p soap.U
 		return err
 	}
 
+	if p.RateLimit == 0 {
+		p.RateLimit = c.RateLimit
+	}
+
 	return vc.Client.Upload(ctx, src, u, &p)
 }
+
+// concatCommand returns the command used to concatenate the given guest files into dst.
+func concatCommand(family types.VirtualMachineGuestOsFamily, parts []string, dst string) *exec.Cmd {
+	switch family {
+	case types.VirtualMachineGuestOsFamilyWindowsGuest:
+		src := make([]string, len(parts))
+		for i, part := range parts {
+			src[i] = `"` + part + `"`
+		}
+		return &exec.Cmd{
+			Path: "copy",
+			Args: []string{"/y", "/b", strings.Join(src, "+"), `"` + dst + `"`},
+		}
+	default:
+		src := make([]string, len(parts))
+		for i, part := range parts {
+			src[i] = posixQuote(part)
+		}
+		script := fmt.Sprintf("cat %s > %s", strings.Join(src, " "), posixQuote(dst))
+		return &exec.Cmd{
+			Path: string(ShellPosix),
+			Args: []string{"-c", posixQuote(script)},
+		}
+	}
+}
+
+// UploadChunked transfers size bytes of src to the guest file dst, as separate uploads of at most chunkSize bytes.
+// Each chunk is uploaded to a guest temporary file, retrying a failed chunk up to retries times
+// without restarting the transfer from the beginning.
+// Once all chunks are uploaded, they are concatenated into dst by a guest command and removed.
+func (c *Client) UploadChunked(ctx context.Context, src io.ReaderAt, size int64, dst string, chunkSize int64, retries int) error {
+	if chunkSize <= 0 {
+		return fmt.Errorf("invalid chunk size: %d", chunkSize)
+	}
+
+	var parts []string
+
+	defer func() {
+		for _, part := range parts {
+			c.rm(ctx, part)
+		}
+	}()
+
+	for offset := int64(0); ; offset += chunkSize {
+		n := chunkSize
+		if offset+n > size {
+			n = size - offset
+		}
+
+		part, err := c.mktemp(ctx)
+		if err != nil {
+			return err
+		}
+		parts = append(parts, part)
+
+		p := soap.DefaultUpload
+		p.ContentLength = n
+		attr := new(types.GuestPosixFileAttributes)
+
+		for i := 0; ; i++ {
+			err = c.Upload(ctx, io.NewSectionReader(src, offset, n), part, p, attr, true)
+			if err == nil || i >= retries || ctx.Err() != nil {
+				break
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("upload chunk at offset %d: %s", offset, err)
+		}
+
+		if offset+n >= size {
+			break
+		}
+	}
+
+	return c.Run(ctx, concatCommand(c.GuestFamily, parts, dst))
+}
@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolbox
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestConcatCommand(t *testing.T) {
+	cmd := concatCommand(types.VirtualMachineGuestOsFamilyWindowsGuest, []string{`C:\Temp\a`, `C:\Temp\b`}, `C:\Program Files\x.iso`)
+	args := strings.Join(cmd.Args, " ")
+	if cmd.Path != "copy" || args != `/y /b "C:\Temp\a"+"C:\Temp\b" "C:\Program Files\x.iso"` {
+		t.Errorf("%s %s", cmd.Path, args)
+	}
+
+	cmd = concatCommand(types.VirtualMachineGuestOsFamilyLinuxGuest, []string{"/tmp/a", "/tmp/b"}, "/data/it's.iso")
+	if cmd.Path != string(ShellPosix) || !strings.Contains(cmd.Args[1], "cat") {
+		t.Errorf("%s %v", cmd.Path, cmd.Args)
+	}
+}
+
+func TestUploadChunked(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		vm := simulator.Map.Any("VirtualMachine")
+
+		tools, err := NewClient(ctx, c, vm, &types.NamePasswordAuthentication{Username: "user", Password: "pass"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// fail the 2nd transfer, which should be retried
+		transfers := 0
+		simulator.Override("InitiateFileTransferToGuest", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			transfers++
+			if transfers == 2 {
+				return simulator.MethodFault(new(types.GuestOperationsUnavailable)), true
+			}
+			return nil, false
+		})
+
+		// vcsim can only run guest programs within a container, fake the concatenation
+		var parts []string
+		simulator.Override("StartProgramInGuest", func(_ *simulator.Context, req types.AnyType) (soap.HasFault, bool) {
+			spec := req.(*types.StartProgramInGuest).Spec.GetGuestProgramSpec()
+			for _, arg := range strings.Fields(spec.Arguments) {
+				if strings.Contains(arg, "govmomi-") {
+					parts = append(parts, strings.Trim(arg, `'\`))
+				}
+			}
+			return &methods.StartProgramInGuestBody{Res: &types.StartProgramInGuestResponse{Returnval: 1}}, true
+		})
+		simulator.Override("ListProcessesInGuest", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			return &methods.ListProcessesInGuestBody{
+				Res: &types.ListProcessesInGuestResponse{
+					Returnval: []types.GuestProcessInfo{{Pid: 1, EndTime: types.NewTime(time.Now())}},
+				},
+			}, true
+		})
+
+		data := bytes.Repeat([]byte("0123456789"), 25)
+
+		err = tools.UploadChunked(ctx, bytes.NewReader(data), int64(len(data)), "/tmp/data", 100, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(parts) != 3 {
+			t.Fatalf("parts=%v", parts)
+		}
+		if transfers != 4 {
+			t.Errorf("transfers=%d", transfers)
+		}
+
+		// chunks should be removed once concatenated
+		res, err := tools.FileManager.ListFiles(ctx, tools.Authentication, "/tmp", 0, 0, "govmomi-*")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Files) != 0 {
+			t.Errorf("files=%v", res.Files)
+		}
+
+		simulator.Override("InitiateFileTransferToGuest", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			return simulator.MethodFault(new(types.GuestOperationsUnavailable)), true
+		})
+
+		err = tools.UploadChunked(ctx, bytes.NewReader(data), int64(len(data)), "/tmp/data", 100, 2)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,186 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolbox
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Shell is the guest command interpreter used to run a program with environment variables set.
+type Shell string
+
+const (
+	ShellPosix      = Shell("/bin/sh")
+	ShellCmd        = Shell("c:\\Windows\\System32\\cmd.exe")
+	ShellPowerShell = Shell("c:\\Windows\\System32\\WindowsPowerShell\\v1.0\\powershell.exe")
+)
+
+// DefaultShell returns ShellCmd for the Windows guest family, otherwise ShellPosix.
+func DefaultShell(family types.VirtualMachineGuestOsFamily) Shell {
+	if family == types.VirtualMachineGuestOsFamilyWindowsGuest {
+		return ShellCmd
+	}
+	return ShellPosix
+}
+
+// ProgramSpec returns a GuestProgramSpec that uses the Shell to set the env variables,
+// then run the named program with the given arguments.
+// Variables are set in sorted order, the program name and arguments are passed as-is to the program,
+// such that values containing spaces or quotes do not need to be escaped by the caller.
+// Note that ShellCmd cannot escape '%' within values, as cmd.exe expands variables before parsing quotes.
+func (s Shell) ProgramSpec(env map[string]string, name string, args ...string) *types.GuestProgramSpec {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var cmd []string
+
+	switch s {
+	case ShellCmd:
+		var set strings.Builder
+		for _, key := range keys {
+			// No space before '&&', as it would be included in the value
+			set.WriteString("set " + key + "=" + cmdEscape(env[key], false) + "&& ")
+		}
+		cmd = append(cmd, `"`+name+`"`)
+		for _, arg := range args {
+			cmd = append(cmd, windowsQuote(arg))
+		}
+		// With '/s', cmd.exe always strips the outer quotes, leaving the command line as-is
+		return &types.GuestProgramSpec{
+			ProgramPath: string(s),
+			Arguments:   `/s /c "` + set.String() + cmdEscape(strings.Join(cmd, " "), true) + `"`,
+		}
+	case ShellPowerShell:
+		for _, key := range keys {
+			cmd = append(cmd, "$env:"+key+" = "+powerShellQuote(env[key])+";")
+		}
+		cmd = append(cmd, "&", powerShellQuote(name))
+		for _, arg := range args {
+			cmd = append(cmd, powerShellQuote(arg))
+		}
+		cmd = append(cmd, "; exit $LASTEXITCODE")
+		// An encoded command avoids quoting the script for both the Windows command line and PowerShell
+		return &types.GuestProgramSpec{
+			ProgramPath: string(s),
+			Arguments:   "-NoProfile -NonInteractive -EncodedCommand " + powerShellEncode(strings.Join(cmd, " ")),
+		}
+	default:
+		for _, key := range keys {
+			cmd = append(cmd, "export "+key+"="+posixQuote(env[key]), "&&")
+		}
+		cmd = append(cmd, "exec", posixQuote(name))
+		for _, arg := range args {
+			cmd = append(cmd, posixQuote(arg))
+		}
+		return &types.GuestProgramSpec{
+			ProgramPath: string(s),
+			Arguments:   "-c " + posixQuote(strings.Join(cmd, " ")),
+		}
+	}
+}
+
+// ProgramSpec returns a GuestProgramSpec using the DefaultShell for the Client's GuestFamily.
+// See Shell.ProgramSpec.
+func (c *Client) ProgramSpec(env map[string]string, name string, args ...string) *types.GuestProgramSpec {
+	return DefaultShell(c.GuestFamily).ProgramSpec(env, name, args...)
+}
+
+// posixQuote single quotes s, such that it is passed as-is by a POSIX shell.
+func posixQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// cmdEscape escapes cmd.exe special characters in s with '^', such that they are not interpreted.
+// If quoted is true, characters within double quotes are not escaped, as cmd.exe does not interpret them.
+// Otherwise, double quotes are also escaped.
+func cmdEscape(s string, quoted bool) string {
+	var b strings.Builder
+
+	inQuote := false
+	for _, c := range s {
+		switch c {
+		case '"':
+			if quoted {
+				inQuote = !inQuote
+			} else {
+				b.WriteByte('^')
+			}
+		case '^', '&', '|', '<', '>', '(', ')':
+			if !inQuote {
+				b.WriteByte('^')
+			}
+		}
+		b.WriteRune(c)
+	}
+
+	return b.String()
+}
+
+// windowsQuote quotes s as needed, such that it is parsed as a single argument by CommandLineToArgvW.
+func windowsQuote(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\"") {
+		return s
+	}
+
+	var b strings.Builder
+	b.WriteByte('"')
+
+	slashes := 0
+	for _, c := range s {
+		switch c {
+		case '\\':
+			slashes++
+		case '"':
+			// Backslashes preceding a quote must be escaped, along with the quote itself
+			b.WriteString(strings.Repeat(`\`, slashes+1))
+			slashes = 0
+		default:
+			slashes = 0
+		}
+		b.WriteRune(c)
+	}
+
+	// Backslashes preceding the closing quote must be escaped
+	b.WriteString(strings.Repeat(`\`, slashes))
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// powerShellQuote single quotes s, such that it is a PowerShell string literal.
+func powerShellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+// powerShellEncode encodes the script for use with 'powershell -EncodedCommand'.
+func powerShellEncode(script string) string {
+	runes := utf16.Encode([]rune(script))
+	buf := make([]byte, len(runes)*2)
+	for i, r := range runes {
+		binary.LittleEndian.PutUint16(buf[i*2:], r)
+	}
+	return base64.StdEncoding.EncodeToString(buf)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolbox
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"os/exec"
+	"runtime"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDefaultShell(t *testing.T) {
+	if s := DefaultShell(types.VirtualMachineGuestOsFamilyWindowsGuest); s != ShellCmd {
+		t.Errorf("shell=%s", s)
+	}
+	if s := DefaultShell(types.VirtualMachineGuestOsFamilyLinuxGuest); s != ShellPosix {
+		t.Errorf("shell=%s", s)
+	}
+}
+
+func TestProgramSpecPosix(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("requires /bin/sh")
+	}
+
+	env := map[string]string{
+		"FOO": `it's "quoted" $HOME`,
+		"BAR": "/path with/spaces",
+	}
+
+	spec := ShellPosix.ProgramSpec(env, "/bin/sh", "-c", `printf '%s\n' "$FOO" "$BAR" "$@"`, "sh", "a b", `c'"d`, "$(id)")
+
+	// vmware-tools runs the program with its arguments via the shell
+	out, err := exec.Command("/bin/sh", "-c", spec.ProgramPath+" "+spec.Arguments).CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s: %s", err, out)
+	}
+
+	expect := []string{env["FOO"], env["BAR"], "a b", `c'"d`, "$(id)"}
+	if lines := strings.Split(strings.TrimSuffix(string(out), "\n"), "\n"); strings.Join(lines, ",") != strings.Join(expect, ",") {
+		t.Errorf("output=%q", lines)
+	}
+}
+
+func TestProgramSpecCmd(t *testing.T) {
+	env := map[string]string{
+		"Q":     `say "hi" & bye`,
+		"PATH2": `C:\Program Files\x`,
+	}
+
+	spec := ShellCmd.ProgramSpec(env, `C:\Program Files\app.exe`, "a b", `x"y&z`, "plain")
+
+	expect := `/s /c "set PATH2=C:\Program Files\x&& set Q=say ^"hi^" ^& bye&& "C:\Program Files\app.exe" "a b" "x\"y^&z" plain"`
+	if spec.Arguments != expect {
+		t.Errorf("\n%s\n%s", spec.Arguments, expect)
+	}
+	if spec.ProgramPath != string(ShellCmd) {
+		t.Errorf("path=%s", spec.ProgramPath)
+	}
+}
+
+func TestProgramSpecPowerShell(t *testing.T) {
+	env := map[string]string{"FOO": "it's"}
+
+	spec := ShellPowerShell.ProgramSpec(env, `C:\Program Files\app.exe`, "a b", `"c"`)
+
+	prefix := "-NoProfile -NonInteractive -EncodedCommand "
+	if !strings.HasPrefix(spec.Arguments, prefix) {
+		t.Fatalf("args=%s", spec.Arguments)
+	}
+
+	buf, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(spec.Arguments, prefix))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runes := make([]uint16, len(buf)/2)
+	for i := range runes {
+		runes[i] = binary.LittleEndian.Uint16(buf[i*2:])
+	}
+
+	script := string(utf16.Decode(runes))
+	expect := `$env:FOO = 'it''s'; & 'C:\Program Files\app.exe' 'a b' '"c"' ; exit $LASTEXITCODE`
+	if script != expect {
+		t.Errorf("\n%s\n%s", script, expect)
+	}
+}
+
+func TestWindowsQuote(t *testing.T) {
+	tests := []struct {
+		in, out string
+	}{
+		{"plain", "plain"},
+		{"", `""`},
+		{"a b", `"a b"`},
+		{`a"b`, `"a\"b"`},
+		{`C:\Program Files\`, `"C:\Program Files\\"`},
+		{`a\"b`, `"a\\\"b"`},
+		{`C:\path\no-spaces`, `C:\path\no-spaces`},
+	}
+
+	for _, test := range tests {
+		if out := windowsQuote(test.in); out != test.out {
+			t.Errorf("%s: %s != %s", test.in, out, test.out)
+		}
+	}
+}
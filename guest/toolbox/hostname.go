@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolbox
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// hostnameRx matches an RFC 1123 host name label
+var hostnameRx = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// hostnameCommand returns the command used to set the guest host name for the given guest family.
+func hostnameCommand(family types.VirtualMachineGuestOsFamily, name string) (*exec.Cmd, error) {
+	if !hostnameRx.MatchString(name) {
+		return nil, fmt.Errorf("invalid host name %q", name)
+	}
+
+	switch family {
+	case types.VirtualMachineGuestOsFamilyWindowsGuest:
+		if len(name) > 15 {
+			return nil, fmt.Errorf("invalid host name %q: Windows computer names are limited to 15 characters", name)
+		}
+		// Note the new name takes effect once the guest is rebooted
+		script := "Rename-Computer -NewName " + powerShellQuote(name) + " -Force"
+		return &exec.Cmd{
+			Path: string(ShellPowerShell),
+			Args: []string{"-NoProfile", "-NonInteractive", "-EncodedCommand", powerShellEncode(script)},
+		}, nil
+	default:
+		script := fmt.Sprintf(
+			"if command -v hostnamectl >/dev/null 2>&1; then hostnamectl set-hostname %[1]s; else hostname %[1]s && echo %[1]s > /etc/hostname; fi",
+			posixQuote(name))
+		return &exec.Cmd{
+			Path: string(ShellPosix),
+			Args: []string{"-c", posixQuote(script)},
+		}, nil
+	}
+}
+
+// SetHostname sets the guest host name via a guest command appropriate for the GuestFamily,
+// without requiring a full guest customization.
+// On Linux, hostnamectl is used if available, otherwise the hostname command and /etc/hostname.
+// On Windows, the computer is renamed with Rename-Computer and the new name takes effect after a reboot.
+// As with Run, VMware Tools must be running in the guest and the Authentication user must have permission to set the host name.
+func (c *Client) SetHostname(ctx context.Context, name string) error {
+	cmd, err := hostnameCommand(c.GuestFamily, name)
+	if err != nil {
+		return err
+	}
+
+	return c.Run(ctx, cmd)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toolbox
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestHostnameCommand(t *testing.T) {
+	linux := types.VirtualMachineGuestOsFamilyLinuxGuest
+	windows := types.VirtualMachineGuestOsFamilyWindowsGuest
+
+	tests := []struct {
+		family types.VirtualMachineGuestOsFamily
+		name   string
+		valid  bool
+	}{
+		{linux, "vm-1", true},
+		{linux, "a-very-long-host-name-is-fine-on-linux", true},
+		{linux, "", false},
+		{linux, "-vm", false},
+		{linux, "vm 1", false},
+		{linux, "vm';reboot;'", false},
+		{windows, "WIN-1", true},
+		{windows, "a-very-long-host-name", false},
+		{windows, "win_1", false},
+	}
+
+	for _, test := range tests {
+		cmd, err := hostnameCommand(test.family, test.name)
+		if test.valid {
+			if err != nil {
+				t.Errorf("%s %q: %s", test.family, test.name, err)
+				continue
+			}
+			if !strings.Contains(strings.Join(cmd.Args, " "), test.name) && test.family == linux {
+				t.Errorf("%s %q: args=%v", test.family, test.name, cmd.Args)
+			}
+		} else if err == nil {
+			t.Errorf("%s %q: expected error", test.family, test.name)
+		}
+	}
+
+	cmd, _ := hostnameCommand(windows, "WIN-1")
+	if cmd.Path != string(ShellPowerShell) {
+		t.Errorf("path=%s", cmd.Path)
+	}
+}
@@ -19,12 +19,23 @@ package esxcli
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
-	"github.com/vmware/govmomi/govc/flags"
 	"github.com/vmware/govmomi/internal"
 )
 
+type stringList []string
+
+func (l *stringList) String() string {
+	return fmt.Sprint(*l)
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
 type Command struct {
 	name []string
 	args []string
@@ -94,8 +105,9 @@ func (c *Command) Moid() string {
 // Parse generates a flag.FlagSet based on the given []CommandInfoParam and
 // returns arguments for use with methods.ExecuteSoap
 func (c *Command) Parse(params []CommandInfoParam) ([]internal.ReflectManagedMethodExecuterSoapArgument, error) {
-	fs := flag.NewFlagSet(strings.Join(c.name, " "), flag.ExitOnError)
-	vals := make([]flags.StringList, len(params))
+	fs := flag.NewFlagSet(strings.Join(c.name, " "), flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	vals := make([]stringList, len(params))
 
 	for i, p := range params {
 		v := &vals[i]
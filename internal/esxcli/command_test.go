@@ -17,6 +17,7 @@ limitations under the License.
 package esxcli
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -123,3 +124,13 @@ func TestNetworkVmListCommand(t *testing.T) {
 		t.Errorf("%s != %s", args, expect)
 	}
 }
+
+func TestNewRequestCommandName(t *testing.T) {
+	e := new(Executor)
+
+	for _, args := range [][]string{nil, {"network"}, {"-v"}, {"network", "-v"}} {
+		if _, _, err := e.NewRequest(context.Background(), args); err == nil {
+			t.Errorf("%q: expected error", args)
+		}
+	}
+}
@@ -18,6 +18,8 @@ package object
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -219,3 +221,92 @@ func (m AuthorizationManager) FetchUserPrivilegeOnEntities(ctx context.Context,
 
 	return res.Returnval, nil
 }
+
+func (m AuthorizationManager) privilegeList(ctx context.Context) ([]types.AuthorizationPrivilege, error) {
+	var am mo.AuthorizationManager
+
+	err := m.Properties(ctx, m.Reference(), []string{"privilegeList"}, &am)
+	if err != nil {
+		return nil, err
+	}
+
+	return am.PrivilegeList, nil
+}
+
+// privilegeGroupIDs returns the IDs of all privileges in the given group, including nested groups.
+func privilegeGroupIDs(list []types.AuthorizationPrivilege, group string) []string {
+	var ids []string
+
+	for _, p := range list {
+		if p.PrivGroupName == group || strings.HasPrefix(p.PrivGroupName, group+".") {
+			ids = append(ids, p.PrivId)
+		}
+	}
+
+	return ids
+}
+
+// PrivilegeIDs returns the IDs of all privileges in the given privilege groups, including nested groups.
+// For example, "VirtualMachine.Interact" resolves to "VirtualMachine.Interact.PowerOn",
+// "VirtualMachine.Interact.PowerOff" and so on.
+func (m AuthorizationManager) PrivilegeIDs(ctx context.Context, group ...string) ([]string, error) {
+	list, err := m.privilegeList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+
+	for _, g := range group {
+		p := privilegeGroupIDs(list, g)
+		if len(p) == 0 {
+			return nil, fmt.Errorf("privilege group %q not found", g)
+		}
+		ids = append(ids, p...)
+	}
+
+	return ids, nil
+}
+
+// CreateRole creates a role with the given privileges, returning the new role ID.
+// Each of ids may be a privilege ID, such as "VirtualMachine.Interact.PowerOn",
+// or a privilege group ID, such as "VirtualMachine.Interact", which is expanded to all privileges in the group.
+func (m AuthorizationManager) CreateRole(ctx context.Context, name string, ids []string) (int32, error) {
+	list, err := m.privilegeList(ctx)
+	if err != nil {
+		return -1, err
+	}
+
+	privileges := make(map[string]bool, len(list))
+	for _, p := range list {
+		privileges[p.PrivId] = true
+	}
+
+	var privIDs []string
+	seen := make(map[string]bool)
+
+	for _, id := range ids {
+		expand := []string{id}
+
+		if !privileges[id] {
+			expand = privilegeGroupIDs(list, id)
+			if len(expand) == 0 {
+				return -1, fmt.Errorf("privilege %q not found", id)
+			}
+		}
+
+		for _, p := range expand {
+			if !seen[p] {
+				seen[p] = true
+				privIDs = append(privIDs, p)
+			}
+		}
+	}
+
+	return m.AddRole(ctx, name, privIDs)
+}
+
+// ListPermissions returns the permissions defined on the given entity and those inherited from its ancestors.
+func (m AuthorizationManager) ListPermissions(ctx context.Context, entity types.ManagedObjectReference) ([]types.Permission, error) {
+	return m.RetrieveEntityPermissions(ctx, entity, true)
+}
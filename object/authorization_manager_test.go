@@ -18,6 +18,7 @@ package object_test
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/vmware/govmomi/object"
@@ -60,3 +61,76 @@ func TestAuthorizationManagerPrivilege(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthorizationManagerCreateRole(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		m := object.NewAuthorizationManager(c)
+
+		ids, err := m.PrivilegeIDs(ctx, "VirtualMachine.Interact")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) == 0 {
+			t.Fatal("no privileges")
+		}
+		for _, id := range ids {
+			if !strings.HasPrefix(id, "VirtualMachine.Interact.") {
+				t.Errorf("id=%s", id)
+			}
+		}
+
+		if _, err = m.PrivilegeIDs(ctx, "VirtualMachine.Nope"); err == nil {
+			t.Error("expected error")
+		}
+
+		if _, err = m.CreateRole(ctx, "invalid", []string{"Nope.Nope"}); err == nil {
+			t.Error("expected error")
+		}
+
+		id, err := m.CreateRole(ctx, "operator", []string{"VirtualMachine.Interact", "VirtualMachine.Interact.PowerOn", "System.View"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		roles, err := m.RoleList(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		role := roles.ById(id)
+		if role == nil || role.Name != "operator" {
+			t.Fatalf("role=%v", role)
+		}
+		if len(role.Privilege) < len(ids)+1 {
+			t.Errorf("privileges=%v", role.Privilege)
+		}
+
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+
+		perm := types.Permission{
+			Principal: "operator@vsphere.local",
+			RoleId:    id,
+			Propagate: true,
+		}
+		if err = m.SetEntityPermissions(ctx, vm, []types.Permission{perm}); err != nil {
+			t.Fatal(err)
+		}
+
+		perms, err := m.ListPermissions(ctx, vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found, inherited bool
+		for _, p := range perms {
+			if p.Principal == perm.Principal && p.RoleId == id && *p.Entity == vm {
+				found = true
+			}
+			if *p.Entity == c.ServiceContent.RootFolder {
+				inherited = true
+			}
+		}
+		if !found || !inherited {
+			t.Errorf("permissions=%v", perms)
+		}
+	})
+}
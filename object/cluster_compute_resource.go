@@ -18,6 +18,8 @@ package object
 
 import (
 	"context"
+	"fmt"
+	"path"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -69,6 +71,56 @@ func (c ClusterComputeResource) AddHost(ctx context.Context, spec types.HostConn
 	return NewTask(c.c, res.Returnval), nil
 }
 
+// AddHostAndWait adds a host to the cluster and waits for the AddHost task to complete, returning the new HostSystem.
+// If the host certificate cannot be verified and acceptThumbprint is true, the request is retried once
+// with spec.SslThumbprint set to the thumbprint reported by the server.
+// Note that auto-accepting the thumbprint means trusting whichever host answers at spec.HostName,
+// leaving the connection open to a man-in-the-middle. Only enable it on trusted networks,
+// otherwise verify the thumbprint out-of-band and set spec.SslThumbprint.
+// If acceptThumbprint is false, a certificate verification failure returns an *SSLVerifyError with the reported thumbprint.
+func (c ClusterComputeResource) AddHostAndWait(ctx context.Context, spec types.HostConnectSpec, asConnected bool, acceptThumbprint bool) (*HostSystem, error) {
+	for attempt := 0; ; attempt++ {
+		task, err := c.AddHost(ctx, spec, asConnected, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			err = sslVerifyError(spec.HostName, err)
+			if verr, ok := err.(*SSLVerifyError); ok && acceptThumbprint && attempt == 0 {
+				spec.SslThumbprint = verr.Thumbprint
+				continue
+			}
+			return nil, err
+		}
+
+		host := NewHostSystem(c.c, info.Result.(types.ManagedObjectReference))
+		if c.InventoryPath != "" {
+			host.InventoryPath = path.Join(c.InventoryPath, spec.HostName)
+		}
+
+		return host, nil
+	}
+}
+
+// QueryHostConnectionInfo validates the given connection spec against a host before adding it to the cluster,
+// via Datacenter.QueryConnectionInfo with the cluster's Datacenter.
+func (c ClusterComputeResource) QueryHostConnectionInfo(ctx context.Context, spec types.HostConnectSpec) (*types.HostConnectInfo, error) {
+	entities, err := mo.Ancestors(ctx, c.c, c.c.ServiceContent.PropertyCollector, c.Reference())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range entities {
+		if e.Self.Type == "Datacenter" {
+			return NewDatacenter(c.c, e.Self).QueryConnectionInfo(ctx, spec)
+		}
+	}
+
+	return nil, fmt.Errorf("datacenter of %s not found", c.Reference())
+}
+
 func (c ClusterComputeResource) MoveInto(ctx context.Context, hosts ...*HostSystem) (*Task, error) {
 	req := types.MoveInto_Task{
 		This: c.Reference(),
@@ -101,3 +153,43 @@ func (c ClusterComputeResource) PlaceVm(ctx context.Context, spec types.Placemen
 
 	return &res.Returnval, nil
 }
+
+// Recommendations returns the current DRS recommendations for the cluster.
+func (c ClusterComputeResource) Recommendations(ctx context.Context) ([]types.ClusterRecommendation, error) {
+	var obj mo.ClusterComputeResource
+
+	err := c.Properties(ctx, c.Reference(), []string{"recommendation"}, &obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.Recommendation, nil
+}
+
+// ApplyRecommendation applies the DRS recommendation with the given key.
+func (c ClusterComputeResource) ApplyRecommendation(ctx context.Context, key string) error {
+	req := types.ApplyRecommendation{
+		This: c.Reference(),
+		Key:  key,
+	}
+
+	_, err := methods.ApplyRecommendation(ctx, c.c, &req)
+	return err
+}
+
+// FilterRecommendations returns the recommendations that include an action of the given type,
+// such as types.ActionTypeMigrationV1 or types.ActionTypeVmPowerV1.
+func FilterRecommendations(recommendations []types.ClusterRecommendation, kind types.ActionType) []types.ClusterRecommendation {
+	var res []types.ClusterRecommendation
+
+	for _, r := range recommendations {
+		for _, action := range r.Action {
+			if action.GetClusterAction().Type == string(kind) {
+				res = append(res, r)
+				break
+			}
+		}
+	}
+
+	return res
+}
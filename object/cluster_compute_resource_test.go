@@ -14,7 +14,170 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package object
+package object_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
 
 // ComputeResource should implement the Reference interface.
-var _ Reference = ClusterComputeResource{}
+var _ object.Reference = object.ClusterComputeResource{}
+
+func TestClusterComputeResourceAddHostAndWait(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		ref := simulator.Map.Any("ClusterComputeResource").Reference()
+		cluster := object.NewClusterComputeResource(c, ref)
+
+		thumbprint := "AA:BB:CC"
+		attempts := 0
+
+		simulator.Override("AddHost_Task", func(ctx *simulator.Context, req types.AnyType) (soap.HasFault, bool) {
+			attempts++
+			if req.(*types.AddHost_Task).Spec.SslThumbprint == thumbprint {
+				return nil, false // add the host
+			}
+			task := simulator.CreateTask(ref, "addHost", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+				return nil, &types.SSLVerifyFault{Thumbprint: thumbprint}
+			})
+			return &methods.AddHost_TaskBody{
+				Res: &types.AddHost_TaskResponse{Returnval: task.Run(ctx)},
+			}, true
+		})
+
+		spec := types.HostConnectSpec{HostName: "host.example.com", UserName: "root", Password: "secret"}
+
+		_, err := cluster.AddHostAndWait(ctx, spec, true, false)
+		var verr *object.SSLVerifyError
+		if !errors.As(err, &verr) {
+			t.Fatalf("err=%#v", err)
+		}
+		if verr.Thumbprint != thumbprint {
+			t.Errorf("thumbprint=%s", verr.Thumbprint)
+		}
+
+		attempts = 0
+		host, err := cluster.AddHostAndWait(ctx, spec, true, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if attempts != 2 {
+			t.Errorf("attempts=%d", attempts)
+		}
+
+		name, err := host.ObjectName(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name != spec.HostName {
+			t.Errorf("name=%s", name)
+		}
+	})
+}
+
+func TestClusterComputeResourceQueryHostConnectionInfo(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		cluster := object.NewClusterComputeResource(c, simulator.Map.Any("ClusterComputeResource").Reference())
+
+		spec := types.HostConnectSpec{HostName: "host.example.com", UserName: "root", Password: "secret"}
+
+		info, err := cluster.QueryHostConnectionInfo(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Host.Config.Name != spec.HostName {
+			t.Errorf("name=%s", info.Host.Config.Name)
+		}
+
+		simulator.Override("QueryConnectionInfoViaSpec", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			return simulator.MethodFault(&types.SSLVerifyFault{Thumbprint: "AA:BB:CC"}), true
+		})
+
+		_, err = cluster.QueryHostConnectionInfo(ctx, spec)
+		var verr *object.SSLVerifyError
+		if !errors.As(err, &verr) {
+			t.Fatalf("err=%#v", err)
+		}
+		if verr.Thumbprint != "AA:BB:CC" {
+			t.Errorf("thumbprint=%s", verr.Thumbprint)
+		}
+	})
+}
+
+func TestClusterComputeResourceRecommendations(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		cluster := simulator.Map.Any("ClusterComputeResource").(*simulator.ClusterComputeResource)
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+		host := cluster.Host[0]
+
+		cluster.Recommendation = []types.ClusterRecommendation{
+			{
+				Key:  "1",
+				Type: "V1",
+				Action: []types.BaseClusterAction{
+					&types.ClusterMigrationAction{
+						ClusterAction: types.ClusterAction{Type: string(types.ActionTypeMigrationV1), Target: &vm},
+					},
+				},
+			},
+			{
+				Key:  "2",
+				Type: "V1",
+				Action: []types.BaseClusterAction{
+					&types.ClusterHostPowerAction{
+						ClusterAction: types.ClusterAction{Type: string(types.ActionTypeHostPowerV1), Target: &host},
+						OperationType: types.HostPowerOperationTypePowerOff,
+					},
+				},
+			},
+		}
+
+		obj := object.NewClusterComputeResource(c, cluster.Reference())
+
+		recs, err := obj.Recommendations(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(recs) != 2 {
+			t.Fatalf("%d recommendations", len(recs))
+		}
+
+		migrations := object.FilterRecommendations(recs, types.ActionTypeMigrationV1)
+		if len(migrations) != 1 || migrations[0].Key != "1" {
+			t.Errorf("migrations=%#v", migrations)
+		}
+
+		power := object.FilterRecommendations(recs, types.ActionTypeHostPowerV1)
+		if len(power) != 1 || power[0].Key != "2" {
+			t.Errorf("power=%#v", power)
+		}
+
+		if err = obj.ApplyRecommendation(ctx, "1"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = obj.ApplyRecommendation(ctx, "1"); err == nil {
+			t.Error("expected error")
+		}
+
+		recs, err = obj.Recommendations(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(recs) != 1 || recs[0].Key != "2" {
+			t.Errorf("recommendations=%#v", recs)
+		}
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -59,6 +60,11 @@ func (c Common) Reference() types.ManagedObjectReference {
 	return c.r
 }
 
+// ManagedObjectType returns the ManagedObjectType of this object's reference.
+func (c Common) ManagedObjectType() ManagedObjectType {
+	return ManagedObjectType(c.r.Type)
+}
+
 func (c Common) Client() *vim25.Client {
 	return c.c
 }
@@ -76,21 +82,30 @@ func (c *Common) SetInventoryPath(p string) {
 }
 
 // ObjectName fetches the mo.ManagedEntity.Name field via the property collector.
+// The object's reference Value (MOID) is returned if the object has no name,
+// such as managed objects that are not a ManagedEntity.
 func (c Common) ObjectName(ctx context.Context) (string, error) {
 	var content []types.ObjectContent
 
 	err := c.Properties(ctx, c.Reference(), []string{"name"}, &content)
 	if err != nil {
+		if soap.IsSoapFault(err) {
+			if _, ok := soap.ToSoapFault(err).VimFault().(types.InvalidProperty); ok {
+				return c.r.Value, nil
+			}
+		}
 		return "", err
 	}
 
 	for i := range content {
 		for _, prop := range content[i].PropSet {
-			return prop.Val.(string), nil
+			if name, ok := prop.Val.(string); ok && name != "" {
+				return name, nil
+			}
 		}
 	}
 
-	return "", nil
+	return c.r.Value, nil
 }
 
 // Properties is a wrapper for property.DefaultCollector().RetrieveOne()
@@ -125,6 +140,8 @@ func (c Common) Rename(ctx context.Context, name string) (*Task, error) {
 	return NewTask(c.c, res.Returnval), nil
 }
 
+// SetCustomValue sets the value of the custom field with the given name on this object.
+// The field must already be defined, see SetCustomValueCreate.
 func (c Common) SetCustomValue(ctx context.Context, key string, value string) error {
 	req := types.SetCustomValue{
 		This:  c.Reference(),
@@ -136,6 +153,94 @@ func (c Common) SetCustomValue(ctx context.Context, key string, value string) er
 	return err
 }
 
+// SetCustomValueCreate sets the value of the custom field with the given name on this object,
+// first defining the field for this object's type via the CustomFieldsManager if it does not exist.
+func (c Common) SetCustomValueCreate(ctx context.Context, key string, value string) error {
+	m, err := GetCustomFieldsManager(c.c)
+	if err != nil {
+		return err
+	}
+
+	fields, err := m.Field(ctx)
+	if err != nil {
+		return err
+	}
+
+	// FindKey matches a field of any type, only a global field or one defined for this object's type can be set
+	var def *types.CustomFieldDef
+	for i := range fields {
+		f := &fields[i]
+		if f.Name == key && (f.ManagedObjectType == "" || f.ManagedObjectType == c.r.Type) {
+			def = f
+			break
+		}
+	}
+
+	if def == nil {
+		def, err = m.Add(ctx, key, c.r.Type, nil, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	return m.Set(ctx, c.r, def.Key, value)
+}
+
+// ListCustomValues returns a map of custom field name to value for this object,
+// using the object's availableField and value properties.
+// Fields that are defined for the object's type, but not set, are not included.
+func (c Common) ListCustomValues(ctx context.Context) (map[string]string, error) {
+	var o mo.ExtensibleManagedObject
+
+	err := c.Properties(ctx, c.r, []string{"availableField", "value"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int32]string, len(o.AvailableField))
+	for _, def := range o.AvailableField {
+		names[def.Key] = def.Name
+	}
+
+	values := make(map[string]string, len(o.Value))
+	for _, val := range o.Value {
+		if v, ok := val.(*types.CustomFieldStringValue); ok {
+			if name, ok := names[v.Key]; ok {
+				values[name] = v.Value
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// GetCustomValue returns the value of the custom field with the given name on this object,
+// which is empty if the field is not set. ErrKeyNameNotFound is returned if the field is not defined for the object.
+func (c Common) GetCustomValue(ctx context.Context, key string) (string, error) {
+	var o mo.ExtensibleManagedObject
+
+	err := c.Properties(ctx, c.r, []string{"availableField", "value"}, &o)
+	if err != nil {
+		return "", err
+	}
+
+	for _, def := range o.AvailableField {
+		if def.Name != key {
+			continue
+		}
+
+		for _, val := range o.Value {
+			if v, ok := val.(*types.CustomFieldStringValue); ok && v.Key == def.Key {
+				return v.Value, nil
+			}
+		}
+
+		return "", nil
+	}
+
+	return "", ErrKeyNameNotFound
+}
+
 func ReferenceFromString(s string) *types.ManagedObjectReference {
 	var ref types.ManagedObjectReference
 	if !ref.FromString(s) {
@@ -146,3 +251,30 @@ func ReferenceFromString(s string) *types.ManagedObjectReference {
 	}
 	return nil
 }
+
+// Names returns a map of the given references to their name property.
+// The references can be of mixed managed entity types, such as the results of a search.
+func Names(ctx context.Context, c *vim25.Client, refs []types.ManagedObjectReference) (map[types.ManagedObjectReference]string, error) {
+	names := make(map[types.ManagedObjectReference]string, len(refs))
+	if len(refs) == 0 {
+		return names, nil
+	}
+
+	// Load ObjectContent rather than []mo.ManagedEntity, as types such as mo.Network shadow the ManagedEntity.Name field
+	var content []types.ObjectContent
+
+	err := property.DefaultCollector(c).Retrieve(ctx, refs, []string{"name"}, &content)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, o := range content {
+		for _, p := range o.PropSet {
+			if name, ok := p.Val.(string); ok && p.Name == "name" {
+				names[o.Obj] = name
+			}
+		}
+	}
+
+	return names, nil
+}
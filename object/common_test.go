@@ -23,6 +23,8 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestCommonName(t *testing.T) {
@@ -61,5 +63,172 @@ func TestObjectName(t *testing.T) {
 				t.Errorf("empty name for %s", ref.Reference())
 			}
 		}
+
+		// objects without a name property return the MOID
+		refs := []types.ManagedObjectReference{
+			c.ServiceContent.PropertyCollector,
+			*c.ServiceContent.SessionManager,
+		}
+
+		for _, ref := range refs {
+			name, err := object.NewCommon(c, ref).ObjectName(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if name != ref.Value {
+				t.Errorf("name=%s, expected %s", name, ref.Value)
+			}
+		}
+	})
+}
+
+func TestNames(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		kinds := []string{"VirtualMachine", "HostSystem", "ClusterComputeResource", "Network", "DistributedVirtualPortgroup"}
+
+		var refs []types.ManagedObjectReference
+		expect := make(map[types.ManagedObjectReference]string)
+
+		for _, kind := range kinds {
+			obj := simulator.Map.Any(kind).(mo.Entity)
+			refs = append(refs, obj.Reference())
+			expect[obj.Reference()] = obj.Entity().Name
+		}
+
+		names, err := object.Names(ctx, c, refs)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(names) != len(expect) {
+			t.Errorf("names=%v", names)
+		}
+
+		for ref, name := range expect {
+			if names[ref] != name {
+				t.Errorf("%s name=%q, expected %q", ref, names[ref], name)
+			}
+		}
+
+		names, err = object.Names(ctx, c, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != 0 {
+			t.Errorf("names=%v", names)
+		}
+	})
+}
+
+func TestManagedObjectType(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		switch vm.ManagedObjectType() {
+		case object.TypeVirtualMachine:
+		default:
+			t.Errorf("type=%s", vm.ManagedObjectType())
+		}
+
+		if object.TypeOf(vm) != object.TypeVirtualMachine {
+			t.Errorf("type=%s", object.TypeOf(vm))
+		}
+
+		if err := object.TypeVirtualMachine.Check(vm); err != nil {
+			t.Error(err)
+		}
+		if err := object.TypeHostSystem.Check(vm); err == nil {
+			t.Error("expected error")
+		}
+		if err := object.TypeVirtualMachine.Check(types.ManagedObjectReference{Type: "VirtualMachine"}); err == nil {
+			t.Error("expected error")
+		}
+
+		for _, kind := range []object.ManagedObjectType{object.TypeDatastore, object.TypeTask} {
+			if !kind.IsKnown() {
+				t.Errorf("%s should be known", kind)
+			}
+		}
+		if object.ManagedObjectType("VirtualMachien").IsKnown() {
+			t.Error("typo should not be known")
+		}
+	})
+}
+
+func TestNewReference(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewReference(c, simulator.Map.Any("VirtualMachine").Reference())
+		if _, ok := vm.(*object.VirtualMachine); !ok {
+			t.Errorf("%T", vm)
+		}
+
+		host := object.NewReference(c, simulator.Map.Any("HostSystem").Reference())
+		if _, ok := host.(*object.HostSystem); !ok {
+			t.Errorf("%T", host)
+		}
+
+		ref := types.ManagedObjectReference{Type: "VirtualMachineSnapshot", Value: "snapshot-1"}
+		obj := object.NewReference(c, ref)
+		if _, ok := obj.(*object.Common); !ok {
+			t.Errorf("%T", obj)
+		}
+		if obj.Reference() != ref {
+			t.Errorf("ref=%s", obj.Reference())
+		}
+	})
+}
+
+func TestCommonCustomValues(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, simulator.Map.Any("HostSystem").Reference())
+
+		if _, err := host.GetCustomValue(ctx, "owner"); err != object.ErrKeyNameNotFound {
+			t.Errorf("err=%v", err)
+		}
+
+		for _, val := range []string{"alice", "bob"} {
+			if err := host.SetCustomValueCreate(ctx, "owner", val); err != nil {
+				t.Fatal(err)
+			}
+
+			v, err := host.GetCustomValue(ctx, "owner")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if v != val {
+				t.Errorf("owner=%q, expected %q", v, val)
+			}
+		}
+
+		m := object.NewCustomFieldsManager(c)
+		if _, err := m.Add(ctx, "team", "HostSystem", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := host.GetCustomValue(ctx, "team")
+		if err != nil || v != "" {
+			t.Errorf("team=%q, err=%v", v, err)
+		}
+
+		values, err := host.ListCustomValues(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 1 || values["owner"] != "bob" {
+			t.Errorf("values=%v", values)
+		}
+
+		// a field with the same name defined for another type is not used
+		if _, err = m.Add(ctx, "location", "VirtualMachine", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+		if err = host.SetCustomValueCreate(ctx, "location", "rack-1"); err != nil {
+			t.Fatal(err)
+		}
+		v, err = host.GetCustomValue(ctx, "location")
+		if err != nil || v != "rack-1" {
+			t.Errorf("location=%q, err=%v", v, err)
+		}
 	})
 }
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// CustomizationNIC is the IP configuration of a network adapter, in the order of the VirtualMachine's adapters.
+type CustomizationNIC struct {
+	MacAddress string   // MacAddress optionally maps the settings to an adapter by MAC address
+	IP         string   // IP is a static IPv4 address, DHCP is used if empty
+	SubnetMask string   // SubnetMask is required with a static IP
+	Gateway    []string // Gateway list
+	DNSServers []string // DNSServers list, Windows only, see LinuxCustomization.DNSServers for Linux
+}
+
+func (nic CustomizationNIC) mapping() types.CustomizationAdapterMapping {
+	m := types.CustomizationAdapterMapping{
+		MacAddress: nic.MacAddress,
+		Adapter: types.CustomizationIPSettings{
+			SubnetMask:    nic.SubnetMask,
+			Gateway:       nic.Gateway,
+			DnsServerList: nic.DNSServers,
+		},
+	}
+
+	if nic.IP == "" {
+		m.Adapter.Ip = new(types.CustomizationDhcpIpGenerator)
+	} else {
+		m.Adapter.Ip = &types.CustomizationFixedIp{IpAddress: nic.IP}
+	}
+
+	return m
+}
+
+func customizationName(name string) types.BaseCustomizationName {
+	if name == "" {
+		return new(types.CustomizationVirtualMachineName)
+	}
+	return &types.CustomizationFixedName{Name: name}
+}
+
+func customizationPassword(password string) *types.CustomizationPassword {
+	if password == "" {
+		return nil
+	}
+	return &types.CustomizationPassword{Value: password, PlainText: true}
+}
+
+// LinuxCustomization is a simplified form of a Linux guest customization spec.
+type LinuxCustomization struct {
+	HostName    string // HostName defaults to the VirtualMachine name if empty
+	Domain      string
+	TimeZone    string // TimeZone such as "America/Los_Angeles"
+	DNSServers  []string
+	DNSSuffixes []string
+	NICs        []CustomizationNIC
+}
+
+// Spec returns the CustomizationSpec for use with VirtualMachine.Customize or a clone spec.
+func (c LinuxCustomization) Spec() types.CustomizationSpec {
+	spec := types.CustomizationSpec{
+		Identity: &types.CustomizationLinuxPrep{
+			HostName: customizationName(c.HostName),
+			Domain:   c.Domain,
+			TimeZone: c.TimeZone,
+		},
+		GlobalIPSettings: types.CustomizationGlobalIPSettings{
+			DnsServerList: c.DNSServers,
+			DnsSuffixList: c.DNSSuffixes,
+		},
+	}
+
+	for _, nic := range c.NICs {
+		nic.DNSServers = nil // global settings only on Linux
+		spec.NicSettingMap = append(spec.NicSettingMap, nic.mapping())
+	}
+
+	return spec
+}
+
+// WindowsCustomization is a simplified form of a Windows sysprep guest customization spec.
+// The computer joins JoinDomain if set, otherwise the Workgroup.
+type WindowsCustomization struct {
+	ComputerName        string // ComputerName defaults to the VirtualMachine name if empty
+	FullName            string // FullName of the end user, defaults to "Administrator"
+	OrgName             string // OrgName of the end user, defaults to "Organization"
+	ProductID           string
+	AdminPassword       string
+	TimeZone            int32 // TimeZone index, such as 4 for Pacific Time
+	Workgroup           string
+	JoinDomain          string
+	DomainAdmin         string
+	DomainAdminPassword string
+	DNSSuffixes         []string
+	NICs                []CustomizationNIC
+}
+
+// Spec returns the CustomizationSpec for use with VirtualMachine.Customize or a clone spec.
+func (c WindowsCustomization) Spec() types.CustomizationSpec {
+	sysprep := &types.CustomizationSysprep{
+		GuiUnattended: types.CustomizationGuiUnattended{
+			Password: customizationPassword(c.AdminPassword),
+			TimeZone: c.TimeZone,
+		},
+		UserData: types.CustomizationUserData{
+			FullName:     c.FullName,
+			OrgName:      c.OrgName,
+			ComputerName: customizationName(c.ComputerName),
+			ProductId:    c.ProductID,
+		},
+	}
+
+	if sysprep.UserData.FullName == "" {
+		sysprep.UserData.FullName = "Administrator"
+	}
+	if sysprep.UserData.OrgName == "" {
+		sysprep.UserData.OrgName = "Organization"
+	}
+
+	if c.JoinDomain != "" {
+		sysprep.Identification = types.CustomizationIdentification{
+			JoinDomain:          c.JoinDomain,
+			DomainAdmin:         c.DomainAdmin,
+			DomainAdminPassword: customizationPassword(c.DomainAdminPassword),
+		}
+	} else {
+		sysprep.Identification.JoinWorkgroup = c.Workgroup
+		if sysprep.Identification.JoinWorkgroup == "" {
+			sysprep.Identification.JoinWorkgroup = "WORKGROUP"
+		}
+	}
+
+	spec := types.CustomizationSpec{
+		Identity: sysprep,
+		GlobalIPSettings: types.CustomizationGlobalIPSettings{
+			DnsSuffixList: c.DNSSuffixes,
+		},
+	}
+
+	for _, nic := range c.NICs {
+		spec.NicSettingMap = append(spec.NicSettingMap, nic.mapping())
+	}
+
+	return spec
+}
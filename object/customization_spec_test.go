@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestLinuxCustomizationSpec(t *testing.T) {
+	spec := LinuxCustomization{
+		DNSServers: []string{"10.0.0.2"},
+		NICs: []CustomizationNIC{
+			{},
+			{IP: "10.0.0.42", SubnetMask: "255.255.255.0", DNSServers: []string{"ignored"}},
+		},
+	}.Spec()
+
+	prep := spec.Identity.(*types.CustomizationLinuxPrep)
+	if _, ok := prep.HostName.(*types.CustomizationVirtualMachineName); !ok {
+		t.Errorf("hostname=%T", prep.HostName)
+	}
+
+	if len(spec.NicSettingMap) != 2 {
+		t.Fatalf("nics=%d", len(spec.NicSettingMap))
+	}
+	if _, ok := spec.NicSettingMap[0].Adapter.Ip.(*types.CustomizationDhcpIpGenerator); !ok {
+		t.Errorf("ip=%T", spec.NicSettingMap[0].Adapter.Ip)
+	}
+	nic := spec.NicSettingMap[1].Adapter
+	if ip, ok := nic.Ip.(*types.CustomizationFixedIp); !ok || ip.IpAddress != "10.0.0.42" {
+		t.Errorf("ip=%#v", nic.Ip)
+	}
+	if len(nic.DnsServerList) != 0 {
+		t.Errorf("dns=%v", nic.DnsServerList)
+	}
+	if len(spec.GlobalIPSettings.DnsServerList) != 1 {
+		t.Errorf("dns=%v", spec.GlobalIPSettings.DnsServerList)
+	}
+}
+
+func TestWindowsCustomizationSpec(t *testing.T) {
+	spec := WindowsCustomization{
+		ComputerName:  "win",
+		AdminPassword: "secret",
+	}.Spec()
+
+	sysprep := spec.Identity.(*types.CustomizationSysprep)
+	if name, ok := sysprep.UserData.ComputerName.(*types.CustomizationFixedName); !ok || name.Name != "win" {
+		t.Errorf("name=%#v", sysprep.UserData.ComputerName)
+	}
+	if sysprep.UserData.FullName == "" || sysprep.UserData.OrgName == "" {
+		t.Errorf("user=%#v", sysprep.UserData)
+	}
+	if p := sysprep.GuiUnattended.Password; p == nil || !p.PlainText || p.Value != "secret" {
+		t.Errorf("password=%#v", p)
+	}
+	if sysprep.Identification.JoinWorkgroup != "WORKGROUP" {
+		t.Errorf("identification=%#v", sysprep.Identification)
+	}
+
+	spec = WindowsCustomization{
+		JoinDomain:          "example.com",
+		DomainAdmin:         "admin",
+		DomainAdminPassword: "secret",
+	}.Spec()
+
+	id := spec.Identity.(*types.CustomizationSysprep).Identification
+	if id.JoinWorkgroup != "" || id.JoinDomain != "example.com" || id.DomainAdminPassword == nil {
+		t.Errorf("identification=%#v", id)
+	}
+}
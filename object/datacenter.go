@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/task"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -89,22 +91,139 @@ func (d Datacenter) Destroy(ctx context.Context) (*Task, error) {
 	return NewTask(d.c, res.Returnval), nil
 }
 
-// PowerOnVM powers on multiple virtual machines with a single vCenter call.
-// If called against ESX, serially powers on the list of VMs and the returned *Task will always be nil.
-func (d Datacenter) PowerOnVM(ctx context.Context, vm []types.ManagedObjectReference, option ...types.BaseOptionValue) (*Task, error) {
-	if d.Client().IsVC() {
-		req := types.PowerOnMultiVM_Task{
-			This:   d.Reference(),
-			Vm:     vm,
-			Option: option,
+// retrieve retrieves the given properties of all objects of the given type within the Datacenter, via a ContainerView.
+func (d Datacenter) retrieve(ctx context.Context, kind string, ps []string, dst interface{}) error {
+	req := types.CreateContainerView{
+		This:      *d.c.ServiceContent.ViewManager,
+		Container: d.Reference(),
+		Type:      []string{kind},
+		Recursive: true,
+	}
+
+	res, err := methods.CreateContainerView(ctx, d.c, &req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, _ = methods.DestroyView(ctx, d.c, &types.DestroyView{This: res.Returnval})
+	}()
+
+	spec := new(property.FilterSpec).
+		Object(res.Returnval, true).
+		Traverse("view", "ContainerView", "view").
+		Properties(kind, ps...)
+
+	return property.DefaultCollector(d.c).RetrieveFiltered(ctx, spec, dst)
+}
+
+// PowerOffAndDestroyVMs powers off and destroys all VirtualMachines within the Datacenter, including templates.
+func (d Datacenter) PowerOffAndDestroyVMs(ctx context.Context) error {
+	var vms []mo.VirtualMachine
+
+	err := d.retrieve(ctx, "VirtualMachine", []string{"runtime.powerState"}, &vms)
+	if err != nil {
+		return err
+	}
+
+	for _, vm := range vms {
+		obj := NewVirtualMachine(d.c, vm.Self)
+
+		if vm.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+			task, err := obj.PowerOff(ctx)
+			if err != nil {
+				return err
+			}
+
+			err = task.Wait(ctx)
+			if err != nil {
+				// Ignore any InvalidPowerState fault, as it indicates the VM is already powered off
+				if f, ok := err.(types.HasFault); ok {
+					if _, ok = f.Fault().(*types.InvalidPowerState); !ok {
+						return err
+					}
+				}
+			}
 		}
 
-		res, err := methods.PowerOnMultiVM_Task(ctx, d.c, &req)
+		task, err := obj.Destroy(ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		return NewTask(d.c, res.Returnval), nil
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Teardown destroys the Datacenter after first removing its contents in dependency order:
+// VirtualMachines are powered off and destroyed, connected cluster hosts are placed in maintenance mode and removed,
+// standalone hosts are removed via their ComputeResource, and clusters are destroyed.
+func (d Datacenter) Teardown(ctx context.Context) error {
+	wait := func(task *Task, err error) error {
+		if err != nil {
+			return err
+		}
+		return task.Wait(ctx)
+	}
+
+	if err := d.PowerOffAndDestroyVMs(ctx); err != nil {
+		return err
+	}
+
+	var hosts []mo.HostSystem
+
+	err := d.retrieve(ctx, "HostSystem", []string{"parent", "runtime"}, &hosts)
+	if err != nil {
+		return err
+	}
+
+	for _, host := range hosts {
+		if host.Parent.Type == "ComputeResource" {
+			// A standalone HostSystem can only be removed via its parent ComputeResource
+			if err = wait(NewComputeResource(d.c, *host.Parent).Destroy(ctx)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		obj := NewHostSystem(d.c, host.Self)
+
+		if host.Runtime.ConnectionState == types.HostSystemConnectionStateConnected && !host.Runtime.InMaintenanceMode {
+			if err = wait(obj.EnterMaintenanceMode(ctx, 0, false, nil)); err != nil {
+				return err
+			}
+		}
+
+		if err = wait(obj.Destroy(ctx)); err != nil {
+			return err
+		}
+	}
+
+	var clusters []mo.ClusterComputeResource
+
+	err = d.retrieve(ctx, "ClusterComputeResource", []string{"name"}, &clusters)
+	if err != nil {
+		return err
+	}
+
+	for _, cluster := range clusters {
+		if err = wait(NewClusterComputeResource(d.c, cluster.Self).Destroy(ctx)); err != nil {
+			return err
+		}
+	}
+
+	return wait(d.Destroy(ctx))
+}
+
+// PowerOnVM powers on multiple virtual machines with a single vCenter call.
+// If called against ESX, serially powers on the list of VMs and the returned *Task will always be nil.
+func (d Datacenter) PowerOnVM(ctx context.Context, vm []types.ManagedObjectReference, option ...types.BaseOptionValue) (*Task, error) {
+	if d.Client().IsVC() {
+		return d.powerOnMultiVM(ctx, vm, option)
 	}
 
 	for _, ref := range vm {
@@ -127,3 +246,102 @@ func (d Datacenter) PowerOnVM(ctx context.Context, vm []types.ManagedObjectRefer
 
 	return nil, nil
 }
+
+// PowerOnMultiVM powers on multiple virtual machines via the vCenter PowerOnMultiVM_Task method.
+// The task result is a types.ClusterPowerOnVmResult, see PowerOnMultiVMResults.
+// If the vm list is empty, no request is made and the returned *Task is nil.
+func (d Datacenter) PowerOnMultiVM(ctx context.Context, vm []types.ManagedObjectReference, option []types.BaseOptionValue) (*Task, error) {
+	if len(vm) == 0 {
+		return nil, nil
+	}
+
+	return d.powerOnMultiVM(ctx, vm, option)
+}
+
+func (d Datacenter) powerOnMultiVM(ctx context.Context, vm []types.ManagedObjectReference, option []types.BaseOptionValue) (*Task, error) {
+	req := types.PowerOnMultiVM_Task{
+		This:   d.Reference(),
+		Vm:     vm,
+		Option: option,
+	}
+
+	res, err := methods.PowerOnMultiVM_Task(ctx, d.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.c, res.Returnval), nil
+}
+
+// PowerOnMultiVMResult is the power on outcome of a single VirtualMachine via PowerOnMultiVM.
+type PowerOnMultiVMResult struct {
+	VirtualMachine types.ManagedObjectReference
+	// Task is the VirtualMachine power on task, nil if power on was not attempted.
+	Task *Task
+	// Err is set if power on was not attempted or the power on task failed.
+	Err error
+}
+
+// PowerOnMultiVMResults waits for the given PowerOnMultiVM task and for the power on task of each attempted VirtualMachine,
+// returning the outcome per VirtualMachine.
+// If the task is nil, as returned by PowerOnMultiVM with an empty list, the result is empty.
+func (d Datacenter) PowerOnMultiVMResults(ctx context.Context, t *Task) ([]PowerOnMultiVMResult, error) {
+	if t == nil {
+		return nil, nil
+	}
+
+	info, err := t.WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res *types.ClusterPowerOnVmResult
+
+	switch r := info.Result.(type) {
+	case types.ClusterPowerOnVmResult:
+		res = &r
+	case *types.ClusterPowerOnVmResult:
+		res = r
+	default:
+		return nil, fmt.Errorf("unexpected %s result type: %T", t.Reference(), info.Result)
+	}
+
+	var results []PowerOnMultiVMResult
+
+	for _, vm := range res.Attempted {
+		r := PowerOnMultiVMResult{VirtualMachine: vm.Vm}
+		if vm.Task != nil {
+			r.Task = NewTask(d.c, *vm.Task)
+			r.Err = r.Task.Wait(ctx)
+		}
+		results = append(results, r)
+	}
+
+	for i := range res.NotAttempted {
+		vm := res.NotAttempted[i]
+		results = append(results, PowerOnMultiVMResult{
+			VirtualMachine: vm.Vm,
+			Err:            task.Error{LocalizedMethodFault: &vm.Fault},
+		})
+	}
+
+	return results, nil
+}
+
+// QueryConnectionInfo validates the given connection spec against the host, without adding the host to inventory.
+// The result includes the host's summary, VMs and other info needed to build an AddHost spec.
+// If spec.SslThumbprint is empty or does not match the host certificate, the error is of type *SSLVerifyError,
+// which includes the thumbprint reported by the server.
+func (d Datacenter) QueryConnectionInfo(ctx context.Context, spec types.HostConnectSpec) (*types.HostConnectInfo, error) {
+	req := types.QueryConnectionInfoViaSpec{
+		This: d.Reference(),
+		Spec: spec,
+	}
+
+	res, err := methods.QueryConnectionInfoViaSpec(ctx, d.c, &req)
+	if err != nil {
+		return nil, sslVerifyError(spec.HostName, err)
+	}
+
+	return &res.Returnval, nil
+}
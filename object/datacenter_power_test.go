@@ -0,0 +1,53 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestDatacenterPowerOnVMEmpty(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		dc, err := find.NewFinder(c).DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// PowerOnVM always returns a task when connected to vCenter
+		task, err := dc.PowerOnVM(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if task == nil {
+			t.Fatal("nil task")
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		// PowerOnMultiVM makes no request for an empty list
+		task, err = dc.PowerOnMultiVM(ctx, nil, nil)
+		if err != nil || task != nil {
+			t.Errorf("task=%v, err=%v", task, err)
+		}
+	})
+}
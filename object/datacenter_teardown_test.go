@@ -0,0 +1,64 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+func TestDatacenterTeardown(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		if err = dc.PowerOffAndDestroyVMs(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		vms, err := finder.VirtualMachineList(ctx, "*")
+		if err == nil || len(vms) != 0 {
+			t.Errorf("vms=%d, err=%v", len(vms), err)
+		}
+
+		if err = dc.Teardown(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, kind := range []string{"HostSystem", "ComputeResource", "ClusterComputeResource", "ResourcePool"} {
+			if obj := simulator.Map.Any(kind); obj != nil {
+				t.Errorf("%s not removed", obj.Reference())
+			}
+		}
+
+		_, err = object.NewCommon(c, dc.Reference()).ObjectName(ctx)
+		if !soap.IsSoapFault(err) {
+			t.Errorf("expected %s to be destroyed, err=%v", dc.Reference(), err)
+		}
+	})
+}
@@ -30,7 +30,9 @@ import (
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/session"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/progress"
 	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -300,13 +302,64 @@ func (d Datastore) UploadFile(ctx context.Context, file string, path string, par
 	return d.Client().UploadFile(ctx, file, u, p)
 }
 
-// Download via soap.Download with an http service ticket
+// Download via soap.Download with an http service ticket.
+// If param.Progress is set, reads from the returned io.ReadCloser are reported to the progress.Sinker,
+// which is marked as done when the io.ReadCloser is closed.
 func (d Datastore) Download(ctx context.Context, path string, param *soap.Download) (io.ReadCloser, int64, error) {
 	u, p, err := d.downloadTicket(ctx, path, param)
 	if err != nil {
 		return nil, 0, err
 	}
-	return d.Client().Download(ctx, u, p)
+
+	rc, size, err := d.Client().Download(ctx, u, p)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if p.Progress != nil {
+		rc = newProgressReadCloser(ctx, p.Progress, rc, size)
+	}
+
+	return rc, size, nil
+}
+
+// progressReadCloser reports progress of reads from an io.ReadCloser,
+// marking the progress.Sinker as done on Close.
+type progressReadCloser struct {
+	io.Closer
+	pr interface {
+		io.Reader
+		Done(error)
+	}
+	err  error
+	done bool
+}
+
+func newProgressReadCloser(ctx context.Context, s progress.Sinker, rc io.ReadCloser, size int64) *progressReadCloser {
+	return &progressReadCloser{
+		Closer: rc,
+		pr:     progress.NewReader(ctx, s, rc, size),
+	}
+}
+
+func (r *progressReadCloser) Read(b []byte) (int, error) {
+	n, err := r.pr.Read(b)
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
+}
+
+func (r *progressReadCloser) Close() error {
+	err := r.Closer.Close()
+	if !r.done {
+		r.done = true
+		if r.err == nil {
+			r.err = err
+		}
+		r.pr.Done(r.err)
+	}
+	return err
 }
 
 // DownloadFile via soap.Download with an http service ticket
@@ -387,6 +440,16 @@ func (d Datastore) AttachedClusterHosts(ctx context.Context, cluster *ComputeRes
 }
 
 func (d Datastore) Stat(ctx context.Context, file string) (types.BaseFileInfo, error) {
+	p := DatastorePath{Datastore: d.Name(), Path: file}
+	p.FromString(file) // file may already be in "[datastore] path" format
+
+	return d.stat(ctx, &p)
+}
+
+// stat searches for p via this Datastore's browser, p.Datastore must be the name of this Datastore.
+func (d Datastore) stat(ctx context.Context, p *DatastorePath) (types.BaseFileInfo, error) {
+	file := p.Path
+
 	b, err := d.Browser(ctx)
 	if err != nil {
 		return nil, err
@@ -402,7 +465,7 @@ func (d Datastore) Stat(ctx context.Context, file string) (types.BaseFileInfo, e
 		MatchPattern: []string{path.Base(file)},
 	}
 
-	dsPath := d.Path(path.Dir(file))
+	dsPath := (&DatastorePath{Datastore: p.Datastore, Path: path.Dir(file)}).String()
 	task, err := b.SearchDatastore(ctx, dsPath, &spec)
 	if err != nil {
 		return nil, err
@@ -421,13 +484,181 @@ func (d Datastore) Stat(ctx context.Context, file string) (types.BaseFileInfo, e
 	res := info.Result.(types.HostDatastoreBrowserSearchResults)
 	if len(res.File) == 0 {
 		// File doesn't exist
-		return nil, DatastoreNoSuchFileError{"stat", d.Path(file)}
+		return nil, DatastoreNoSuchFileError{"stat", p.String()}
 	}
 
 	return res.File[0], nil
 
 }
 
+// MkdirAll creates the directory dsPath, along with any necessary parents.
+// dsPath may be relative to the Datastore or in "[datastore] path" format.
+// If dsPath is already a directory, MkdirAll does nothing and returns nil.
+// If dsPath exists but is not a directory, an error is returned.
+func (d Datastore) MkdirAll(ctx context.Context, dsPath string) error {
+	p, err := d.datastorePath(ctx, dsPath)
+	if err != nil {
+		return err
+	}
+
+	dc, err := d.datacenter(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = NewFileManager(d.c).MakeDirectory(ctx, p.String(), dc, true)
+	if err != nil {
+		if _, fault, ok := soap.FaultDetail(err); ok {
+			if _, exists := fault.(*types.FileAlreadyExists); exists {
+				info, serr := d.Stat(ctx, p.String())
+				if serr != nil {
+					return serr
+				}
+				if _, dir := info.(*types.FolderFileInfo); dir {
+					return nil
+				}
+				return fmt.Errorf("cannot mkdir '%s': Not a directory", p)
+			}
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveAll removes dsPath and any children it contains.
+// dsPath may be relative to the Datastore or in "[datastore] path" format.
+// If dsPath does not exist, RemoveAll returns nil.
+func (d Datastore) RemoveAll(ctx context.Context, dsPath string) error {
+	p, err := d.datastorePath(ctx, dsPath)
+	if err != nil {
+		return err
+	}
+
+	dc, err := d.datacenter(ctx)
+	if err != nil {
+		return err
+	}
+
+	task, err := NewFileManager(d.c).DeleteDatastoreFile(ctx, p.String(), dc)
+	if err != nil {
+		return err
+	}
+
+	err = task.Wait(ctx)
+	if err != nil {
+		if types.IsFileNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// datastorePath returns name as a DatastorePath, relative to the Datastore unless already in "[datastore] path" format.
+func (d Datastore) datastorePath(ctx context.Context, name string) (*DatastorePath, error) {
+	var p DatastorePath
+	if p.FromString(name) {
+		return &p, nil
+	}
+
+	ds := d.Name()
+	if ds == "" {
+		var err error
+		if ds, err = d.ObjectName(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DatastorePath{Datastore: ds, Path: name}, nil
+}
+
+// datastore returns the Datastore with the given name, which may be this Datastore or another in its Datacenter.
+func (d Datastore) datastore(ctx context.Context, name string) (*Datastore, error) {
+	if name == d.Name() {
+		return &d, nil
+	}
+
+	dc, err := d.datacenter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dss []mo.Datastore
+	if err = dc.retrieve(ctx, "Datastore", []string{"name"}, &dss); err != nil {
+		return nil, err
+	}
+
+	for _, ds := range dss {
+		if ds.Name == name {
+			return NewDatastore(d.c, ds.Self), nil
+		}
+	}
+
+	return nil, fmt.Errorf("datastore %q not found in %s", name, dc.Reference())
+}
+
+// transferFile validates that srcPath exists and starts a copy or move task to dstPath on dst.
+func (d Datastore) transferFile(ctx context.Context, verb string, srcPath string, dst *Datastore, dstPath string, force bool) (*Task, error) {
+	src, err := d.datastorePath(ctx, srcPath)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := dst.datastorePath(ctx, dstPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := d.datastore(ctx, src.Datastore)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = ds.stat(ctx, src); err != nil {
+		switch err.(type) {
+		case DatastoreNoSuchFileError, DatastoreNoSuchDirectoryError:
+			return nil, DatastoreNoSuchFileError{verb, src.String()}
+		}
+		return nil, err
+	}
+
+	srcDC, err := ds.datacenter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dstDC, err := dst.datacenter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := NewFileManager(d.c)
+
+	if verb == "move" {
+		return m.MoveDatastoreFile(ctx, src.String(), srcDC, target.String(), dstDC, force)
+	}
+
+	return m.CopyDatastoreFile(ctx, src.String(), srcDC, target.String(), dstDC, force)
+}
+
+// CopyFileTo copies srcPath on this Datastore to dstPath on the dst Datastore, which may be in another Datacenter.
+// Paths may be relative to their Datastore or in "[datastore] path" format.
+// An existing file at dstPath is overwritten only if force is true.
+// DatastoreNoSuchFileError is returned if srcPath does not exist.
+func (d Datastore) CopyFileTo(ctx context.Context, srcPath string, dst *Datastore, dstPath string, force bool) (*Task, error) {
+	return d.transferFile(ctx, "copy", srcPath, dst, dstPath, force)
+}
+
+// MoveFileTo moves srcPath on this Datastore to dstPath on the dst Datastore, which may be in another Datacenter.
+// Paths may be relative to their Datastore or in "[datastore] path" format.
+// An existing file at dstPath is overwritten only if force is true.
+// DatastoreNoSuchFileError is returned if srcPath does not exist.
+func (d Datastore) MoveFileTo(ctx context.Context, srcPath string, dst *Datastore, dstPath string, force bool) (*Task, error) {
+	return d.transferFile(ctx, "move", srcPath, dst, dstPath, force)
+}
+
 // Type returns the type of file system volume.
 func (d Datastore) Type(ctx context.Context) (types.HostFileSystemVolumeFileSystemType, error) {
 	var mds mo.Datastore
@@ -437,3 +668,81 @@ func (d Datastore) Type(ctx context.Context) (types.HostFileSystemVolumeFileSyst
 	}
 	return types.HostFileSystemVolumeFileSystemType(mds.Summary.Type), nil
 }
+
+// EnterMaintenanceMode requests the Datastore enter maintenance mode.
+// Rather than a Task, the result is a StoragePlacementResult: when Storage DRS is enabled,
+// the result may include Recommendations to evacuate VMs from the Datastore, which must be applied via
+// StorageResourceManager.ApplyStorageDrsRecommendation before the Datastore can enter maintenance mode.
+// The result Task, if any, completes once the Datastore has entered maintenance mode.
+// See EnterMaintenanceModeAndWait, which handles both.
+func (d Datastore) EnterMaintenanceMode(ctx context.Context) (*types.StoragePlacementResult, error) {
+	req := types.DatastoreEnterMaintenanceMode{
+		This: d.Reference(),
+	}
+
+	res, err := methods.DatastoreEnterMaintenanceMode(ctx, d.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// EnterMaintenanceModeAndWait requests the Datastore enter maintenance mode, applies any Storage DRS
+// recommendations to evacuate the Datastore and waits for summary.maintenanceMode to be inMaintenance.
+func (d Datastore) EnterMaintenanceModeAndWait(ctx context.Context) error {
+	res, err := d.EnterMaintenanceMode(ctx)
+	if err != nil {
+		return err
+	}
+
+	if res.DrsFault != nil {
+		return fmt.Errorf("%s: enter maintenance mode: %s", d.Reference(), res.DrsFault.Reason)
+	}
+
+	if len(res.Recommendations) != 0 {
+		keys := make([]string, len(res.Recommendations))
+		for i := range res.Recommendations {
+			keys[i] = res.Recommendations[i].Key
+		}
+
+		task, err := NewStorageResourceManager(d.c).ApplyStorageDrsRecommendation(ctx, keys)
+		if err != nil {
+			return err
+		}
+
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	if res.Task != nil {
+		if err = NewTask(d.c, *res.Task).Wait(ctx); err != nil {
+			return err
+		}
+	}
+
+	p := property.DefaultCollector(d.c)
+	return property.Wait(ctx, p, d.Reference(), []string{"summary.maintenanceMode"}, func(pc []types.PropertyChange) bool {
+		for _, c := range pc {
+			if c.Name == "summary.maintenanceMode" && c.Val == string(types.DatastoreSummaryMaintenanceModeStateInMaintenance) {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// ExitMaintenanceMode requests the Datastore exit maintenance mode.
+func (d Datastore) ExitMaintenanceMode(ctx context.Context) (*Task, error) {
+	req := types.DatastoreExitMaintenanceMode_Task{
+		This: d.Reference(),
+	}
+
+	res, err := methods.DatastoreExitMaintenanceMode_Task(ctx, d.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(d.c, res.Returnval), nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+func TestDatastoreCopyMoveFileTo(t *testing.T) {
+	m := simulator.VPX()
+	m.Datastore = 2
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		src, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := finder.Datastore(ctx, "LocalDS_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// inventory path is not required
+		dst := object.NewDatastore(c, ds.Reference())
+
+		err = src.Upload(ctx, strings.NewReader("govmomi"), "file.txt", &soap.DefaultUpload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wait := func(task *object.Task, err error) error {
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		err = wait(src.CopyFileTo(ctx, "file.txt", dst, "copy.txt", false))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// dst exists and force=false
+		if err = wait(src.CopyFileTo(ctx, "file.txt", dst, "copy.txt", false)); err == nil {
+			t.Error("expected error")
+		}
+
+		err = wait(src.CopyFileTo(ctx, "[LocalDS_0] file.txt", dst, "[LocalDS_1] copy.txt", true))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = wait(src.MoveFileTo(ctx, "file.txt", dst, "move.txt", false))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, name := range []string{"copy.txt", "move.txt"} {
+			if _, err = ds.Stat(ctx, name); err != nil {
+				t.Error(err)
+			}
+		}
+
+		// source on another Datastore in the same Datacenter
+		err = wait(src.CopyFileTo(ctx, "[LocalDS_1] copy.txt", src, "back.txt", false))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = src.Stat(ctx, "back.txt"); err != nil {
+			t.Error(err)
+		}
+
+		_, err = src.CopyFileTo(ctx, "[LocalDS_1] back.txt", src, "copy.txt", true)
+		if _, ok := err.(object.DatastoreNoSuchFileError); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		if _, err = src.CopyFileTo(ctx, "[enoent] copy.txt", src, "copy.txt", true); err == nil {
+			t.Error("expected error")
+		}
+
+		_, err = src.CopyFileTo(ctx, "file.txt", dst, "copy.txt", true)
+		if _, ok := err.(object.DatastoreNoSuchFileError); !ok {
+			t.Errorf("err=%v", err)
+		}
+
+		_, err = src.MoveFileTo(ctx, "enoent/file.txt", dst, "move.txt", true)
+		if _, ok := err.(object.DatastoreNoSuchFileError); !ok {
+			t.Errorf("err=%v", err)
+		}
+	}, m)
+}
@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// DiskInfo describes a virtual disk, as returned by Datastore.DiskInfo.
+type DiskInfo struct {
+	Name      string            // Name is the datastore path of the disk descriptor
+	DiskType  string            // DiskType is the disk format, such as "thin" or, for hosted disks, "sparse2Gb"
+	Capacity  int64             // Capacity of the disk in bytes
+	Allocated int64             // Allocated size of the disk's data files in bytes, not including its parents
+	Chain     []VirtualDiskInfo // Chain of parent disks, starting with the immediate parent
+}
+
+// DiskInfo returns info for the virtual disk descriptor at the given path, including its chain of parent disks.
+// Both managed disks, where the datastore presents the descriptor and data files as one,
+// and hosted disks, where data is stored in separate extent files such as "disk-s001.vmdk", are supported.
+// An error is returned if vmdkPath is a data file, such as "disk-flat.vmdk", rather than the disk descriptor,
+// as determined by the datastore browser rather than by file name.
+func (d Datastore) DiskInfo(ctx context.Context, vmdkPath string) (*DiskInfo, error) {
+	var p DatastorePath
+	if !p.FromString(vmdkPath) {
+		p = DatastorePath{Datastore: d.Name(), Path: vmdkPath}
+	}
+
+	if err := d.checkDiskDescriptor(ctx, &p); err != nil {
+		return nil, err
+	}
+
+	dc, err := d.datacenter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	m := NewVirtualDiskManager(d.c)
+
+	chain, err := m.QueryVirtualDiskInfo(ctx, p.String(), dc, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 0 {
+		return nil, DatastoreNoSuchFileError{"query", p.String()}
+	}
+
+	info := &DiskInfo{
+		Name:     chain[0].Name,
+		DiskType: chain[0].DiskType,
+		Chain:    chain[1:],
+	}
+
+	files, err := d.diskFiles(ctx, p.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	info.Capacity, info.Allocated = diskSize(path.Base(p.Path), files)
+
+	return info, nil
+}
+
+// DiskStat describes a datastore file, as returned by Datastore.StatDisk.
+type DiskStat struct {
+	Name      string // Name is the datastore path of the file
+	FileSize  int64  // FileSize of the file as reported by Stat, for a hosted disk only the descriptor
+	Capacity  int64  // Capacity is the provisioned size of a virtual disk's flat extent in bytes, 0 for other files
+	Allocated int64  // Allocated size of the file and any disk data files in bytes, less than Capacity for a thin disk
+	Thin      bool   // Thin is true if the virtual disk is thin provisioned
+	DiskType  string // DiskType is the disk format of a virtual disk
+}
+
+// StatDisk is like Stat, but for a virtual disk descriptor also reports the size of its data files,
+// using a VmDiskFileQuery to get the disk capacity and whether or not it is thin provisioned.
+// Other file types are reported with an Allocated size equal to their FileSize.
+func (d Datastore) StatDisk(ctx context.Context, dsPath string) (*DiskStat, error) {
+	var p DatastorePath
+	if !p.FromString(dsPath) {
+		p = DatastorePath{Datastore: d.Name(), Path: dsPath}
+	}
+
+	if err := d.checkDiskDescriptor(ctx, &p); err != nil {
+		return nil, err
+	}
+
+	files, err := d.diskFiles(ctx, p.Path)
+	if err != nil {
+		if types.IsFileNotFound(err) {
+			// FileNotFound means the base path doesn't exist.
+			return nil, DatastoreNoSuchDirectoryError{"stat", d.Path(path.Dir(p.Path))}
+		}
+		return nil, err
+	}
+
+	stat := diskStat(path.Base(p.Path), files)
+	if stat == nil {
+		return nil, DatastoreNoSuchFileError{"stat", p.String()}
+	}
+	stat.Name = p.String()
+
+	return stat, nil
+}
+
+// diskStat returns the DiskStat of the file with the given name, or nil if there is no such file.
+func diskStat(name string, files []types.BaseFileInfo) *DiskStat {
+	for _, f := range files {
+		info := f.GetFileInfo()
+		if info.Path != name {
+			continue
+		}
+
+		stat := &DiskStat{FileSize: info.FileSize}
+		stat.Capacity, stat.Allocated = diskSize(name, files)
+
+		if disk, ok := f.(*types.VmDiskFileInfo); ok {
+			stat.Thin = disk.Thin != nil && *disk.Thin
+			stat.DiskType = disk.DiskType
+		}
+
+		return stat
+	}
+
+	return nil
+}
+
+// datacenter returns the Datacenter containing the Datastore.
+func (d Datastore) datacenter(ctx context.Context) (*Datacenter, error) {
+	ref := d.Reference()
+
+	for {
+		var me mo.ManagedEntity
+
+		err := d.Properties(ctx, ref, []string{"parent"}, &me)
+		if err != nil {
+			return nil, err
+		}
+
+		if me.Parent == nil {
+			return nil, fmt.Errorf("no datacenter found for %s", d.Reference())
+		}
+
+		if me.Parent.Type == "Datacenter" {
+			return NewDatacenter(d.c, *me.Parent), nil
+		}
+
+		ref = *me.Parent
+	}
+}
+
+// diskFiles returns the descriptor and data files of the virtual disk descriptor with the given name.
+func (d Datastore) diskFiles(ctx context.Context, name string) ([]types.BaseFileInfo, error) {
+	b, err := d.Browser(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	base := path.Base(name)
+
+	spec := types.HostDatastoreBrowserSearchSpec{
+		Query: []types.BaseFileQuery{
+			&types.VmDiskFileQuery{
+				Details: &types.VmDiskFileQueryFlags{
+					DiskType:   true,
+					CapacityKb: true,
+					Thin:       types.NewBool(true),
+				},
+			},
+			new(types.FileQuery),
+		},
+		Details: &types.FileQueryFlags{
+			FileType: true,
+			FileSize: true,
+		},
+		MatchPattern: []string{base, strings.TrimSuffix(base, ".vmdk") + "-*.vmdk"},
+	}
+
+	task, err := b.SearchDatastore(ctx, d.Path(path.Dir(name)), &spec)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.Result.(types.HostDatastoreBrowserSearchResults).File, nil
+}
+
+// diskSize returns the capacity and allocated size of the disk descriptor with the given name.
+// The allocated size includes data files listed separately from the descriptor, such as the extents of hosted disks.
+func diskSize(name string, files []types.BaseFileInfo) (int64, int64) {
+	var capacity, allocated int64
+
+	for _, f := range files {
+		info := f.GetFileInfo()
+
+		if info.Path == name {
+			if disk, ok := f.(*types.VmDiskFileInfo); ok {
+				capacity = disk.CapacityKb * 1024
+			}
+			allocated += info.FileSize
+			continue
+		}
+
+		if descriptor, ok := diskExtentDescriptor(info.Path); ok && descriptor == name {
+			allocated += info.FileSize
+		}
+	}
+
+	return capacity, allocated
+}
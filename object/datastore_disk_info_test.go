@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDiskSize(t *testing.T) {
+	file := func(name string, size int64) types.BaseFileInfo {
+		return &types.FileInfo{Path: name, FileSize: size}
+	}
+
+	tests := []struct {
+		name      string
+		files     []types.BaseFileInfo
+		capacity  int64
+		allocated int64
+	}{
+		{"managed", []types.BaseFileInfo{
+			&types.VmDiskFileInfo{FileInfo: types.FileInfo{Path: "disk.vmdk", FileSize: 4096}, CapacityKb: 8},
+			file("disk-000001.vmdk", 512),
+		}, 8192, 4096},
+		{"hosted", []types.BaseFileInfo{
+			&types.VmDiskFileInfo{FileInfo: types.FileInfo{Path: "disk.vmdk", FileSize: 512}, CapacityKb: 16},
+			file("disk-s001.vmdk", 1024),
+			file("disk-s002.vmdk", 2048),
+			file("disk-000001-delta.vmdk", 4096),
+		}, 16384, 3584},
+	}
+
+	for _, test := range tests {
+		capacity, allocated := diskSize("disk.vmdk", test.files)
+		if capacity != test.capacity || allocated != test.allocated {
+			t.Errorf("%s: capacity=%d allocated=%d", test.name, capacity, allocated)
+		}
+	}
+}
+
+func TestDiskStat(t *testing.T) {
+	files := []types.BaseFileInfo{
+		&types.VmDiskFileInfo{
+			FileInfo:   types.FileInfo{Path: "disk.vmdk", FileSize: 512},
+			CapacityKb: 16,
+			Thin:       types.NewBool(true),
+			DiskType:   "VirtualDiskSparseVer2BackingInfo",
+		},
+		&types.FileInfo{Path: "disk-s001.vmdk", FileSize: 1024},
+		&types.VmLogFileInfo{FileInfo: types.FileInfo{Path: "vmware.log", FileSize: 256}},
+	}
+
+	disk := diskStat("disk.vmdk", files)
+	if disk == nil {
+		t.Fatal("disk.vmdk not found")
+	}
+	if disk.FileSize != 512 || disk.Capacity != 16384 || disk.Allocated != 1536 || !disk.Thin {
+		t.Errorf("disk=%#v", disk)
+	}
+
+	log := diskStat("vmware.log", files)
+	if log == nil {
+		t.Fatal("vmware.log not found")
+	}
+	if log.FileSize != 256 || log.Capacity != 0 || log.Allocated != 256 || log.Thin {
+		t.Errorf("log=%#v", log)
+	}
+
+	if diskStat("vm.vmx", files) != nil {
+		t.Error("expected nil")
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDatastoreDiskInfo(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := &types.FileBackedVirtualDiskSpec{
+			VirtualDiskSpec: types.VirtualDiskSpec{
+				AdapterType: string(types.VirtualDiskAdapterTypeLsiLogic),
+				DiskType:    string(types.VirtualDiskTypeThin),
+			},
+			CapacityKb: 1024,
+		}
+
+		// "db-rdm.vmdk" is a descriptor named like a data file
+		for _, name := range []string{"disk.vmdk", "db-rdm.vmdk"} {
+			task, err := object.NewVirtualDiskManager(c).CreateVirtualDisk(ctx, ds.Path(name), dc, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		paths := map[string]string{
+			"disk.vmdk":          ds.Path("disk.vmdk"),
+			ds.Path("disk.vmdk"): ds.Path("disk.vmdk"),
+			"db-rdm.vmdk":        ds.Path("db-rdm.vmdk"),
+		}
+
+		for name, want := range paths {
+			info, err := ds.DiskInfo(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if info.Name != want {
+				t.Errorf("name=%s", info.Name)
+			}
+			if info.DiskType != string(types.VirtualDiskTypeThin) {
+				t.Errorf("type=%s", info.DiskType)
+			}
+			if len(info.Chain) != 0 {
+				t.Errorf("chain=%#v", info.Chain)
+			}
+		}
+
+		// data files of an existing disk and missing disks are rejected
+		for _, name := range []string{"disk-flat.vmdk", "enoent.vmdk"} {
+			if _, err = ds.DiskInfo(ctx, name); err == nil {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
+
+func TestDatastoreStatDisk(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := &types.FileBackedVirtualDiskSpec{
+			VirtualDiskSpec: types.VirtualDiskSpec{
+				AdapterType: string(types.VirtualDiskAdapterTypeLsiLogic),
+				DiskType:    string(types.VirtualDiskTypeThin),
+			},
+			CapacityKb: 1024,
+		}
+
+		// "web-s001.vmdk" is a descriptor named like a data file
+		for _, name := range []string{"disk.vmdk", "web-s001.vmdk"} {
+			task, err := object.NewVirtualDiskManager(c).CreateVirtualDisk(ctx, ds.Path(name), dc, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			stat, err := ds.StatDisk(ctx, name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stat.Name != ds.Path(name) {
+				t.Errorf("name=%s", stat.Name)
+			}
+		}
+
+		// data files of an existing disk and missing files are rejected
+		for _, name := range []string{"disk-flat.vmdk", "enoent.vmdk"} {
+			if _, err = ds.StatDisk(ctx, name); err == nil {
+				t.Errorf("%s: expected error", name)
+			}
+		}
+	})
+}
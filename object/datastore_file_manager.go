@@ -24,10 +24,12 @@ import (
 	"io"
 	"log"
 	"path"
+	"regexp"
 	"strings"
 
 	"github.com/vmware/govmomi/vim25/progress"
 	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 // DatastoreFileManager combines FileManager and VirtualDiskManager to manage files on a Datastore
@@ -39,6 +41,10 @@ type DatastoreFileManager struct {
 
 	Force            bool
 	DatacenterTarget *Datacenter
+
+	// AllowDiskExtents allows a virtual disk data file, such as "disk-flat.vmdk", to be deleted,
+	// copied or moved on its own via FileManager, for example if its descriptor no longer exists.
+	AllowDiskExtents bool
 }
 
 // NewFileManager creates a new instance of DatastoreFileManager
@@ -70,10 +76,106 @@ func (m *DatastoreFileManager) wait(ctx context.Context, task *Task) error {
 	return err
 }
 
-// Delete dispatches to the appropriate Delete method based on file name extension
+// diskExtent matches the name of a virtual disk data file, such as "disk-flat.vmdk", as opposed to a disk descriptor
+var diskExtent = regexp.MustCompile(`^(.+)-(flat|delta|sesparse|ctk|rdm|rdmp|s[0-9]{3}|f[0-9]{3})\.vmdk$`)
+
+// diskExtentDescriptor returns the name of the disk descriptor if the given name is a virtual disk data file.
+func diskExtentDescriptor(name string) (string, bool) {
+	m := diskExtent.FindStringSubmatch(name)
+	if m == nil {
+		return "", false
+	}
+	return m[1] + ".vmdk", true
+}
+
+// isDiskExtent returns true if the given ".vmdk" file exists and is a virtual disk data file, such as "disk-flat.vmdk",
+// rather than a disk descriptor. The datastore browser's VmDiskFileQuery only matches disk descriptors,
+// so the file name alone is not used, as a descriptor may be named like a data file, such as "db-rdm.vmdk".
+func (d Datastore) isDiskExtent(ctx context.Context, p *DatastorePath) (bool, error) {
+	if !p.IsVMDK() {
+		return false, nil
+	}
+
+	b, err := d.Browser(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	spec := types.HostDatastoreBrowserSearchSpec{
+		Query:        []types.BaseFileQuery{new(types.VmDiskFileQuery)},
+		MatchPattern: []string{path.Base(p.Path)},
+	}
+
+	task, err := b.SearchDatastore(ctx, d.Path(path.Dir(p.Path)), &spec)
+	if err != nil {
+		return false, err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		if types.IsFileNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if len(info.Result.(types.HostDatastoreBrowserSearchResults).File) != 0 {
+		return false, nil // disk descriptor
+	}
+
+	_, err = d.Stat(ctx, p.Path)
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case DatastoreNoSuchFileError, DatastoreNoSuchDirectoryError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// checkDiskDescriptor returns an error if the given path is a virtual disk data file rather than a disk descriptor.
+func (d Datastore) checkDiskDescriptor(ctx context.Context, p *DatastorePath) error {
+	extent, err := d.isDiskExtent(ctx, p)
+	if err != nil {
+		return err
+	}
+	if extent {
+		return fmt.Errorf("%s is a virtual disk data file, use the disk descriptor instead", p)
+	}
+	return nil
+}
+
+// diskExtent returns true if the given path is a virtual disk data file, or an error unless AllowDiskExtents is true,
+// in which case the data file is managed via FileManager rather than VirtualDiskManager.
+func (m *DatastoreFileManager) diskExtent(ctx context.Context, p *DatastorePath) (bool, error) {
+	extent, err := m.Datastore.isDiskExtent(ctx, p)
+	if err != nil {
+		return false, err
+	}
+
+	if extent && !m.AllowDiskExtents {
+		return false, fmt.Errorf("%s is a virtual disk data file, use the disk descriptor instead", p)
+	}
+
+	return extent, nil
+}
+
+// Delete dispatches to the appropriate Delete method based on file name extension.
+// Virtual disk data files, such as "disk-flat.vmdk", are not deleted, as they are deleted along with their descriptor,
+// unless AllowDiskExtents is true, in which case they are deleted via DeleteFile.
 func (m *DatastoreFileManager) Delete(ctx context.Context, name string) error {
-	switch path.Ext(name) {
-	case ".vmdk":
+	p := m.Path(name)
+
+	extent, err := m.diskExtent(ctx, p)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case extent:
+		return m.DeleteFile(ctx, name)
+	case path.Ext(name) == ".vmdk":
 		return m.DeleteVirtualDisk(ctx, name)
 	default:
 		return m.DeleteFile(ctx, name)
@@ -126,14 +228,21 @@ func (m *DatastoreFileManager) CopyFile(ctx context.Context, src string, dst str
 	return m.wait(ctx, task)
 }
 
-// Copy dispatches to the appropriate FileManager or VirtualDiskManager Copy method based on file name extension
+// Copy dispatches to the appropriate FileManager or VirtualDiskManager Copy method based on file name extension.
+// Virtual disks are copied via VirtualDiskManager, such that the descriptor and its data files are copied together.
+// Copying a disk data file, such as "disk-flat.vmdk", on its own returns an error, unless AllowDiskExtents is true.
 func (m *DatastoreFileManager) Copy(ctx context.Context, src string, dst string) error {
 	srcp := m.Path(src)
 	dstp := m.Path(dst)
 
+	extent, err := m.diskExtent(ctx, srcp)
+	if err != nil {
+		return err
+	}
+
 	f := m.FileManager.CopyDatastoreFile
 
-	if srcp.IsVMDK() {
+	if srcp.IsVMDK() && !extent {
 		// types.VirtualDiskSpec=nil as it is not implemented by vCenter
 		f = func(ctx context.Context, src string, srcDC *Datacenter, dst string, dstDC *Datacenter, force bool) (*Task, error) {
 			return m.VirtualDiskManager.CopyVirtualDisk(ctx, src, srcDC, dst, dstDC, nil, force)
@@ -161,14 +270,21 @@ func (m *DatastoreFileManager) MoveFile(ctx context.Context, src string, dst str
 	return m.wait(ctx, task)
 }
 
-// Move dispatches to the appropriate FileManager or VirtualDiskManager Move method based on file name extension
+// Move dispatches to the appropriate FileManager or VirtualDiskManager Move method based on file name extension.
+// Virtual disks are moved via VirtualDiskManager, such that the descriptor and its data files are moved together.
+// Moving a disk data file, such as "disk-flat.vmdk", on its own returns an error, unless AllowDiskExtents is true.
 func (m *DatastoreFileManager) Move(ctx context.Context, src string, dst string) error {
 	srcp := m.Path(src)
 	dstp := m.Path(dst)
 
+	extent, err := m.diskExtent(ctx, srcp)
+	if err != nil {
+		return err
+	}
+
 	f := m.FileManager.MoveDatastoreFile
 
-	if srcp.IsVMDK() {
+	if srcp.IsVMDK() && !extent {
 		f = m.VirtualDiskManager.MoveVirtualDisk
 	}
 
@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDatastoreFileManagerDiskExtent(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := &types.FileBackedVirtualDiskSpec{
+			VirtualDiskSpec: types.VirtualDiskSpec{
+				AdapterType: string(types.VirtualDiskAdapterTypeLsiLogic),
+				DiskType:    string(types.VirtualDiskTypeThin),
+			},
+			CapacityKb: 1024,
+		}
+
+		create := func(name string) {
+			task, err := object.NewVirtualDiskManager(c).CreateVirtualDisk(ctx, ds.Path(name), dc, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		upload := func(name string) {
+			err := ds.Upload(ctx, strings.NewReader("data"), name, &soap.DefaultUpload)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		exists := func(name string) bool {
+			_, err := ds.Stat(ctx, name)
+			if err == nil {
+				return true
+			}
+			if _, ok := err.(object.DatastoreNoSuchFileError); !ok {
+				t.Fatal(err)
+			}
+			return false
+		}
+
+		create("disk.vmdk")
+
+		fm := ds.NewFileManager(dc, true)
+
+		// data file of an existing descriptor, Force does not apply
+		if err = fm.Delete(ctx, "disk-flat.vmdk"); err == nil {
+			t.Error("expected error")
+		}
+		if err = fm.Copy(ctx, "disk-flat.vmdk", "copy-flat.vmdk"); err == nil {
+			t.Error("expected error")
+		}
+		if err = fm.Move(ctx, "disk-flat.vmdk", "moved-flat.vmdk"); err == nil {
+			t.Error("expected error")
+		}
+		if !exists("disk-flat.vmdk") {
+			t.Error("disk-flat.vmdk was deleted")
+		}
+
+		// descriptor named like a data file is deleted along with its data file
+		create("db-rdm.vmdk")
+		if err = fm.Delete(ctx, "db-rdm.vmdk"); err != nil {
+			t.Fatal(err)
+		}
+		if exists("db-rdm.vmdk") || exists("db-rdm-flat.vmdk") {
+			t.Error("db-rdm.vmdk was not deleted with its data file")
+		}
+
+		// data file without a descriptor
+		upload("orphan-flat.vmdk")
+		if err = fm.Delete(ctx, "orphan-flat.vmdk"); err == nil {
+			t.Error("expected error")
+		}
+
+		fm.AllowDiskExtents = true
+		if err = fm.Delete(ctx, "orphan-flat.vmdk"); err != nil {
+			t.Fatal(err)
+		}
+		if exists("orphan-flat.vmdk") {
+			t.Error("orphan-flat.vmdk was not deleted")
+		}
+
+		// files that do not exist are dispatched as before
+		if err = fm.Delete(ctx, "enoent-flat.vmdk"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import "testing"
+
+func TestDiskExtentDescriptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		descriptor string
+	}{
+		{"vm/disk.vmdk", ""},
+		{"vm/disk-000001.vmdk", ""},
+		{"vm/disk-flat.vmdk", "vm/disk.vmdk"},
+		{"vm/disk-000001-delta.vmdk", "vm/disk-000001.vmdk"},
+		{"vm/disk-000001-sesparse.vmdk", "vm/disk-000001.vmdk"},
+		{"vm/disk-ctk.vmdk", "vm/disk.vmdk"},
+		{"vm/disk-rdmp.vmdk", "vm/disk.vmdk"},
+		{"vm/disk-s001.vmdk", "vm/disk.vmdk"},
+		{"vm/disk-f001.vmdk", "vm/disk.vmdk"},
+		{"vm/disk-flat.vmx", ""},
+		{"vm/my-flat-disk.vmdk", ""},
+	}
+
+	for _, test := range tests {
+		descriptor, ok := diskExtentDescriptor(test.name)
+		if ok != (test.descriptor != "") || descriptor != test.descriptor {
+			t.Errorf("%s: descriptor=%q", test.name, descriptor)
+		}
+	}
+}
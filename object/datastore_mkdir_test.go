@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestDatastoreMkdirAll(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		// inventory path is not required
+		ds := object.NewDatastore(c, simulator.Map.Any("Datastore").Reference())
+
+		stat := func(name string) error {
+			_, err := ds.Stat(ctx, "[LocalDS_0] "+name)
+			return err
+		}
+
+		for i := 0; i < 2; i++ {
+			if err := ds.MkdirAll(ctx, "a/b/c"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := stat("a/b/c"); err != nil {
+			t.Fatal(err)
+		}
+
+		// vCenter faults when the directory exists
+		simulator.Override("MakeDirectory", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			return &methods.MakeDirectoryBody{
+				Fault_: simulator.Fault("", &types.FileAlreadyExists{FileFault: types.FileFault{File: "a/b"}}),
+			}, true
+		})
+
+		if err := ds.MkdirAll(ctx, "[LocalDS_0] a/b"); err != nil {
+			t.Fatal(err)
+		}
+
+		// like os.MkdirAll, an error is returned if the path exists but is not a directory
+		named, err := find.NewFinder(c).Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = named.Upload(ctx, strings.NewReader("govmomi"), "a/b/file.txt", &soap.DefaultUpload)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = stat("a/b/file.txt"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = ds.MkdirAll(ctx, "a/b/file.txt"); err == nil {
+			t.Error("expected error")
+		}
+
+		simulator.ClearOverrides()
+
+		for i := 0; i < 2; i++ {
+			if err := ds.RemoveAll(ctx, "[LocalDS_0] a"); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if err := stat("a/b"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
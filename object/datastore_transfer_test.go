@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/progress"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// testSink records the last progress.Report received before its channel is closed.
+type testSink struct {
+	reports int
+	last    progress.Report
+	done    chan struct{}
+}
+
+func (s *testSink) Sink() chan<- progress.Report {
+	ch := make(chan progress.Report)
+	s.done = make(chan struct{})
+
+	go func() {
+		for r := range ch {
+			s.reports++
+			s.last = r
+		}
+		close(s.done)
+	}()
+
+	return ch
+}
+
+func (s *testSink) wait(t *testing.T, size int) {
+	<-s.done
+
+	if s.reports == 0 {
+		t.Fatal("no progress reports")
+	}
+	if err := s.last.Error(); err != nil {
+		t.Errorf("final report error: %s", err)
+	}
+	if p := s.last.Percentage(); p != 100 {
+		t.Errorf("final report percentage=%f (size=%d)", p, size)
+	}
+}
+
+func TestDatastoreTransferProgress(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		ds, err := find.NewFinder(c).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir, err := ioutil.TempDir("", "govmomi-transfer")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		data := []byte("this is a test of the transfer progress sink")
+		src := filepath.Join(dir, "src.txt")
+		if err = ioutil.WriteFile(src, data, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		sink := new(testSink)
+		up := soap.DefaultUpload
+		up.Progress = sink
+
+		if err = ds.UploadFile(ctx, src, "progress.txt", &up); err != nil {
+			t.Fatal(err)
+		}
+		sink.wait(t, len(data))
+
+		sink = new(testSink)
+		down := soap.DefaultDownload
+		down.Progress = sink
+
+		rc, _, err := ds.Download(ctx, "progress.txt", &down)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		b, err := ioutil.ReadAll(rc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = rc.Close()
+		_ = rc.Close()
+
+		sink.wait(t, len(data))
+
+		if string(b) != string(data) {
+			t.Errorf("download=%q", b)
+		}
+
+		sink = new(testSink)
+		down.Progress = sink
+
+		if err = ds.DownloadFile(ctx, "progress.txt", filepath.Join(dir, "dst.txt"), &down); err != nil {
+			t.Fatal(err)
+		}
+		sink.wait(t, len(data))
+	})
+}
@@ -88,3 +88,23 @@ func (p DistributedVirtualPortgroup) Reconfigure(ctx context.Context, spec types
 
 	return NewTask(p.Client(), res.Returnval), nil
 }
+
+// Ports returns the DVPorts of this DistributedVirtualPortgroup matching the given criteria,
+// via FetchDVPorts on the portgroup's switch. The criteria PortgroupKey and Inside fields are set to select
+// ports within this portgroup.
+func (p DistributedVirtualPortgroup) Ports(ctx context.Context, criteria types.DistributedVirtualSwitchPortCriteria) ([]types.DistributedVirtualPort, error) {
+	var dvp mo.DistributedVirtualPortgroup
+
+	if err := p.Properties(ctx, p.Reference(), []string{"key", "config.distributedVirtualSwitch"}, &dvp); err != nil {
+		return nil, err
+	}
+
+	if dvp.Config.DistributedVirtualSwitch == nil {
+		return nil, fmt.Errorf("failed to fetch ports for %s: System.Read privilege required for config.distributedVirtualSwitch", p.Reference())
+	}
+
+	criteria.PortgroupKey = []string{dvp.Key}
+	criteria.Inside = types.NewBool(true)
+
+	return NewDistributedVirtualSwitch(p.c, *dvp.Config.DistributedVirtualSwitch).FetchDVPorts(ctx, &criteria)
+}
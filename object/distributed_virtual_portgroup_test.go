@@ -23,6 +23,7 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 // DistributedVirtualPortgroup should implement the Reference interface.
@@ -48,3 +49,63 @@ func TestDistributedVirtualPortgroupEthernetCardBackingInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestDistributedVirtualPortgroupPorts(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("DistributedVirtualSwitch").(*simulator.DistributedVirtualSwitch)
+		dvs := object.NewDistributedVirtualSwitch(c, obj.Self)
+
+		task, err := dvs.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{{Name: "pg-ports", NumPorts: 4}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		ref := simulator.Map.FindByName("pg-ports", obj.Portgroup).Reference()
+		pg := object.NewDistributedVirtualPortgroup(c, ref)
+
+		ports, err := pg.Ports(ctx, types.DistributedVirtualSwitchPortCriteria{PortKey: []string{"2"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ports) != 1 || ports[0].Key != "2" {
+			t.Fatalf("ports=%#v", ports)
+		}
+
+		backing, err := pg.EthernetCardBackingInfo(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo).Port.PortKey = "2"
+
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+		device, err := object.VirtualDeviceList{}.CreateEthernetCard("vmxnet3", backing)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = vm.AddDevice(ctx, device); err != nil {
+			t.Fatal(err)
+		}
+
+		conns, err := vm.PortConnections(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		found := false
+		for _, conn := range conns {
+			if conn.PortKey != "2" {
+				continue
+			}
+			found = true
+			if conn.Port == nil || conn.Port.Key != "2" || conn.Port.PortgroupKey != conn.PortgroupKey || conn.SwitchUUID == "" {
+				t.Errorf("conn=%#v", conn)
+			}
+		}
+		if !found {
+			t.Errorf("connection not found in %#v", conns)
+		}
+	})
+}
@@ -19,6 +19,7 @@ package object
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -76,6 +77,67 @@ func (s DistributedVirtualSwitch) AddPortgroup(ctx context.Context, spec []types
 	return NewTask(s.Client(), res.Returnval), nil
 }
 
+// PortgroupOptions are the options used by DistributedVirtualSwitch.CreatePortgroup.
+type PortgroupOptions struct {
+	VlanID    int32                // VlanID of the portgroup, used when VlanTrunk is empty
+	VlanTrunk []types.NumericRange // VlanTrunk ranges of a trunk portgroup
+	NumPorts  int32                // NumPorts is the number of ports
+	Type      string               // Type is the port binding type, defaults to "earlyBinding"
+}
+
+// vlanSpec validates the VLAN options, returning the VLAN spec of the portgroup.
+func (o PortgroupOptions) vlanSpec() (types.BaseVmwareDistributedVirtualSwitchVlanSpec, error) {
+	const maxVlanID = 4094
+
+	if len(o.VlanTrunk) == 0 {
+		if o.VlanID < 0 || o.VlanID > maxVlanID {
+			return nil, fmt.Errorf("invalid VLAN ID: %d", o.VlanID)
+		}
+		return &types.VmwareDistributedVirtualSwitchVlanIdSpec{VlanId: o.VlanID}, nil
+	}
+
+	ranges := make([]types.NumericRange, len(o.VlanTrunk))
+	copy(ranges, o.VlanTrunk)
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+
+	for i, r := range ranges {
+		if r.Start < 0 || r.End > maxVlanID || r.Start > r.End {
+			return nil, fmt.Errorf("invalid VLAN range: %d-%d", r.Start, r.End)
+		}
+		if i > 0 && r.Start <= ranges[i-1].End {
+			prev := ranges[i-1]
+			return nil, fmt.Errorf("overlapping VLAN ranges: %d-%d and %d-%d", prev.Start, prev.End, r.Start, r.End)
+		}
+	}
+
+	return &types.VmwareDistributedVirtualSwitchTrunkVlanSpec{VlanId: o.VlanTrunk}, nil
+}
+
+// CreatePortgroup adds a portgroup with the given name and options to the switch.
+// An error is returned without calling AddDVPortgroup_Task if the VLAN options are invalid.
+func (s DistributedVirtualSwitch) CreatePortgroup(ctx context.Context, name string, opts PortgroupOptions) (*Task, error) {
+	vlan, err := opts.vlanSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	ptype := opts.Type
+	if ptype == "" {
+		ptype = string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding)
+	}
+
+	spec := types.DVPortgroupConfigSpec{
+		Name:     name,
+		Type:     ptype,
+		NumPorts: opts.NumPorts,
+		DefaultPortConfig: &types.VMwareDVSPortSetting{
+			Vlan: vlan,
+		},
+	}
+
+	return s.AddPortgroup(ctx, []types.DVPortgroupConfigSpec{spec})
+}
+
 func (s DistributedVirtualSwitch) FetchDVPorts(ctx context.Context, criteria *types.DistributedVirtualSwitchPortCriteria) ([]types.DistributedVirtualPort, error) {
 	req := &types.FetchDVPorts{
 		This:     s.Reference(),
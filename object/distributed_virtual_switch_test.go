@@ -23,6 +23,7 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestDistributedVirtualSwitchEthernetCardBackingInfo(t *testing.T) {
@@ -37,3 +38,74 @@ func TestDistributedVirtualSwitchEthernetCardBackingInfo(t *testing.T) {
 		}
 	})
 }
+
+func TestDistributedVirtualSwitchCreatePortgroup(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("DistributedVirtualSwitch").(*simulator.DistributedVirtualSwitch)
+
+		dvs := object.NewDistributedVirtualSwitch(c, obj.Self)
+
+		invalid := []object.PortgroupOptions{
+			{VlanID: -1},
+			{VlanID: 4095},
+			{VlanTrunk: []types.NumericRange{{Start: 10, End: 5}}},
+			{VlanTrunk: []types.NumericRange{{Start: 0, End: 4095}}},
+			{VlanTrunk: []types.NumericRange{{Start: 100, End: 200}, {Start: 1, End: 100}}},
+		}
+
+		for _, opts := range invalid {
+			if _, err := dvs.CreatePortgroup(ctx, "invalid", opts); err == nil {
+				t.Errorf("expected error for %#v", opts)
+			}
+		}
+
+		tests := []struct {
+			name string
+			opts object.PortgroupOptions
+		}{
+			{"pg-vlan", object.PortgroupOptions{VlanID: 100, NumPorts: 16}},
+			{"pg-trunk", object.PortgroupOptions{
+				VlanTrunk: []types.NumericRange{{Start: 1, End: 99}, {Start: 200, End: 300}},
+				Type:      string(types.DistributedVirtualPortgroupPortgroupTypeEphemeral),
+			}},
+		}
+
+		for _, test := range tests {
+			task, err := dvs.CreatePortgroup(ctx, test.name, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = task.Wait(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			pg := simulator.Map.FindByName(test.name, obj.Portgroup).(*simulator.DistributedVirtualPortgroup)
+			config := pg.Config
+
+			switch vlan := config.DefaultPortConfig.(*types.VMwareDVSPortSetting).Vlan.(type) {
+			case *types.VmwareDistributedVirtualSwitchVlanIdSpec:
+				if vlan.VlanId != test.opts.VlanID {
+					t.Errorf("%s: VlanId=%d", test.name, vlan.VlanId)
+				}
+			case *types.VmwareDistributedVirtualSwitchTrunkVlanSpec:
+				if len(vlan.VlanId) != len(test.opts.VlanTrunk) {
+					t.Errorf("%s: VlanId=%v", test.name, vlan.VlanId)
+				}
+			default:
+				t.Errorf("%s: unexpected vlan spec %T", test.name, vlan)
+			}
+
+			ptype := test.opts.Type
+			if ptype == "" {
+				ptype = string(types.DistributedVirtualPortgroupPortgroupTypeEarlyBinding)
+			}
+			if config.Type != ptype {
+				t.Errorf("%s: Type=%s", test.name, config.Type)
+			}
+
+			if config.NumPorts != test.opts.NumPorts {
+				t.Errorf("%s: NumPorts=%d", test.name, config.NumPorts)
+			}
+		}
+	})
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/units"
 	"github.com/vmware/govmomi/view"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
@@ -51,14 +52,22 @@ func ExampleResourcePool_Owner() {
 				return err
 			}
 
-			fmt.Printf("%s owner is a %T\n", name, owner)
+			var path string
+			switch o := owner.(type) {
+			case *object.ClusterComputeResource:
+				path = o.InventoryPath
+			case *object.ComputeResource:
+				path = o.InventoryPath
+			}
+
+			fmt.Printf("%s owner is a %T (%s)\n", name, owner, path)
 		}
 
 		return nil
 	})
 	// Output:
-	// DC0_H0_VM0 owner is a *object.ComputeResource
-	// DC0_C0_RP0_VM0 owner is a *object.ClusterComputeResource
+	// DC0_H0_VM0 owner is a *object.ComputeResource (/DC0/host/DC0_H0)
+	// DC0_C0_RP0_VM0 owner is a *object.ClusterComputeResource (/DC0/host/DC0_C0)
 }
 
 func ExampleVirtualMachine_CreateSnapshot() {
@@ -164,6 +173,151 @@ func ExampleVirtualMachine_Customize() {
 	// Output: 192.168.1.100
 }
 
+func ExampleVirtualMachine_CloneAndCustomize() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		folder, err := finder.Folder(ctx, "vm")
+		if err != nil {
+			return err
+		}
+
+		spec := types.CustomizationSpec{
+			NicSettingMap: []types.CustomizationAdapterMapping{
+				types.CustomizationAdapterMapping{
+					Adapter: types.CustomizationIPSettings{
+						Ip: &types.CustomizationFixedIp{
+							IpAddress: "192.168.1.101",
+						},
+						SubnetMask: "255.255.255.0",
+						Gateway:    []string{"192.168.1.1"},
+					},
+				},
+			},
+			Identity: &types.CustomizationLinuxPrep{
+				HostName: &types.CustomizationFixedName{
+					Name: "clone",
+				},
+				Domain: "ad.domain",
+			},
+		}
+
+		clone, err := vm.CloneAndCustomize(ctx, folder, "DC0_H0_VM0_clone", types.VirtualMachineCloneSpec{}, spec)
+		if err != nil {
+			return err
+		}
+
+		task, err := clone.PowerOn(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		ip, err := clone.WaitForIP(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(ip)
+
+		return nil
+	})
+	// Output: 192.168.1.101
+}
+
+func ExampleVirtualMachine_CloneWithCustomization() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		folder, err := finder.Folder(ctx, "vm")
+		if err != nil {
+			return err
+		}
+
+		options := object.CloneOptions{PowerOn: true}
+
+		_, err = vm.CloneWithCustomization(ctx, folder, "clone-1", "enoent", options)
+		fmt.Println(err)
+
+		task, err := vm.CloneWithCustomization(ctx, folder, "clone-1", "vcsim-linux", options)
+		if err != nil {
+			return err
+		}
+
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		var clone mo.VirtualMachine
+		pc := property.DefaultCollector(c)
+		err = pc.RetrieveOne(ctx, info.Result.(types.ManagedObjectReference), []string{"runtime.powerState"}, &clone)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(clone.Runtime.PowerState)
+
+		return nil
+	})
+	// Output:
+	// customization spec "enoent" not found
+	// poweredOn
+}
+
+func ExampleVirtualMachine_InstantClone() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		spec := object.NewInstantCloneSpec("DC0_H0_VM0_fork", types.VirtualMachineRelocateSpec{}, map[string]string{
+			"hostname": "fork-1",
+		})
+
+		task, err := vm.InstantClone(ctx, spec)
+		if err != nil {
+			return err
+		}
+
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		var clone mo.VirtualMachine
+		pc := property.DefaultCollector(c)
+		err = pc.RetrieveOne(ctx, info.Result.(types.ManagedObjectReference), []string{"runtime.powerState", "config.extraConfig"}, &clone)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(clone.Runtime.PowerState)
+		for _, opt := range clone.Config.ExtraConfig {
+			o := opt.GetOptionValue()
+			if o.Key == "guestinfo.hostname" {
+				fmt.Println(o.Value)
+			}
+		}
+
+		return nil
+	})
+	// Output:
+	// poweredOn
+	// fork-1
+}
+
 func ExampleVirtualMachine_HostSystem() {
 	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
 		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
@@ -322,6 +476,65 @@ func ExampleFolder_CreateVM() {
 	// Output: example-vm
 }
 
+func ExampleFolder_CreateVMFromSpec() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.Datacenter(ctx, "DC0")
+		if err != nil {
+			return err
+		}
+
+		finder.SetDatacenter(dc)
+
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+
+		pool, err := finder.ResourcePool(ctx, "DC0_C0/Resources")
+		if err != nil {
+			return err
+		}
+
+		ds, err := finder.Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			return err
+		}
+
+		opts := object.VMCreateOptions{
+			Name:      "example-vm",
+			MemoryMB:  2048,
+			NumCPUs:   2,
+			Datastore: ds,
+		}
+
+		task, err := folders.VmFolder.CreateVMFromSpec(ctx, opts, pool, nil)
+		if err != nil {
+			return err
+		}
+
+		info, err := task.WaitForResult(ctx)
+		if err != nil {
+			return err
+		}
+
+		vm := object.NewVirtualMachine(c, info.Result.(types.ManagedObjectReference))
+
+		var props mo.VirtualMachine
+		err = vm.Properties(ctx, vm.Reference(), []string{"config"}, &props)
+		if err != nil {
+			return err
+		}
+
+		scsi := object.VirtualDeviceList(props.Config.Hardware.Device).SelectByType((*types.VirtualSCSIController)(nil))
+
+		fmt.Println(props.Config.Files.VmPathName, props.Config.Hardware.NumCPU, props.Config.Firmware, len(scsi))
+
+		return nil
+	})
+	// Output: [LocalDS_0] example-vm/example-vm.vmx 2 bios 1
+}
+
 func ExampleVirtualMachine_Reconfigure() {
 	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
 		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
@@ -487,6 +700,48 @@ func ExampleCustomizationSpecManager_Info() {
 	// vcsim-windows-domain=*types.CustomizationSysprep
 }
 
+func ExampleVirtualMachine_AddNetworkAdapter() {
+	model := simulator.VPX()
+	model.OpaqueNetwork = 1 // Create 1 NSX backed OpaqueNetwork per DC
+
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		// One code path for any network type: "Network", "DistributedVirtualPortgroup" or "OpaqueNetwork"
+		for _, name := range []string{"VM Network", "DC0_DVPG0", "DC0_NSX0"} {
+			net, err := finder.Network(ctx, name)
+			if err != nil {
+				return err
+			}
+
+			device, err := vm.AddNetworkAdapter(ctx, net, "vmxnet3")
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s: %T\n", name, device.GetVirtualDevice().Backing)
+		}
+
+		list, err := vm.Device(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d NICs\n", len(list.SelectByType((*types.VirtualEthernetCard)(nil))))
+
+		return nil
+	}, model)
+	// Output:
+	// VM Network: *types.VirtualEthernetCardNetworkBackingInfo
+	// DC0_DVPG0: *types.VirtualEthernetCardDistributedVirtualPortBackingInfo
+	// DC0_NSX0: *types.VirtualEthernetCardOpaqueNetworkBackingInfo
+	// 4 NICs
+}
+
 func ExampleNetworkReference_EthernetCardBackingInfo() {
 	model := simulator.VPX()
 	model.OpaqueNetwork = 1 // Create 1 NSX backed OpaqueNetwork per DC
@@ -535,3 +790,384 @@ func ExampleNetworkReference_EthernetCardBackingInfo() {
 	}, model)
 	// Output: 1 of 2 NICs match backing
 }
+
+func ExampleDatacenter_PowerOnMultiVM() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		finder := find.NewFinder(c)
+		dc, err := finder.Datacenter(ctx, "DC0")
+		if err != nil {
+			return err
+		}
+		finder.SetDatacenter(dc)
+
+		vm0, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+		vm1, err := finder.VirtualMachine(ctx, "DC0_H0_VM1")
+		if err != nil {
+			return err
+		}
+
+		task, err := vm0.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		// DC0_H0_VM1 is already powered on
+		task, err = dc.PowerOnMultiVM(ctx, []types.ManagedObjectReference{vm0.Reference(), vm1.Reference()}, nil)
+		if err != nil {
+			return err
+		}
+
+		results, err := dc.PowerOnMultiVMResults(ctx, task)
+		if err != nil {
+			return err
+		}
+
+		for _, res := range results {
+			name := vm0.Name()
+			if res.VirtualMachine == vm1.Reference() {
+				name = vm1.Name()
+			}
+			fmt.Printf("%s: %v\n", name, res.Err)
+		}
+
+		return nil
+	})
+	// Output:
+	// DC0_H0_VM0: <nil>
+	// DC0_H0_VM1: *types.InvalidPowerState
+}
+
+func ExampleDatastore_EnterMaintenanceModeAndWait() {
+	model := simulator.VPX()
+	model.Machine = 0 // no VMs to evacuate
+
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		ds, err := find.NewFinder(c).Datastore(ctx, "LocalDS_0")
+		if err != nil {
+			return err
+		}
+
+		state := func() string {
+			var mds mo.Datastore
+			_ = ds.Properties(ctx, ds.Reference(), []string{"summary.maintenanceMode"}, &mds)
+			return mds.Summary.MaintenanceMode
+		}
+
+		if err = ds.EnterMaintenanceModeAndWait(ctx); err != nil {
+			return err
+		}
+		fmt.Println(state())
+
+		task, err := ds.ExitMaintenanceMode(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+		fmt.Println(state())
+
+		return nil
+	}, model)
+	// Output:
+	// inMaintenance
+	// normal
+}
+
+func ExampleVirtualMachine_DiskChangeTracking() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		show := func(snapshot *types.ManagedObjectReference) ([]object.DiskChangeTracking, error) {
+			disks, err := vm.DiskChangeTracking(ctx, snapshot)
+			for _, disk := range disks {
+				fmt.Printf("%s: supported=%t enabled=%t\n", disk.Label, disk.Supported, disk.Enabled)
+			}
+			return disks, err
+		}
+
+		if _, err = show(nil); err != nil {
+			return err
+		}
+
+		task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{ChangeTrackingEnabled: types.NewBool(true)})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		task, err = vm.CreateSnapshot(ctx, "backup", "", false, false)
+		if err != nil {
+			return err
+		}
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			return err
+		}
+		snapshot := info.Result.(types.ManagedObjectReference)
+
+		current, err := show(nil)
+		if err != nil {
+			return err
+		}
+
+		disks, err := show(&snapshot)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(disks[0].ChangeID != current[0].ChangeID)
+
+		return nil
+	})
+	// Output:
+	// disk-202-0: supported=true enabled=false
+	// disk-202-0: supported=true enabled=true
+	// disk-202-0: supported=true enabled=true
+	// true
+}
+
+func ExampleVirtualMachine_ChangedDiskAreas() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{ChangeTrackingEnabled: types.NewBool(true)})
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		snapshot, err := vm.CreateSnapshotAndWait(ctx, "backup", "", false, false, false)
+		if err != nil {
+			return err
+		}
+
+		layout, err := vm.DiskLayout(ctx)
+		if err != nil {
+			return err
+		}
+
+		chain := layout.Disks[0]
+		unit := chain.Units[0]
+		fmt.Println(*unit.Snapshot == snapshot, unit.ChangeTracking.Enabled)
+
+		// full backup: all allocated areas of the disk as of the snapshot
+		areas, err := vm.ChangedDiskAreas(ctx, chain.Disk, &snapshot, "*")
+		if err != nil {
+			return err
+		}
+
+		var size int64
+		for _, area := range areas {
+			size += area.Length
+		}
+		fmt.Println(units.ByteSize(size))
+
+		return nil
+	})
+	// Output:
+	// true true
+	// 10.0GB
+}
+
+func ExampleLinuxCustomization_Spec() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		spec := object.LinuxCustomization{
+			HostName:   "vm0",
+			Domain:     "example.com",
+			DNSServers: []string{"10.0.0.2"},
+			NICs: []object.CustomizationNIC{
+				{IP: "10.0.0.42", SubnetMask: "255.255.255.0", Gateway: []string{"10.0.0.1"}},
+			},
+		}.Spec()
+
+		// The VM must be powered off
+		_, err = vm.Customize(ctx, spec)
+		fmt.Println(err != nil)
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		task, err = vm.Customize(ctx, spec)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		// The customization is applied on power on
+		task, err = vm.PowerOn(ctx)
+		if err != nil {
+			return err
+		}
+		if err = task.Wait(ctx); err != nil {
+			return err
+		}
+
+		ip, err := vm.WaitForIP(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println(ip)
+
+		return nil
+	})
+	// Output:
+	// true
+	// 10.0.0.42
+}
+
+func ExampleVirtualMachine_SetScheduledPowerOps() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		_, err = vm.SetScheduledPowerOps(ctx,
+			object.ScheduledPowerOp{Method: "PowerOnVM_Task", Hour: 8},
+			object.ScheduledPowerOp{Method: "ShutdownGuest", Hour: 18, Minute: 30},
+		)
+		if err != nil {
+			return err
+		}
+
+		// replaces the existing schedule
+		tasks, err := vm.SetScheduledPowerOps(ctx,
+			object.ScheduledPowerOp{Method: "PowerOnVM_Task", Hour: 7},
+			object.ScheduledPowerOp{Method: "PowerOffVM_Task", Hour: 19},
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			info, err := task.Info(ctx)
+			if err != nil {
+				return err
+			}
+			scheduler := info.Scheduler.(*types.DailyTaskScheduler)
+			action := info.Action.(*types.MethodAction)
+			fmt.Printf("%s at %02d:%02d\n", action.Name, scheduler.Hour, scheduler.Minute)
+		}
+
+		tasks, err = vm.ScheduledPowerOps(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(len(tasks))
+
+		return nil
+	})
+	// Output:
+	// PowerOnVM_Task at 07:00
+	// PowerOffVM_Task at 19:00
+	// 2
+}
+
+func ExampleVirtualMachine_SnapshotTree() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		for _, name := range []string{"base", "backup", "backup"} {
+			task, err := vm.CreateSnapshot(ctx, name, "", false, false)
+			if err != nil {
+				return err
+			}
+			if err = task.Wait(ctx); err != nil {
+				return err
+			}
+		}
+
+		tree, err := vm.SnapshotTree(ctx)
+		if err != nil {
+			return err
+		}
+
+		var show func(string, []object.SnapshotNode)
+		show = func(indent string, nodes []object.SnapshotNode) {
+			for _, node := range nodes {
+				fmt.Printf("%s%s current=%t\n", indent, node.Name, node.Current)
+				show(indent+"  ", node.Children)
+			}
+		}
+		show("", tree)
+
+		_, err = vm.FindSnapshot(ctx, "backup")
+		if merr, ok := err.(*object.MultipleFoundError); ok {
+			fmt.Println(merr.Error(), len(merr.Found))
+		}
+
+		sizes, err := vm.SnapshotSize(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Println(len(sizes))
+
+		return nil
+	})
+	// Output:
+	// base current=false
+	//   backup current=false
+	//     backup current=true
+	// path 'backup' resolves to multiple snapshots 2
+	// 3
+}
+
+func ExampleVirtualMachine_ExportDevices() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			return err
+		}
+
+		data, err := vm.ExportDevices(ctx)
+		if err != nil {
+			return err
+		}
+
+		devices, err := object.UnmarshalDeviceList(data)
+		if err != nil {
+			return err
+		}
+
+		nics := devices.SelectByType((*types.VirtualEthernetCard)(nil))
+		backing := nics[0].GetVirtualDevice().Backing
+
+		fmt.Printf("%s %T\n", devices.Name(nics[0]), backing)
+
+		return nil
+	})
+	// Output: ethernet-0 *types.VirtualEthernetCardDistributedVirtualPortBackingInfo
+}
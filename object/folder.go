@@ -18,6 +18,8 @@ package object
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -169,6 +171,87 @@ func (f Folder) CreateVM(ctx context.Context, config types.VirtualMachineConfigS
 	return NewTask(f.c, res.Returnval), nil
 }
 
+// VMCreateOptions are the options used by Folder.CreateVMFromSpec to create a VM.
+type VMCreateOptions struct {
+	Name           string     // Name of the VM, required
+	GuestID        string     // GuestID defaults to "otherGuest"
+	MemoryMB       int64      // MemoryMB defaults to 1024
+	NumCPUs        int32      // NumCPUs defaults to 1
+	Datastore      *Datastore // Datastore where the VM files are created, required
+	Firmware       string     // Firmware defaults to "bios"
+	SCSIController string     // SCSIController type, as per VirtualDeviceList.CreateSCSIController
+}
+
+// configSpec returns a VirtualMachineConfigSpec for the options, with the VM files placed on the given datastore name.
+func (o VMCreateOptions) configSpec(datastore string) (types.VirtualMachineConfigSpec, error) {
+	spec := types.VirtualMachineConfigSpec{
+		Name:     o.Name,
+		GuestId:  o.GuestID,
+		MemoryMB: o.MemoryMB,
+		NumCPUs:  o.NumCPUs,
+		Firmware: o.Firmware,
+		Files: &types.VirtualMachineFileInfo{
+			VmPathName: fmt.Sprintf("[%s]", datastore),
+		},
+	}
+
+	if spec.GuestId == "" {
+		spec.GuestId = string(types.VirtualMachineGuestOsIdentifierOtherGuest)
+	}
+	if spec.MemoryMB == 0 {
+		spec.MemoryMB = 1024
+	}
+	if spec.NumCPUs == 0 {
+		spec.NumCPUs = 1
+	}
+	if spec.Firmware == "" {
+		spec.Firmware = string(types.GuestOsDescriptorFirmwareTypeBios)
+	}
+
+	var devices VirtualDeviceList
+
+	scsi, err := devices.CreateSCSIController(o.SCSIController)
+	if err != nil {
+		return spec, err
+	}
+
+	devices = append(devices, scsi)
+
+	spec.DeviceChange, err = devices.ConfigSpec(types.VirtualDeviceConfigSpecOperationAdd)
+	if err != nil {
+		return spec, err
+	}
+
+	return spec, nil
+}
+
+// CreateVMFromSpec creates a VM in the Folder as per CreateVM, constructing the VirtualMachineConfigSpec from opts.
+// The VM files are placed in a directory named after the VM on opts.Datastore and a SCSI controller is added.
+func (f Folder) CreateVMFromSpec(ctx context.Context, opts VMCreateOptions, pool *ResourcePool, host *HostSystem) (*Task, error) {
+	if opts.Name == "" {
+		return nil, errors.New("create vm: name is required")
+	}
+	if opts.Datastore == nil {
+		return nil, errors.New("create vm: datastore is required")
+	}
+
+	name := opts.Datastore.Name()
+	if opts.Datastore.InventoryPath == "" {
+		var err error
+		name, err = opts.Datastore.ObjectName(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	spec, err := opts.configSpec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.CreateVM(ctx, spec, pool, host)
+}
+
 func (f Folder) RegisterVM(ctx context.Context, path string, name string, asTemplate bool, pool *ResourcePool, host *HostSystem) (*Task, error) {
 	req := types.RegisterVM_Task{
 		This:       f.Reference(),
@@ -0,0 +1,160 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/vmware/govmomi/internal/esxcli"
+)
+
+// EsxcliResponse is the result of HostSystem.Esxcli and HostEsxcli.Run.
+type EsxcliResponse struct {
+	Values []map[string][]string // Values are the rows of a command that returns structured data
+	String string                // String is the result of a command that returns a single string
+}
+
+// HostEsxcli runs esxcli commands on a host, as returned by HostSystem.NewEsxcli.
+// The command executor and the command info it fetches from the host are reused between commands.
+type HostEsxcli struct {
+	e *esxcli.Executor
+}
+
+// NewEsxcli returns a HostEsxcli for running esxcli commands on the host.
+func (h HostSystem) NewEsxcli(ctx context.Context) (*HostEsxcli, error) {
+	e, err := esxcli.NewExecutor(ctx, h.c, h)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HostEsxcli{e: e}, nil
+}
+
+// Esxcli runs the esxcli command given by args on the host, for example:
+// []string{"network", "ip", "interface", "list"}
+// If the command fails, the error message reported by esxcli is returned.
+// Use NewEsxcli to run more than one command, rather than creating a new executor each time.
+func (h HostSystem) Esxcli(ctx context.Context, args []string) (*EsxcliResponse, error) {
+	x, err := h.NewEsxcli(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return x.Run(ctx, args)
+}
+
+// Run runs the esxcli command given by args, as HostSystem.Esxcli does.
+func (x *HostEsxcli) Run(ctx context.Context, args []string) (*EsxcliResponse, error) {
+	res, err := x.e.Run(ctx, args)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &EsxcliResponse{String: res.String}
+	for _, v := range res.Values {
+		r.Values = append(r.Values, v)
+	}
+
+	return r, nil
+}
+
+// EsxcliIPInterface is a row of 'esxcli network ip interface list'.
+type EsxcliIPInterface struct {
+	Name             string
+	MACAddress       string
+	Enabled          bool
+	Portgroup        string
+	Portset          string
+	NetstackInstance string
+	MTU              int32
+}
+
+// IPInterfaces returns the VMkernel network interfaces of the host, via 'esxcli network ip interface list'.
+func (x *HostEsxcli) IPInterfaces(ctx context.Context) ([]EsxcliIPInterface, error) {
+	res, err := x.Run(ctx, []string{"network", "ip", "interface", "list"})
+	if err != nil {
+		return nil, err
+	}
+
+	return esxcliIPInterfaces(res.Values), nil
+}
+
+func esxcliIPInterfaces(rows []map[string][]string) []EsxcliIPInterface {
+	nics := make([]EsxcliIPInterface, 0, len(rows))
+
+	for _, row := range rows {
+		mtu, _ := strconv.ParseInt(esxcliValue(row, "MTU"), 10, 32)
+
+		nics = append(nics, EsxcliIPInterface{
+			Name:             esxcliValue(row, "Name"),
+			MACAddress:       esxcliValue(row, "MACAddress"),
+			Enabled:          esxcliValue(row, "Enabled") == "true",
+			Portgroup:        esxcliValue(row, "Portgroup"),
+			Portset:          esxcliValue(row, "Portset"),
+			NetstackInstance: esxcliValue(row, "NetstackInstance"),
+			MTU:              int32(mtu),
+		})
+	}
+
+	return nics
+}
+
+// EsxcliVIB is a row of 'esxcli software vib list'.
+type EsxcliVIB struct {
+	ID              string
+	Name            string
+	Version         string
+	Vendor          string
+	AcceptanceLevel string
+	InstallDate     string
+}
+
+// VIBs returns the VIBs installed on the host, via 'esxcli software vib list'.
+func (x *HostEsxcli) VIBs(ctx context.Context) ([]EsxcliVIB, error) {
+	res, err := x.Run(ctx, []string{"software", "vib", "list"})
+	if err != nil {
+		return nil, err
+	}
+
+	return esxcliVIBs(res.Values), nil
+}
+
+func esxcliVIBs(rows []map[string][]string) []EsxcliVIB {
+	vibs := make([]EsxcliVIB, 0, len(rows))
+
+	for _, row := range rows {
+		vibs = append(vibs, EsxcliVIB{
+			ID:              esxcliValue(row, "ID"),
+			Name:            esxcliValue(row, "Name"),
+			Version:         esxcliValue(row, "Version"),
+			Vendor:          esxcliValue(row, "Vendor"),
+			AcceptanceLevel: esxcliValue(row, "AcceptanceLevel"),
+			InstallDate:     esxcliValue(row, "InstallDate"),
+		})
+	}
+
+	return vibs
+}
+
+// esxcliValue returns the first value of the given field in an esxcli response row.
+func esxcliValue(row map[string][]string, key string) string {
+	if v := row[key]; len(v) != 0 {
+		return v[0]
+	}
+	return ""
+}
@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEsxcliIPInterfaces(t *testing.T) {
+	rows := []map[string][]string{
+		{
+			"Name":             {"vmk0"},
+			"MACAddress":       {"00:0c:29:5b:7d:3a"},
+			"Enabled":          {"true"},
+			"Portgroup":        {"Management Network"},
+			"Portset":          {"vSwitch0"},
+			"NetstackInstance": {"defaultTcpipStack"},
+			"MTU":              {"1500"},
+		},
+		{
+			"Name":    {"vmk1"},
+			"Enabled": {"false"},
+			"MTU":     {"9000"},
+		},
+	}
+
+	expect := []EsxcliIPInterface{
+		{
+			Name:             "vmk0",
+			MACAddress:       "00:0c:29:5b:7d:3a",
+			Enabled:          true,
+			Portgroup:        "Management Network",
+			Portset:          "vSwitch0",
+			NetstackInstance: "defaultTcpipStack",
+			MTU:              1500,
+		},
+		{
+			Name: "vmk1",
+			MTU:  9000,
+		},
+	}
+
+	nics := esxcliIPInterfaces(rows)
+	if !reflect.DeepEqual(nics, expect) {
+		t.Errorf("%#v != %#v", nics, expect)
+	}
+}
+
+func TestEsxcliVIBs(t *testing.T) {
+	rows := []map[string][]string{
+		{
+			"ID":              {"VMware_bootbank_esx-base_7.0.2-0.0.17630552"},
+			"Name":            {"esx-base"},
+			"Version":         {"7.0.2-0.0.17630552"},
+			"Vendor":          {"VMware"},
+			"AcceptanceLevel": {"VMwareCertified"},
+			"InstallDate":     {"2021-03-09"},
+		},
+	}
+
+	vibs := esxcliVIBs(rows)
+	if len(vibs) != 1 {
+		t.Fatalf("%d vibs", len(vibs))
+	}
+
+	vib := vibs[0]
+	if vib.Name != "esx-base" || vib.Vendor != "VMware" || vib.AcceptanceLevel != "VMwareCertified" {
+		t.Errorf("%#v", vib)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/vmware/govmomi/vim25"
@@ -58,6 +59,51 @@ func (s HostFirewallSystem) EnableRuleset(ctx context.Context, id string) error
 	return err
 }
 
+func (s HostFirewallSystem) UpdateRuleset(ctx context.Context, id string, spec types.HostFirewallRulesetRulesetSpec) error {
+	req := types.UpdateRuleset{
+		This: s.Reference(),
+		Id:   id,
+		Spec: spec,
+	}
+
+	_, err := methods.UpdateRuleset(ctx, s.c, &req)
+	return err
+}
+
+// SetRulesetAllowedIP sets the hosts allowed to connect via the given ruleset.
+// Each ip can be an address, such as "10.0.0.1", or a network in CIDR notation, such as "10.0.0.0/24".
+// All hosts are allowed if no ips are given.
+func (s HostFirewallSystem) SetRulesetAllowedIP(ctx context.Context, id string, ips ...string) error {
+	var spec types.HostFirewallRulesetRulesetSpec
+
+	if len(ips) == 0 {
+		spec.AllowedHosts.AllIp = true
+	}
+
+	for _, ip := range ips {
+		if !strings.Contains(ip, "/") {
+			if net.ParseIP(ip) == nil {
+				return fmt.Errorf("invalid IP address: %q", ip)
+			}
+			spec.AllowedHosts.IpAddress = append(spec.AllowedHosts.IpAddress, ip)
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(ip)
+		if err != nil {
+			return err
+		}
+
+		prefix, _ := network.Mask.Size()
+		spec.AllowedHosts.IpNetwork = append(spec.AllowedHosts.IpNetwork, types.HostFirewallRulesetIpNetwork{
+			Network:      network.IP.String(),
+			PrefixLength: int32(prefix),
+		})
+	}
+
+	return s.UpdateRuleset(ctx, id, spec)
+}
+
 func (s HostFirewallSystem) Refresh(ctx context.Context) error {
 	req := types.RefreshFirewall{
 		This: s.Reference(),
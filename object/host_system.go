@@ -20,11 +20,15 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"regexp"
+	"time"
 
 	"github.com/vmware/govmomi/internal"
+	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -42,6 +46,131 @@ func (h HostSystem) ConfigManager() *HostConfigManager {
 	return NewHostConfigManager(h.c, h.Reference())
 }
 
+// ListRulesets returns the rulesets of the host's firewall.
+func (h HostSystem) ListRulesets(ctx context.Context) (HostFirewallRulesetList, error) {
+	fs, err := h.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := fs.Info(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.Ruleset, nil
+}
+
+// EnableRuleset enables the host firewall ruleset with the given id, such as "sshClient".
+func (h HostSystem) EnableRuleset(ctx context.Context, id string) error {
+	fs, err := h.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return fs.EnableRuleset(ctx, id)
+}
+
+// DisableRuleset disables the host firewall ruleset with the given id.
+func (h HostSystem) DisableRuleset(ctx context.Context, id string) error {
+	fs, err := h.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return fs.DisableRuleset(ctx, id)
+}
+
+// SetRulesetAllowedIP sets the hosts allowed by the host firewall ruleset with the given id,
+// see HostFirewallSystem.SetRulesetAllowedIP.
+func (h HostSystem) SetRulesetAllowedIP(ctx context.Context, id string, ips ...string) error {
+	fs, err := h.ConfigManager().FirewallSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return fs.SetRulesetAllowedIP(ctx, id, ips...)
+}
+
+// networkInfo returns the host's network system and its network configuration.
+func (h HostSystem) networkInfo(ctx context.Context) (*HostNetworkSystem, *types.HostNetworkInfo, error) {
+	ns, err := h.ConfigManager().NetworkSystem(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mns mo.HostNetworkSystem
+
+	err = ns.Properties(ctx, ns.Reference(), []string{"networkInfo"}, &mns)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if mns.NetworkInfo == nil {
+		return ns, new(types.HostNetworkInfo), nil
+	}
+
+	return ns, mns.NetworkInfo, nil
+}
+
+// AddStandardSwitch adds a standard virtual switch with the given name and spec to the host.
+func (h HostSystem) AddStandardSwitch(ctx context.Context, name string, spec types.HostVirtualSwitchSpec) error {
+	ns, err := h.ConfigManager().NetworkSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return ns.AddVirtualSwitch(ctx, name, &spec)
+}
+
+// AddPortGroup adds a port group with the given name and VLAN ID to the host's standard virtual switch vSwitch.
+// A vlanID of 0 disables VLAN tagging and 4095 allows all VLANs.
+// An error is returned if the host has no virtual switch named vSwitch.
+func (h HostSystem) AddPortGroup(ctx context.Context, vSwitch, name string, vlanID int32) error {
+	if vlanID < 0 || vlanID > 4095 {
+		return fmt.Errorf("invalid VLAN ID %d, must be in the range 0-4095", vlanID)
+	}
+
+	ns, info, err := h.networkInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	var names []string
+	for _, vs := range info.Vswitch {
+		if vs.Name == vSwitch {
+			return ns.AddPortGroup(ctx, types.HostPortGroupSpec{
+				Name:        name,
+				VlanId:      vlanID,
+				VswitchName: vSwitch,
+			})
+		}
+		names = append(names, vs.Name)
+	}
+
+	return fmt.Errorf("%s: virtual switch %q not found, valid names=%s", h.Reference(), vSwitch, names)
+}
+
+// AddVMKernelNIC adds a VMkernel network adapter connected to the given port group,
+// returning the device name of the new adapter, such as "vmk1".
+// An error is returned if the host has no port group with the given name.
+func (h HostSystem) AddVMKernelNIC(ctx context.Context, portgroup string, spec types.HostVirtualNicSpec) (string, error) {
+	ns, info, err := h.networkInfo(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var names []string
+	for _, pg := range info.Portgroup {
+		if pg.Spec.Name == portgroup {
+			return ns.AddVirtualNic(ctx, portgroup, spec)
+		}
+		names = append(names, pg.Spec.Name)
+	}
+
+	return "", fmt.Errorf("%s: port group %q not found, valid names=%s", h.Reference(), portgroup, names)
+}
+
 func (h HostSystem) ResourcePool(ctx context.Context) (*ResourcePool, error) {
 	var mh mo.HostSystem
 
@@ -113,6 +242,73 @@ func (h HostSystem) Reconnect(ctx context.Context, cnxSpec *types.HostConnectSpe
 	return NewTask(h.c, res.Returnval), nil
 }
 
+// SSLVerifyError is returned when a host connection fails due to SSL certificate verification.
+// Thumbprint is the host's certificate thumbprint as reported by the server,
+// which can be set as HostConnectSpec.SslThumbprint to pin the certificate in a subsequent request.
+type SSLVerifyError struct {
+	Host       string
+	Thumbprint string
+	SelfSigned bool
+	Err        error
+}
+
+func (e *SSLVerifyError) Error() string {
+	return fmt.Sprintf("%s: SSL certificate verification failed, server reported thumbprint %s", e.Host, e.Thumbprint)
+}
+
+func (e *SSLVerifyError) Unwrap() error {
+	return e.Err
+}
+
+// sslVerifyError returns an SSLVerifyError if err contains an SSLVerifyFault, otherwise err is returned as-is.
+func sslVerifyError(host string, err error) error {
+	var fault *types.SSLVerifyFault
+	if soap.AsFault(err, &fault) {
+		return &SSLVerifyError{
+			Host:       host,
+			Thumbprint: fault.Thumbprint,
+			SelfSigned: fault.SelfSigned,
+			Err:        err,
+		}
+	}
+	return err
+}
+
+// ReconnectAndWait reconnects the host, optionally updating its connection credentials with the given spec,
+// waits for the reconnect task to complete and for runtime.connectionState to be connected.
+// If timeout is greater than zero, an error is returned if the host is not connected within that duration.
+// If the reconnect fails due to a certificate thumbprint mismatch, the error is of type *SSLVerifyError.
+func (h HostSystem) ReconnectAndWait(ctx context.Context, spec *types.HostConnectSpec, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	task, err := h.Reconnect(ctx, spec, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = task.Wait(ctx); err != nil {
+		name := h.Name()
+		if spec != nil && spec.HostName != "" {
+			name = spec.HostName
+		}
+		return sslVerifyError(name, err)
+	}
+
+	p := property.DefaultCollector(h.c)
+	return property.Wait(ctx, p, h.Reference(), []string{"runtime.connectionState"}, func(pc []types.PropertyChange) bool {
+		for _, c := range pc {
+			if c.Name == "runtime.connectionState" && c.Val == types.HostSystemConnectionStateConnected {
+				return true
+			}
+		}
+		return false
+	})
+}
+
 func (h HostSystem) EnterMaintenanceMode(ctx context.Context, timeout int32, evacuate bool, spec *types.HostMaintenanceSpec) (*Task, error) {
 	req := types.EnterMaintenanceMode_Task{
 		This:                  h.Reference(),
@@ -142,3 +338,131 @@ func (h HostSystem) ExitMaintenanceMode(ctx context.Context, timeout int32) (*Ta
 
 	return NewTask(h.c, res.Returnval), nil
 }
+
+// DatastoreInfo describes a datastore as seen from a particular host.
+type DatastoreInfo struct {
+	Datastore  *Datastore
+	Name       string
+	URL        string
+	Type       string
+	Capacity   int64
+	FreeSpace  int64
+	Accessible bool
+	MountInfo  *types.HostMountInfo // MountInfo is the host's mount of the datastore, if reported
+}
+
+// AccessibleDatastores returns info for each datastore mounted by the host, including capacity, free space
+// and accessibility state. The host's datastore list and each datastore's summary are read using a single
+// property collector call. Accessible reflects the host's mount info when available, otherwise the datastore summary.
+func (h HostSystem) AccessibleDatastores(ctx context.Context) ([]DatastoreInfo, error) {
+	spec := new(property.FilterSpec).
+		Object(h.Reference(), true).
+		Traverse("datastore", "HostSystem", "datastore").
+		Properties("Datastore", "summary", "host")
+
+	var stores []mo.Datastore
+	err := property.DefaultCollector(h.c).RetrieveFiltered(ctx, spec, &stores)
+	if err != nil {
+		return nil, err
+	}
+
+	info := make([]DatastoreInfo, 0, len(stores))
+
+	for _, ds := range stores {
+		i := DatastoreInfo{
+			Datastore:  NewDatastore(h.c, ds.Self),
+			Name:       ds.Summary.Name,
+			URL:        ds.Summary.Url,
+			Type:       ds.Summary.Type,
+			Capacity:   ds.Summary.Capacity,
+			FreeSpace:  ds.Summary.FreeSpace,
+			Accessible: ds.Summary.Accessible,
+		}
+
+		for _, mount := range ds.Host {
+			if mount.Key == h.Reference() {
+				m := mount.MountInfo
+				i.MountInfo = &m
+				if m.Accessible != nil {
+					i.Accessible = *m.Accessible
+				}
+				break
+			}
+		}
+
+		info = append(info, i)
+	}
+
+	return info, nil
+}
+
+// ntpServiceID is the HostServiceSystem key of the ESX NTP daemon.
+const ntpServiceID = "ntpd"
+
+var hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// SetNTPServers sets the host's NTP servers, each of which must be an IP address or hostname.
+// If the NTP service is running, it is restarted to apply the change.
+func (h HostSystem) SetNTPServers(ctx context.Context, servers []string) error {
+	for _, server := range servers {
+		if net.ParseIP(server) == nil && !hostnameRegexp.MatchString(server) {
+			return fmt.Errorf("invalid NTP server address %q", server)
+		}
+	}
+
+	m := h.ConfigManager()
+
+	dts, err := m.DateTimeSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = dts.UpdateConfig(ctx, types.HostDateTimeConfig{
+		NtpConfig: &types.HostNtpConfig{Server: servers},
+	})
+	if err != nil {
+		return err
+	}
+
+	ss, err := m.ServiceSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	services, err := ss.Service(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		if service.Key == ntpServiceID && service.Running {
+			return ss.Restart(ctx, ntpServiceID)
+		}
+	}
+
+	return nil
+}
+
+// StartNTPService starts the host's NTP service, setting its policy to start and stop with the host.
+func (h HostSystem) StartNTPService(ctx context.Context) error {
+	ss, err := h.ConfigManager().ServiceSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = ss.UpdatePolicy(ctx, ntpServiceID, string(types.HostServicePolicyOn)); err != nil {
+		return err
+	}
+
+	return ss.Start(ctx, ntpServiceID)
+}
+
+// SetDateTime sets the host's system clock to the given time.
+func (h HostSystem) SetDateTime(ctx context.Context, t time.Time) error {
+	dts, err := h.ConfigManager().DateTimeSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	return dts.Update(ctx, t)
+}
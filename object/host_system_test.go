@@ -15,12 +15,20 @@ package object_test
 
 import (
 	"context"
+	"errors"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestHostSystemManagementIPs(t *testing.T) {
@@ -51,3 +59,314 @@ func TestHostSystemManagementIPs(t *testing.T) {
 		return nil
 	})
 }
+
+func TestHostSystemReconnectAndWait(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		ref := simulator.Map.Any("HostSystem").Reference()
+		host := object.NewHostSystem(c, ref)
+
+		task, err := host.Disconnect(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		spec := &types.HostConnectSpec{UserName: "root", Password: "secret"}
+
+		if err = host.ReconnectAndWait(ctx, spec, time.Minute); err != nil {
+			t.Fatal(err)
+		}
+
+		var mh mo.HostSystem
+		if err = host.Properties(ctx, ref, []string{"runtime.connectionState"}, &mh); err != nil {
+			t.Fatal(err)
+		}
+		if mh.Runtime.ConnectionState != types.HostSystemConnectionStateConnected {
+			t.Errorf("state=%s", mh.Runtime.ConnectionState)
+		}
+
+		simulator.Override("ReconnectHost_Task", func(ctx *simulator.Context, _ types.AnyType) (soap.HasFault, bool) {
+			task := simulator.CreateTask(ref, "reconnectHost", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+				return nil, &types.SSLVerifyFault{Thumbprint: "AA:BB:CC"}
+			})
+			return &methods.ReconnectHost_TaskBody{
+				Res: &types.ReconnectHost_TaskResponse{Returnval: task.Run(ctx)},
+			}, true
+		})
+
+		err = host.ReconnectAndWait(ctx, spec, time.Minute)
+		var verr *object.SSLVerifyError
+		if !errors.As(err, &verr) {
+			t.Fatalf("err=%#v", err)
+		}
+		if verr.Thumbprint != "AA:BB:CC" {
+			t.Errorf("thumbprint=%s", verr.Thumbprint)
+		}
+	})
+}
+
+func TestHostSystemRulesets(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, simulator.Map.Any("HostSystem").Reference())
+
+		ruleset := func() types.HostFirewallRuleset {
+			rulesets, err := host.ListRulesets(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, rs := range rulesets {
+				if rs.Key == "sshClient" {
+					return rs
+				}
+			}
+			t.Fatal("sshClient ruleset not found")
+			return types.HostFirewallRuleset{}
+		}
+
+		if err := host.EnableRuleset(ctx, "sshClient"); err != nil {
+			t.Fatal(err)
+		}
+		if !ruleset().Enabled {
+			t.Error("expected ruleset to be enabled")
+		}
+
+		if err := host.DisableRuleset(ctx, "sshClient"); err != nil {
+			t.Fatal(err)
+		}
+		if ruleset().Enabled {
+			t.Error("expected ruleset to be disabled")
+		}
+
+		if err := host.SetRulesetAllowedIP(ctx, "sshClient", "10.0.0.1", "192.168.0.0/16"); err != nil {
+			t.Fatal(err)
+		}
+		allowed := ruleset().AllowedHosts
+		if allowed.AllIp || len(allowed.IpAddress) != 1 || len(allowed.IpNetwork) != 1 ||
+			allowed.IpNetwork[0].Network != "192.168.0.0" || allowed.IpNetwork[0].PrefixLength != 16 {
+			t.Errorf("allowed=%#v", allowed)
+		}
+
+		if err := host.SetRulesetAllowedIP(ctx, "sshClient"); err != nil {
+			t.Fatal(err)
+		}
+		if !ruleset().AllowedHosts.AllIp {
+			t.Error("expected all hosts to be allowed")
+		}
+
+		if err := host.SetRulesetAllowedIP(ctx, "sshClient", "10.0.0"); err == nil {
+			t.Error("expected error")
+		}
+
+		if err := host.EnableRuleset(ctx, "enoent"); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestHostSystemStandardSwitch(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, simulator.Map.Any("HostSystem").Reference())
+
+		err := host.AddStandardSwitch(ctx, "vSwitch1", types.HostVirtualSwitchSpec{NumPorts: 128})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = host.AddPortGroup(ctx, "vSwitch1", "pg1", 100); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = host.AddPortGroup(ctx, "vSwitch9", "pg2", 0); err == nil {
+			t.Error("expected error")
+		}
+
+		if err = host.AddPortGroup(ctx, "vSwitch1", "pg2", 4096); err == nil {
+			t.Error("expected error")
+		}
+
+		device, err := host.AddVMKernelNIC(ctx, "pg1", types.HostVirtualNicSpec{
+			Ip: &types.HostIpConfig{Dhcp: true},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = host.AddVMKernelNIC(ctx, "pg9", types.HostVirtualNicSpec{}); err == nil {
+			t.Error("expected error")
+		}
+
+		ns, err := host.ConfigManager().NetworkSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var mns mo.HostNetworkSystem
+		err = ns.Properties(ctx, ns.Reference(), []string{"networkInfo"}, &mns)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, vs := range mns.NetworkInfo.Vswitch {
+			if vs.Name == "vSwitch1" && (vs.NumPorts != 128 || len(vs.Portgroup) != 1) {
+				t.Errorf("vswitch=%#v", vs)
+			}
+		}
+
+		for _, pg := range mns.NetworkInfo.Portgroup {
+			if pg.Spec.Name == "pg1" && pg.Spec.VlanId != 100 {
+				t.Errorf("vlan=%d", pg.Spec.VlanId)
+			}
+		}
+
+		found := false
+		for _, nic := range mns.NetworkInfo.Vnic {
+			if nic.Device == device {
+				found = nic.Portgroup == "pg1"
+			}
+		}
+		if !found {
+			t.Errorf("vnic %q not found", device)
+		}
+	})
+}
+
+func TestHostSystemAccessibleDatastores(t *testing.T) {
+	model := simulator.VPX()
+	model.Datastore = 2
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, simulator.Map.Any("HostSystem").Reference())
+
+		var mh mo.HostSystem
+		if err := host.Properties(ctx, host.Reference(), []string{"datastore"}, &mh); err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := host.AccessibleDatastores(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(info) != len(mh.Datastore) || len(info) != 2 {
+			t.Fatalf("datastores=%d, expected %d", len(info), len(mh.Datastore))
+		}
+
+		for _, ds := range info {
+			if ds.Name == "" || ds.Capacity == 0 || ds.FreeSpace > ds.Capacity {
+				t.Errorf("info=%#v", ds)
+			}
+			if ds.MountInfo == nil || !ds.Accessible {
+				t.Errorf("%s: expected accessible mount", ds.Name)
+			}
+		}
+	}, model)
+}
+
+func TestHostSystemNTP(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		host := object.NewHostSystem(c, simulator.Map.Any("HostSystem").Reference())
+
+		if err := host.SetNTPServers(ctx, []string{"pool ntp org"}); err == nil {
+			t.Error("expected error")
+		}
+
+		dts, err := host.ConfigManager().DateTimeSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ss, err := host.ConfigManager().ServiceSystem(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// wait for watchers of ref to see a change to prop matching fn after calling action
+		wait := func(ref types.ManagedObjectReference, prop string, action func() error, fn func(types.AnyType) bool) {
+			wctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+			defer cancel()
+
+			called := false
+			err := property.Wait(wctx, property.DefaultCollector(c), ref, []string{prop}, func(changes []types.PropertyChange) bool {
+				if !called {
+					called = true
+					if err := action(); err != nil {
+						t.Fatal(err)
+					}
+					return false
+				}
+
+				for _, change := range changes {
+					if fn(change.Val) {
+						return true
+					}
+				}
+				return false
+			})
+			if err != nil {
+				t.Fatalf("%s: %s", prop, err)
+			}
+		}
+
+		servers := []string{"0.pool.ntp.org", "10.0.0.1"}
+		wait(dts.Reference(), "dateTimeInfo", func() error {
+			return host.SetNTPServers(ctx, servers)
+		}, func(val types.AnyType) bool {
+			info, ok := val.(types.HostDateTimeInfo)
+			return ok && info.NtpConfig != nil && len(info.NtpConfig.Server) == len(servers)
+		})
+
+		wait(ss.Reference(), "serviceInfo", func() error {
+			return host.StartNTPService(ctx)
+		}, func(val types.AnyType) bool {
+			info, ok := val.(types.HostServiceInfo)
+			if ok {
+				for _, s := range info.Service {
+					if s.Key == "ntpd" {
+						return s.Running
+					}
+				}
+			}
+			return false
+		})
+
+		var mdts mo.HostDateTimeSystem
+		if err = dts.Properties(ctx, dts.Reference(), []string{"dateTimeInfo"}, &mdts); err != nil {
+			t.Fatal(err)
+		}
+		if ntp := mdts.DateTimeInfo.NtpConfig; ntp == nil || len(ntp.Server) != 2 || ntp.Server[1] != servers[1] {
+			t.Errorf("ntp=%#v", ntp)
+		}
+
+		services, err := ss.Service(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range services {
+			if s.Key == "ntpd" && (!s.Running || s.Policy != "on") {
+				t.Errorf("ntpd=%#v", s)
+			}
+		}
+
+		// restarts the running service
+		if err = host.SetNTPServers(ctx, servers[:1]); err != nil {
+			t.Fatal(err)
+		}
+
+		date := time.Now().Add(-time.Hour)
+		if err = host.SetDateTime(ctx, date); err != nil {
+			t.Fatal(err)
+		}
+
+		now, err := dts.Query(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := now.Sub(date); d < 0 || d > time.Minute {
+			t.Errorf("time=%s, expected %s", now, date)
+		}
+	})
+}
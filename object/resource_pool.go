@@ -18,7 +18,9 @@ package object
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/vmware/govmomi/internal"
 	"github.com/vmware/govmomi/nfc"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -36,7 +38,8 @@ func NewResourcePool(c *vim25.Client, ref types.ManagedObjectReference) *Resourc
 	}
 }
 
-// Owner returns the ResourcePool owner as a ClusterComputeResource or ComputeResource.
+// Owner returns the ResourcePool owner as a ClusterComputeResource or ComputeResource,
+// with its InventoryPath set in the same form as the find package.
 func (p ResourcePool) Owner(ctx context.Context) (Reference, error) {
 	var pool mo.ResourcePool
 
@@ -45,7 +48,18 @@ func (p ResourcePool) Owner(ctx context.Context) (Reference, error) {
 		return nil, err
 	}
 
-	return NewReference(p.Client(), pool.Owner), nil
+	owner := NewReference(p.Client(), pool.Owner)
+
+	entities, err := mo.Ancestors(ctx, p.c, p.c.ServiceContent.PropertyCollector, pool.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if o, ok := owner.(interface{ SetInventoryPath(string) }); ok {
+		o.SetInventoryPath(internal.InventoryPath(entities))
+	}
+
+	return owner, nil
 }
 
 func (p ResourcePool) ImportVApp(ctx context.Context, spec types.BaseImportSpec, folder *Folder, host *HostSystem) (*nfc.Lease, error) {
@@ -87,6 +101,83 @@ func (p ResourcePool) Create(ctx context.Context, name string, spec types.Resour
 	return NewResourcePool(p.c, res.Returnval), nil
 }
 
+// ResourcePoolAllocation is a simplified form of types.ResourceAllocationInfo.
+// The zero value results in the same defaults as types.DefaultResourceConfigSpec:
+// no reservation, expandable reservation, no limit and normal shares.
+type ResourcePoolAllocation struct {
+	Reservation      int64             // Guaranteed allocation in MHz (CPU) or MB (memory)
+	Limit            *int64            // Upper bound in MHz (CPU) or MB (memory), nil for unlimited
+	FixedReservation bool              // If true, the reservation cannot grow beyond the specified value
+	Shares           types.SharesLevel // Defaults to types.SharesLevelNormal
+	CustomShares     int32             // Number of shares when Shares is types.SharesLevelCustom
+}
+
+// ResourcePoolSpec is a simplified form of types.ResourceConfigSpec, see ResourcePool.CreateChild.
+type ResourcePoolSpec struct {
+	CPU    ResourcePoolAllocation
+	Memory ResourcePoolAllocation
+}
+
+func (a ResourcePoolAllocation) info(kind string) (types.ResourceAllocationInfo, error) {
+	info := types.ResourceAllocationInfo{
+		Reservation:           types.NewInt64(a.Reservation),
+		ExpandableReservation: types.NewBool(!a.FixedReservation),
+		Limit:                 types.NewInt64(-1),
+		Shares: &types.SharesInfo{
+			Level:  a.Shares,
+			Shares: a.CustomShares,
+		},
+	}
+
+	if a.Reservation < 0 {
+		return info, fmt.Errorf("%s reservation (%d) must not be negative", kind, a.Reservation)
+	}
+
+	if a.Limit != nil && *a.Limit >= 0 {
+		if a.Reservation > *a.Limit {
+			return info, fmt.Errorf("%s reservation (%d) exceeds limit (%d)", kind, a.Reservation, *a.Limit)
+		}
+		info.Limit = types.NewInt64(*a.Limit)
+	}
+
+	if info.Shares.Level == "" {
+		info.Shares.Level = types.SharesLevelNormal
+	}
+
+	return info, nil
+}
+
+// ConfigSpec returns a types.ResourceConfigSpec for the given ResourcePoolSpec,
+// or an error if a reservation exceeds its limit.
+func (s ResourcePoolSpec) ConfigSpec() (types.ResourceConfigSpec, error) {
+	var spec types.ResourceConfigSpec
+	var err error
+
+	spec.CpuAllocation, err = s.CPU.info("cpu")
+	if err != nil {
+		return spec, err
+	}
+
+	spec.MemoryAllocation, err = s.Memory.info("memory")
+	if err != nil {
+		return spec, err
+	}
+
+	return spec, nil
+}
+
+// CreateChild creates a child resource pool with the given name, using the defaults of ResourcePoolSpec
+// for any unset allocation fields.
+// The spec is validated before calling the CreateResourcePool method.
+func (p ResourcePool) CreateChild(ctx context.Context, name string, spec ResourcePoolSpec) (*ResourcePool, error) {
+	config, err := spec.ConfigSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Create(ctx, name, config)
+}
+
 func (p ResourcePool) CreateVApp(ctx context.Context, name string, resSpec types.ResourceConfigSpec, configSpec types.VAppConfigSpec, folder *Folder) (*VirtualApp, error) {
 	req := types.CreateVApp{
 		This:       p.Reference(),
@@ -128,6 +219,18 @@ func (p ResourcePool) UpdateConfig(ctx context.Context, name string, config *typ
 	return err
 }
 
+// MoveInto moves the given VirtualMachines, ResourcePools and VirtualApps into this pool.
+// The entities must be part of the same compute resource hierarchy.
+func (p ResourcePool) MoveInto(ctx context.Context, list ...types.ManagedObjectReference) error {
+	req := types.MoveIntoResourcePool{
+		This: p.Reference(),
+		List: list,
+	}
+
+	_, err := methods.MoveIntoResourcePool(ctx, p.c, &req)
+	return err
+}
+
 func (p ResourcePool) DestroyChildren(ctx context.Context) error {
 	req := types.DestroyChildren{
 		This: p.Reference(),
@@ -0,0 +1,151 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestResourcePoolCreateChild(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("ResourcePool")
+		pool := object.NewResourcePool(c, obj.Reference())
+
+		spec := object.ResourcePoolSpec{}
+		spec.Memory.Reservation = 1024
+		spec.Memory.Limit = types.NewInt64(512)
+
+		_, err := pool.CreateChild(ctx, "invalid", spec)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		spec.Memory.Limit = types.NewInt64(2048)
+
+		child, err := pool.CreateChild(ctx, "child", spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var p mo.ResourcePool
+		err = child.Properties(ctx, child.Reference(), []string{"config"}, &p)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cpu := p.Config.CpuAllocation
+		if *cpu.Limit != -1 || !*cpu.ExpandableReservation || cpu.Shares.Level != types.SharesLevelNormal {
+			t.Errorf("cpu=%#v", cpu)
+		}
+
+		mem := p.Config.MemoryAllocation
+		if *mem.Limit != 2048 || *mem.Reservation != 1024 {
+			t.Errorf("memory=%#v", mem)
+		}
+	})
+}
+
+func TestResourcePoolMoveInto(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+		root := object.NewResourcePool(c, *svm.ResourcePool)
+
+		child, err := root.CreateChild(ctx, "child", object.ResourcePoolSpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// watchers of the destination pool see the vm arrive
+		wctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		moved := false
+		err = property.Wait(wctx, property.DefaultCollector(c), child.Reference(), []string{"vm"}, func(changes []types.PropertyChange) bool {
+			if !moved {
+				moved = true
+				if err = vm.MoveToResourcePool(ctx, child); err != nil {
+					t.Fatal(err)
+				}
+				return false
+			}
+
+			for _, change := range changes {
+				if refs, ok := change.Val.(types.ArrayOfManagedObjectReference); ok {
+					for _, ref := range refs.ManagedObjectReference {
+						if ref == vm.Reference() {
+							return true
+						}
+					}
+				}
+			}
+			return false
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := vm.ResourcePool(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if pool.Reference() != child.Reference() {
+			t.Errorf("pool=%s", pool.Reference())
+		}
+
+		var p mo.ResourcePool
+		if err = root.Properties(ctx, root.Reference(), []string{"vm"}, &p); err != nil {
+			t.Fatal(err)
+		}
+		for _, ref := range p.Vm {
+			if ref == vm.Reference() {
+				t.Errorf("%s still in %s", ref, root.Reference())
+			}
+		}
+
+		grandchild, err := child.CreateChild(ctx, "grandchild", object.ResourcePoolSpec{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// a pool cannot be moved into its own descendant
+		if err = grandchild.MoveInto(ctx, child.Reference()); err == nil {
+			t.Error("expected error")
+		}
+
+		if err = root.MoveInto(ctx, grandchild.Reference()); err != nil {
+			t.Fatal(err)
+		}
+
+		var g mo.ResourcePool
+		if err = grandchild.Properties(ctx, grandchild.Reference(), []string{"parent"}, &g); err != nil {
+			t.Fatal(err)
+		}
+		if *g.Parent != root.Reference() {
+			t.Errorf("parent=%s", g.Parent)
+		}
+	})
+}
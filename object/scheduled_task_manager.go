@@ -0,0 +1,108 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type ScheduledTaskManager struct {
+	Common
+}
+
+func NewScheduledTaskManager(c *vim25.Client) *ScheduledTaskManager {
+	m := ScheduledTaskManager{
+		Common: NewCommon(c, *c.ServiceContent.ScheduledTaskManager),
+	}
+
+	return &m
+}
+
+// CreateScheduledTask creates a scheduled task to run the given spec's action on entity.
+func (m ScheduledTaskManager) CreateScheduledTask(ctx context.Context, entity mo.Reference, spec types.BaseScheduledTaskSpec) (*ScheduledTask, error) {
+	req := types.CreateScheduledTask{
+		This:   m.Reference(),
+		Entity: entity.Reference(),
+		Spec:   spec,
+	}
+
+	res, err := methods.CreateScheduledTask(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewScheduledTask(m.c, res.Returnval), nil
+}
+
+// RetrieveEntityScheduledTask returns the scheduled tasks of the given entity, or all scheduled tasks if entity is nil.
+func (m ScheduledTaskManager) RetrieveEntityScheduledTask(ctx context.Context, entity mo.Reference) ([]*ScheduledTask, error) {
+	req := types.RetrieveEntityScheduledTask{
+		This: m.Reference(),
+	}
+
+	if entity != nil {
+		ref := entity.Reference()
+		req.Entity = &ref
+	}
+
+	res, err := methods.RetrieveEntityScheduledTask(ctx, m.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*ScheduledTask, len(res.Returnval))
+	for i := range res.Returnval {
+		tasks[i] = NewScheduledTask(m.c, res.Returnval[i])
+	}
+
+	return tasks, nil
+}
+
+type ScheduledTask struct {
+	Common
+}
+
+func NewScheduledTask(c *vim25.Client, ref types.ManagedObjectReference) *ScheduledTask {
+	return &ScheduledTask{
+		Common: NewCommon(c, ref),
+	}
+}
+
+func (t ScheduledTask) Info(ctx context.Context) (*types.ScheduledTaskInfo, error) {
+	var st mo.ScheduledTask
+
+	err := t.Properties(ctx, t.Reference(), []string{"info"}, &st)
+	if err != nil {
+		return nil, err
+	}
+
+	return &st.Info, nil
+}
+
+func (t ScheduledTask) Remove(ctx context.Context) error {
+	req := types.RemoveScheduledTask{
+		This: t.Reference(),
+	}
+
+	_, err := methods.RemoveScheduledTask(ctx, t.c, &req)
+	return err
+}
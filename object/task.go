@@ -57,6 +57,66 @@ func (t *Task) WaitForResult(ctx context.Context, s ...progress.Sinker) (*types.
 	return task.Wait(ctx, t.Reference(), p, pr)
 }
 
+// WaitEx waits for the task to complete, calling fn with the task's progress percentage and description as they change.
+// Upon success, fn is called with a progress of 100. The final TaskInfo is returned, along with a task.Error if the task failed.
+// If ctx is cancelled or expires before the task completes and the task is cancelable, the task is cancelled via CancelTask.
+func (t *Task) WaitEx(ctx context.Context, fn func(progress int32, desc string)) (*types.TaskInfo, error) {
+	var info *types.TaskInfo
+	progress := int32(-1)
+	desc := ""
+
+	p := property.DefaultCollector(t.c)
+	err := property.Wait(ctx, p, t.Reference(), []string{"info"}, func(pc []types.PropertyChange) bool {
+		for _, c := range pc {
+			if c.Name != "info" || c.Op != types.PropertyChangeOpAssign || c.Val == nil {
+				continue
+			}
+
+			ti := c.Val.(types.TaskInfo)
+			info = &ti
+		}
+
+		if info == nil {
+			return false
+		}
+
+		done := info.State == types.TaskInfoStateSuccess || info.State == types.TaskInfoStateError
+
+		n := info.Progress
+		if info.State == types.TaskInfoStateSuccess {
+			n = 100
+		}
+
+		d := ""
+		if info.Description != nil {
+			d = info.Description.Message
+			if d == "" {
+				d = info.Description.Key
+			}
+		}
+
+		if fn != nil && (n != progress || d != desc) {
+			progress, desc = n, d
+			fn(progress, desc)
+		}
+
+		return done
+	})
+	if err != nil {
+		if ctx.Err() != nil && info != nil && info.Cancelable {
+			// ctx is done, so use a new context to request cancellation
+			_ = t.Cancel(context.Background())
+		}
+		return info, err
+	}
+
+	if info.Error != nil {
+		return info, task.Error{LocalizedMethodFault: info.Error, Description: info.Description}
+	}
+
+	return info, nil
+}
+
 func (t *Task) Cancel(ctx context.Context) error {
 	_, err := methods.CancelTask(ctx, t.Client(), &types.CancelTask{
 		This: t.Reference(),
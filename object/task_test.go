@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestTaskWaitEx(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		var reports []int32
+		progress := func(p int32, _ string) {
+			reports = append(reports, p)
+		}
+
+		ptask, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := ptask.WaitEx(ctx, progress)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.State != types.TaskInfoStateSuccess {
+			t.Errorf("state=%s", info.State)
+		}
+		if len(reports) == 0 || reports[len(reports)-1] != 100 {
+			t.Errorf("reports=%v", reports)
+		}
+
+		// already powered off
+		ptask, err = vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info, err = ptask.WaitEx(ctx, nil)
+		if _, ok := err.(task.Error); !ok {
+			t.Fatalf("err=%#v", err)
+		}
+		if info.State != types.TaskInfoStateError {
+			t.Errorf("state=%s", info.State)
+		}
+
+		simulator.TaskDelay.MethodDelay = map[string]int{"PowerOn": 500}
+		defer func() { simulator.TaskDelay.MethodDelay = nil }()
+
+		ptask, err = vm.PowerOn(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err = ptask.WaitEx(wctx, nil)
+		if err == nil || wctx.Err() != context.DeadlineExceeded {
+			t.Errorf("err=%v", err)
+		}
+
+		// the delayed task reads TaskDelay, let it complete before the deferred reset
+		_ = ptask.Wait(ctx)
+	})
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package object
 
 import (
+	"fmt"
+
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -25,43 +27,123 @@ type Reference interface {
 	Reference() types.ManagedObjectReference
 }
 
-func NewReference(c *vim25.Client, e types.ManagedObjectReference) Reference {
-	switch e.Type {
-	case "Folder":
+// ManagedObjectType is the Type of a ManagedObjectReference, for use in switch statements and type checks
+// in place of string literals.
+type ManagedObjectType string
+
+const (
+	TypeFolder                         = ManagedObjectType("Folder")
+	TypeStoragePod                     = ManagedObjectType("StoragePod")
+	TypeDatacenter                     = ManagedObjectType("Datacenter")
+	TypeVirtualMachine                 = ManagedObjectType("VirtualMachine")
+	TypeVirtualApp                     = ManagedObjectType("VirtualApp")
+	TypeComputeResource                = ManagedObjectType("ComputeResource")
+	TypeClusterComputeResource         = ManagedObjectType("ClusterComputeResource")
+	TypeHostSystem                     = ManagedObjectType("HostSystem")
+	TypeNetwork                        = ManagedObjectType("Network")
+	TypeOpaqueNetwork                  = ManagedObjectType("OpaqueNetwork")
+	TypeResourcePool                   = ManagedObjectType("ResourcePool")
+	TypeDistributedVirtualSwitch       = ManagedObjectType("DistributedVirtualSwitch")
+	TypeVmwareDistributedVirtualSwitch = ManagedObjectType("VmwareDistributedVirtualSwitch")
+	TypeDistributedVirtualPortgroup    = ManagedObjectType("DistributedVirtualPortgroup")
+	TypeDatastore                      = ManagedObjectType("Datastore")
+	TypeTask                           = ManagedObjectType("Task")
+	TypeScheduledTask                  = ManagedObjectType("ScheduledTask")
+)
+
+// referenceTypes maps each ManagedObjectType to the constructor of its object wrapper type, for use by NewReference.
+var referenceTypes = map[ManagedObjectType]func(*vim25.Client, types.ManagedObjectReference) Reference{
+	TypeFolder: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewFolder(c, e)
-	case "StoragePod":
+	},
+	TypeStoragePod: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return &StoragePod{
 			NewFolder(c, e),
 		}
-	case "Datacenter":
+	},
+	TypeDatacenter: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewDatacenter(c, e)
-	case "VirtualMachine":
+	},
+	TypeVirtualMachine: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewVirtualMachine(c, e)
-	case "VirtualApp":
+	},
+	TypeVirtualApp: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return &VirtualApp{
 			NewResourcePool(c, e),
 		}
-	case "ComputeResource":
+	},
+	TypeComputeResource: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewComputeResource(c, e)
-	case "ClusterComputeResource":
+	},
+	TypeClusterComputeResource: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewClusterComputeResource(c, e)
-	case "HostSystem":
+	},
+	TypeHostSystem: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewHostSystem(c, e)
-	case "Network":
+	},
+	TypeNetwork: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewNetwork(c, e)
-	case "OpaqueNetwork":
+	},
+	TypeOpaqueNetwork: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewOpaqueNetwork(c, e)
-	case "ResourcePool":
+	},
+	TypeResourcePool: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewResourcePool(c, e)
-	case "DistributedVirtualSwitch":
+	},
+	TypeDistributedVirtualSwitch: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewDistributedVirtualSwitch(c, e)
-	case "VmwareDistributedVirtualSwitch":
+	},
+	TypeVmwareDistributedVirtualSwitch: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return &VmwareDistributedVirtualSwitch{*NewDistributedVirtualSwitch(c, e)}
-	case "DistributedVirtualPortgroup":
+	},
+	TypeDistributedVirtualPortgroup: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewDistributedVirtualPortgroup(c, e)
-	case "Datastore":
+	},
+	TypeDatastore: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
 		return NewDatastore(c, e)
-	default:
-		panic("Unknown managed entity: " + e.Type)
+	},
+	TypeTask: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
+		return NewTask(c, e)
+	},
+	TypeScheduledTask: func(c *vim25.Client, e types.ManagedObjectReference) Reference {
+		return NewScheduledTask(c, e)
+	},
+}
+
+// TypeOf returns the ManagedObjectType of the given reference.
+func TypeOf(ref Reference) ManagedObjectType {
+	return ManagedObjectType(ref.Reference().Type)
+}
+
+// IsKnown returns true if t is one of the ManagedObjectType constants,
+// for which NewReference returns a specific wrapper type rather than a *Common.
+func (t ManagedObjectType) IsKnown() bool {
+	_, ok := referenceTypes[t]
+	return ok
+}
+
+// Check returns an error if the given reference is not of type t or has an empty Value.
+func (t ManagedObjectType) Check(ref Reference) error {
+	r := ref.Reference()
+
+	if r.Type != string(t) {
+		return fmt.Errorf("%s is not a %s", r, t)
+	}
+
+	if r.Value == "" {
+		return fmt.Errorf("%s reference has no value", t)
+	}
+
+	return nil
+}
+
+// NewReference returns the object wrapper type for the given reference, such as *VirtualMachine for a "VirtualMachine" ref.
+// References to types without a specific wrapper type are returned as a *Common.
+func NewReference(c *vim25.Client, e types.ManagedObjectReference) Reference {
+	if ref, ok := referenceTypes[ManagedObjectType(e.Type)]; ok {
+		return ref(c, e)
 	}
+
+	common := NewCommon(c, e)
+	return &common
 }
@@ -18,9 +18,11 @@ package object
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -63,6 +65,59 @@ func (p VirtualApp) UpdateConfig(ctx context.Context, spec types.VAppConfigSpec)
 	return err
 }
 
+// StartOrderEntry configures the startup and shutdown of a VirtualApp child VM, as used by VirtualApp.UpdateStartOrder.
+type StartOrderEntry struct {
+	VM              types.ManagedObjectReference // VM is a child VM of the VirtualApp
+	StartDelay      int32                        // StartDelay in seconds before starting the next VM
+	StartAction     string                       // StartAction is a types.VAppAutoStartAction, such as "powerOn" or "none"
+	StopDelay       int32                        // StopDelay in seconds before stopping the next VM
+	StopAction      string                       // StopAction is a types.VAppAutoStartAction, such as "powerOff" or "guestShutdown"
+	WaitingForGuest bool                         // WaitingForGuest waits for VMware Tools before starting the next VM
+}
+
+// UpdateStartOrder sets the start order of the VirtualApp's child VMs to the order of the given entries,
+// each VM in its own start order group. VMs are started in the given order and stopped in reverse order.
+// An error is returned if an entry's VM is not a child of the VirtualApp or is listed more than once.
+func (p VirtualApp) UpdateStartOrder(ctx context.Context, order []StartOrderEntry) error {
+	var o mo.VirtualApp
+
+	err := p.Properties(ctx, p.Reference(), []string{"vm"}, &o)
+	if err != nil {
+		return err
+	}
+
+	child := make(map[types.ManagedObjectReference]bool, len(o.Vm))
+	for _, ref := range o.Vm {
+		child[ref] = true
+	}
+
+	var spec types.VAppConfigSpec
+	seen := make(map[types.ManagedObjectReference]bool, len(order))
+
+	for i, entry := range order {
+		if !child[entry.VM] {
+			return fmt.Errorf("%s is not a child of %s", entry.VM, p.Reference())
+		}
+		if seen[entry.VM] {
+			return fmt.Errorf("%s is listed more than once in the start order", entry.VM)
+		}
+		seen[entry.VM] = true
+
+		ref := entry.VM
+		spec.EntityConfig = append(spec.EntityConfig, types.VAppEntityConfigInfo{
+			Key:             &ref,
+			StartOrder:      int32(i + 1),
+			StartDelay:      entry.StartDelay,
+			StartAction:     entry.StartAction,
+			StopDelay:       entry.StopDelay,
+			StopAction:      entry.StopAction,
+			WaitingForGuest: types.NewBool(entry.WaitingForGuest),
+		})
+	}
+
+	return p.UpdateConfig(ctx, spec)
+}
+
 func (p VirtualApp) PowerOn(ctx context.Context) (*Task, error) {
 	req := types.PowerOnVApp_Task{
 		This: p.Reference(),
@@ -91,6 +146,27 @@ func (p VirtualApp) PowerOff(ctx context.Context, force bool) (*Task, error) {
 
 }
 
+// PowerOnAndWait powers on the VirtualApp's child VMs in start order and waits for the task to complete.
+func (p VirtualApp) PowerOnAndWait(ctx context.Context) error {
+	task, err := p.PowerOn(ctx)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+// PowerOffAndWait powers off the VirtualApp's child VMs in reverse start order and waits for the task to complete.
+// If force is true, VMs are powered off regardless of their stop action.
+func (p VirtualApp) PowerOffAndWait(ctx context.Context, force bool) error {
+	task, err := p.PowerOff(ctx, force)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
 func (p VirtualApp) Suspend(ctx context.Context) (*Task, error) {
 	req := types.SuspendVApp_Task{
 		This: p.Reference(),
@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualAppStartOrder(t *testing.T) {
+	m := simulator.VPX()
+	m.App = 1
+
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		app := simulator.Map.Any("VirtualApp").(*simulator.VirtualApp)
+		if len(app.Vm) != 2 {
+			t.Fatalf("vApp has %d VMs", len(app.Vm))
+		}
+		vm1, vm2 := app.Vm[0], app.Vm[1]
+
+		vapp := object.NewVirtualApp(c, app.Reference())
+
+		state := func(ref types.ManagedObjectReference) types.VirtualMachinePowerState {
+			var vm mo.VirtualMachine
+			err := vapp.Properties(ctx, ref, []string{"runtime.powerState"}, &vm)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return vm.Runtime.PowerState
+		}
+
+		var other types.ManagedObjectReference
+		for _, ref := range simulator.Map.All("VirtualMachine") {
+			if ref.Reference() != vm1 && ref.Reference() != vm2 {
+				other = ref.Reference()
+				break
+			}
+		}
+
+		invalid := [][]object.StartOrderEntry{
+			{{VM: vm1}, {VM: other}},
+			{{VM: vm1}, {VM: vm1}},
+		}
+		for _, order := range invalid {
+			if err := vapp.UpdateStartOrder(ctx, order); err == nil {
+				t.Errorf("expected error for %v", order)
+			}
+		}
+
+		order := []object.StartOrderEntry{
+			{VM: vm2, StartDelay: 10, StopAction: string(types.VAppAutoStartActionPowerOff)},
+			{VM: vm1, StartAction: string(types.VAppAutoStartActionNone)},
+		}
+
+		// watchers of the vApp see the new start order
+		wctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		updated := false
+		err := property.Wait(wctx, property.DefaultCollector(c), vapp.Reference(), []string{"vAppConfig"}, func(changes []types.PropertyChange) bool {
+			if !updated {
+				updated = true
+				if err := vapp.UpdateStartOrder(ctx, order); err != nil {
+					t.Fatal(err)
+				}
+				return false
+			}
+
+			for _, change := range changes {
+				if config, ok := change.Val.(types.VAppConfigInfo); ok {
+					return len(config.EntityConfig) == len(order)
+				}
+			}
+			return false
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var o mo.VirtualApp
+		if err := vapp.Properties(ctx, vapp.Reference(), []string{"vAppConfig"}, &o); err != nil {
+			t.Fatal(err)
+		}
+		for _, info := range o.VAppConfig.EntityConfig {
+			expect := map[types.ManagedObjectReference]int32{vm2: 1, vm1: 2}[*info.Key]
+			if info.StartOrder != expect {
+				t.Errorf("%s start order=%d", *info.Key, info.StartOrder)
+			}
+		}
+
+		if err := vapp.PowerOffAndWait(ctx, false); err != nil {
+			t.Fatal(err)
+		}
+		if state(vm1) != types.VirtualMachinePowerStatePoweredOff || state(vm2) != types.VirtualMachinePowerStatePoweredOff {
+			t.Error("expected VMs to be powered off")
+		}
+
+		if err := vapp.PowerOnAndWait(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if state(vm2) != types.VirtualMachinePowerStatePoweredOn {
+			t.Error("expected vm2 to be powered on")
+		}
+		if state(vm1) != types.VirtualMachinePowerStatePoweredOff {
+			t.Error("expected vm1 start action none to leave it powered off")
+		}
+	}, m)
+}
@@ -0,0 +1,345 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// deviceTypeName is the JSON key used to record the concrete type of interface values,
+// such as VirtualDevice.Backing, in the format used by MarshalDeviceList.
+const deviceTypeName = "_typeName"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// MarshalDeviceList encodes the given devices as JSON.
+// Unlike encoding/json, the concrete type of each device and interface field, such as the device backing,
+// is recorded, such that the devices can be decoded by UnmarshalDeviceList.
+// Fields are named as per the vSphere API, object keys are sorted and zero value fields are omitted,
+// making the encoding stable for use in version control.
+func MarshalDeviceList(l VirtualDeviceList) ([]byte, error) {
+	var devices []interface{}
+
+	for _, device := range l {
+		val, err := encodeDeviceValue(reflect.ValueOf(&device).Elem())
+		if err != nil {
+			return nil, err
+		}
+		devices = append(devices, val)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(devices); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalDeviceList decodes devices encoded by MarshalDeviceList.
+// The returned list can be used to build a device change via VirtualDeviceList.ConfigSpec,
+// for example to add the devices to another VirtualMachine.
+func UnmarshalDeviceList(data []byte) (VirtualDeviceList, error) {
+	var devices []interface{}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&devices); err != nil {
+		return nil, err
+	}
+
+	l := make(VirtualDeviceList, len(devices))
+
+	for i := range devices {
+		val := reflect.ValueOf(&l[i]).Elem()
+		if err := decodeDeviceValue(devices[i], val, fmt.Sprintf("[%d]", i)); err != nil {
+			return nil, err
+		}
+	}
+
+	return l, nil
+}
+
+// ExportDevices returns the VirtualMachine's devices encoded via MarshalDeviceList.
+func (v VirtualMachine) ExportDevices(ctx context.Context) ([]byte, error) {
+	devices, err := v.Device(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalDeviceList(devices)
+}
+
+// jsonFieldName returns the vSphere API name of the given struct field, as defined by its xml tag.
+func jsonFieldName(f reflect.StructField) string {
+	if tag := f.Tag.Get("xml"); tag != "" {
+		if name := strings.Split(tag, ",")[0]; name != "" && name != "-" {
+			return name
+		}
+	}
+	return f.Name
+}
+
+func encodeDeviceValue(v reflect.Value) (interface{}, error) {
+	switch v.Kind() {
+	case reflect.Interface:
+		if v.IsNil() {
+			return nil, nil
+		}
+
+		elem := v.Elem()
+		kind := elem.Type()
+		if kind.Kind() == reflect.Ptr {
+			kind = kind.Elem()
+		}
+
+		val, err := encodeDeviceValue(elem)
+		if err != nil {
+			return nil, err
+		}
+
+		obj, ok := val.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unsupported %s value: %s", v.Type(), kind)
+		}
+		obj[deviceTypeName] = kind.Name()
+
+		return obj, nil
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return encodeDeviceValue(v.Elem())
+	case reflect.Struct:
+		if v.Type() == timeType {
+			return v.Interface(), nil
+		}
+
+		obj := make(map[string]interface{})
+		if err := encodeDeviceStruct(v, obj); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Bytes(), nil
+		}
+
+		list := make([]interface{}, v.Len())
+		for i := range list {
+			val, err := encodeDeviceValue(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = val
+		}
+		return list, nil
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// encodeDeviceStruct encodes the fields of struct v to obj, flattening embedded structs and omitting zero values.
+func encodeDeviceStruct(v reflect.Value, obj map[string]interface{}) error {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		fv := v.Field(i)
+
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+
+		if f.Anonymous && fv.Kind() == reflect.Struct {
+			if err := encodeDeviceStruct(fv, obj); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fv.IsZero() {
+			continue
+		}
+
+		val, err := encodeDeviceValue(fv)
+		if err != nil {
+			return fmt.Errorf("%s: %s", f.Name, err)
+		}
+
+		obj[jsonFieldName(f)] = val
+	}
+
+	return nil
+}
+
+func decodeDeviceValue(data interface{}, v reflect.Value, name string) error {
+	if data == nil {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Interface:
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %T", name, data)
+		}
+
+		kind, _ := obj[deviceTypeName].(string)
+		rt, ok := types.TypeFunc()(kind)
+		if !ok {
+			return fmt.Errorf("%s: unknown %s %q", name, deviceTypeName, kind)
+		}
+
+		ptr := reflect.New(rt)
+		if err := decodeDeviceValue(obj, ptr.Elem(), name); err != nil {
+			return err
+		}
+
+		switch {
+		case ptr.Type().AssignableTo(v.Type()):
+			v.Set(ptr)
+		case rt.AssignableTo(v.Type()):
+			v.Set(ptr.Elem())
+		default:
+			return fmt.Errorf("%s: %s is not a %s", name, kind, v.Type())
+		}
+	case reflect.Ptr:
+		ptr := reflect.New(v.Type().Elem())
+		if err := decodeDeviceValue(data, ptr.Elem(), name); err != nil {
+			return err
+		}
+		v.Set(ptr)
+	case reflect.Struct:
+		if v.Type() == timeType {
+			s, ok := data.(string)
+			if !ok {
+				return fmt.Errorf("%s: expected time, got %T", name, data)
+			}
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return fmt.Errorf("%s: %s", name, err)
+			}
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %T", name, data)
+		}
+
+		return decodeDeviceStruct(obj, v, name)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			s, ok := data.(string)
+			if !ok {
+				return fmt.Errorf("%s: expected base64 string, got %T", name, data)
+			}
+			b, err := base64.StdEncoding.DecodeString(s)
+			if err != nil {
+				return fmt.Errorf("%s: %s", name, err)
+			}
+			v.SetBytes(b)
+			return nil
+		}
+
+		list, ok := data.([]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected array, got %T", name, data)
+		}
+
+		s := reflect.MakeSlice(v.Type(), len(list), len(list))
+		for i := range list {
+			if err := decodeDeviceValue(list[i], s.Index(i), fmt.Sprintf("%s[%d]", name, i)); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	case reflect.String:
+		s, ok := data.(string)
+		if !ok {
+			return fmt.Errorf("%s: expected string, got %T", name, data)
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, ok := data.(bool)
+		if !ok {
+			return fmt.Errorf("%s: expected bool, got %T", name, data)
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := data.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: expected number, got %T", name, data)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		v.SetInt(i)
+	case reflect.Float32, reflect.Float64:
+		n, ok := data.(json.Number)
+		if !ok {
+			return fmt.Errorf("%s: expected number, got %T", name, data)
+		}
+		f, err := n.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: %s", name, err)
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("%s: unsupported type %s", name, v.Type())
+	}
+
+	return nil
+}
+
+// decodeDeviceStruct decodes obj into the fields of struct v, including the fields of embedded structs.
+func decodeDeviceStruct(obj map[string]interface{}, v reflect.Value, name string) error {
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		fv := v.Field(i)
+
+		if f.PkgPath != "" {
+			continue // unexported
+		}
+
+		if f.Anonymous && fv.Kind() == reflect.Struct {
+			if err := decodeDeviceStruct(obj, fv, name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		key := jsonFieldName(f)
+		if err := decodeDeviceValue(obj[key], fv, name+"."+key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestMarshalDeviceList(t *testing.T) {
+	data, err := MarshalDeviceList(devices)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Contains(data, []byte(`"_typeName": "VirtualDiskFlatVer2BackingInfo"`)) {
+		t.Errorf("missing backing type: %s", data)
+	}
+
+	l, err := UnmarshalDeviceList(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(l) != len(devices) {
+		t.Fatalf("len=%d", len(l))
+	}
+
+	for i := range devices {
+		if !reflect.DeepEqual(l[i], devices[i]) {
+			t.Errorf("%s: %#v", devices.Name(devices[i]), l[i])
+		}
+	}
+
+	// encoding must be stable
+	again, err := MarshalDeviceList(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, again) {
+		t.Error("encoding changed")
+	}
+
+	// zero value of pointer fields must be preserved
+	disk := l.SelectByType((*types.VirtualDisk)(nil))[0].GetVirtualDevice()
+	if disk.UnitNumber == nil {
+		t.Error("UnitNumber=nil")
+	}
+
+	tests := []string{
+		`{}`,
+		`[{"key": 1}]`,
+		`[{"_typeName": "NoSuchDevice"}]`,
+		`[{"_typeName": "VirtualDiskFlatVer2BackingInfo"}]`,
+		`[{"_typeName": "VirtualE1000", "key": "one"}]`,
+	}
+
+	for _, test := range tests {
+		_, err = UnmarshalDeviceList([]byte(test))
+		if err == nil {
+			t.Errorf("expected error for: %s", test)
+		}
+	}
+
+	_, err = UnmarshalDeviceList([]byte(`[{"_typeName": "VirtualE1000", "backing": {"_typeName": "Description"}}]`))
+	if err == nil || !strings.Contains(err.Error(), "backing") {
+		t.Errorf("err=%v", err)
+	}
+}
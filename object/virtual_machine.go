@@ -21,14 +21,20 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"path"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/vmware/govmomi/nfc"
+	"github.com/vmware/govmomi/ovf"
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -210,6 +216,126 @@ func (v VirtualMachine) Clone(ctx context.Context, folder *Folder, name string,
 	return NewTask(v.c, res.Returnval), nil
 }
 
+// CloneAndCustomize clones the VM with the given guest customization spec applied as part of the clone operation,
+// waits for the clone task to complete and returns the new VM.
+// Unlike Clone followed by Customize, the customization is pending before the clone can be powered on,
+// including when config.PowerOn is true.
+func (v VirtualMachine) CloneAndCustomize(ctx context.Context, folder *Folder, name string, config types.VirtualMachineCloneSpec, spec types.CustomizationSpec) (*VirtualMachine, error) {
+	config.Customization = &spec
+
+	task, err := v.Clone(ctx, folder, name, config)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewVirtualMachine(v.c, info.Result.(types.ManagedObjectReference)), nil
+}
+
+// CloneOptions are the placement and power options used by CloneWithCustomization.
+type CloneOptions struct {
+	Datastore    *Datastore    // Target datastore, defaults to that of the source VM
+	ResourcePool *ResourcePool // Target resource pool, defaults to that of the source VM
+	PowerOn      bool          // Power on the clone once created, applying the customization
+}
+
+// CloneWithCustomization clones the VM with the saved customization spec of the given name,
+// as found via the CustomizationSpecManager.
+func (v VirtualMachine) CloneWithCustomization(ctx context.Context, folder *Folder, name string, customSpecName string, options CloneOptions) (*Task, error) {
+	m := NewCustomizationSpecManager(v.c)
+
+	exists, err := m.DoesCustomizationSpecExist(ctx, customSpecName)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("customization spec %q not found", customSpecName)
+	}
+
+	item, err := m.GetCustomizationSpec(ctx, customSpecName)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := types.VirtualMachineCloneSpec{
+		PowerOn:       options.PowerOn,
+		Customization: &item.Spec,
+	}
+
+	if options.Datastore != nil {
+		ref := options.Datastore.Reference()
+		spec.Location.Datastore = &ref
+	}
+
+	if options.ResourcePool != nil {
+		ref := options.ResourcePool.Reference()
+		spec.Location.Pool = &ref
+	}
+
+	return v.Clone(ctx, folder, name, spec)
+}
+
+// NewInstantCloneSpec returns a VirtualMachineInstantCloneSpec for an instant clone with the given name and location,
+// with the given guestinfo applied via ApplyInstantCloneGuestInfo.
+func NewInstantCloneSpec(name string, location types.VirtualMachineRelocateSpec, guestinfo map[string]string) types.VirtualMachineInstantCloneSpec {
+	spec := types.VirtualMachineInstantCloneSpec{
+		Name:     name,
+		Location: location,
+	}
+
+	ApplyInstantCloneGuestInfo(&spec, guestinfo)
+
+	return spec
+}
+
+// ApplyInstantCloneGuestInfo sets each guestinfo key and value in the spec Config,
+// such that every instant clone can be given unique identity data.
+// Keys are prefixed with "guestinfo." if not already.
+// Existing Config values with the same key are replaced, new keys are appended in sorted order.
+func ApplyInstantCloneGuestInfo(spec *types.VirtualMachineInstantCloneSpec, guestinfo map[string]string) {
+	values := make(map[string]string, len(guestinfo))
+	for key, val := range guestinfo {
+		values[guestInfoKey(key)] = val
+	}
+
+	for _, opt := range spec.Config {
+		o := opt.GetOptionValue()
+		if val, ok := values[o.Key]; ok {
+			o.Value = val
+			delete(values, o.Key)
+		}
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		spec.Config = append(spec.Config, &types.OptionValue{
+			Key:   key,
+			Value: values[key],
+		})
+	}
+}
+
+func guestInfoKey(key string) string {
+	const prefix = "guestinfo."
+
+	if strings.HasPrefix(key, prefix) {
+		return key
+	}
+
+	return prefix + key
+}
+
+// InstantClone creates a powered on clone of the running VM, sharing its memory and disk state.
+// See NewInstantCloneSpec for building the spec.
 func (v VirtualMachine) InstantClone(ctx context.Context, config types.VirtualMachineInstantCloneSpec) (*Task, error) {
 	req := types.InstantClone_Task{
 		This: v.Reference(),
@@ -224,7 +350,19 @@ func (v VirtualMachine) InstantClone(ctx context.Context, config types.VirtualMa
 	return NewTask(v.c, res.Returnval), nil
 }
 
+// Customize applies the given guest customization spec to the VirtualMachine, such as a VM cloned without customization.
+// The VirtualMachine must be powered off, the customization is applied by the guest on its next power on.
+// See LinuxCustomization and WindowsCustomization for building a spec.
 func (v VirtualMachine) Customize(ctx context.Context, spec types.CustomizationSpec) (*Task, error) {
+	state, err := v.PowerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if state != types.VirtualMachinePowerStatePoweredOff {
+		return nil, fmt.Errorf("%s must be powered off to customize, power state is %s", v.Reference(), state)
+	}
+
 	req := types.CustomizeVM_Task{
 		This: v.Reference(),
 		Spec: spec,
@@ -267,6 +405,95 @@ func (v VirtualMachine) Reconfigure(ctx context.Context, config types.VirtualMac
 	return NewTask(v.c, res.Returnval), nil
 }
 
+// hotConfig returns the VirtualMachine's power state and config, as needed to validate CPU and memory changes.
+func (v VirtualMachine) hotConfig(ctx context.Context) (*mo.VirtualMachine, error) {
+	var o mo.VirtualMachine
+
+	props := []string{
+		"runtime.powerState",
+		"config.hardware.numCPU",
+		"config.hardware.memoryMB",
+		"config.cpuHotAddEnabled",
+		"config.cpuHotRemoveEnabled",
+		"config.memoryHotAddEnabled",
+	}
+
+	err := v.Properties(ctx, v.Reference(), props, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Config == nil {
+		return nil, fmt.Errorf("%s config is not available", v.Reference())
+	}
+
+	return &o, nil
+}
+
+// SetMemoryMB reconfigures the VirtualMachine's memory size.
+// If the VirtualMachine is powered on, memory hot add must be enabled and memory cannot be reduced,
+// otherwise an error is returned without attempting the reconfigure.
+func (v VirtualMachine) SetMemoryMB(ctx context.Context, mb int64) (*Task, error) {
+	o, err := v.hotConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+		current := int64(o.Config.Hardware.MemoryMB)
+		switch {
+		case mb < current:
+			return nil, fmt.Errorf("%s is powered on, memory cannot be reduced from %dMB to %dMB", v.Reference(), current, mb)
+		case mb > current && (o.Config.MemoryHotAddEnabled == nil || !*o.Config.MemoryHotAddEnabled):
+			return nil, fmt.Errorf("%s is powered on and memory hot add is not enabled", v.Reference())
+		}
+	}
+
+	return v.Reconfigure(ctx, types.VirtualMachineConfigSpec{MemoryMB: mb})
+}
+
+// SetNumCPU reconfigures the VirtualMachine's number of virtual CPUs.
+// If the VirtualMachine is powered on, CPU hot add must be enabled to add CPUs and
+// CPU hot remove must be enabled to remove CPUs, otherwise an error is returned without attempting the reconfigure.
+func (v VirtualMachine) SetNumCPU(ctx context.Context, n int32) (*Task, error) {
+	o, err := v.hotConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Runtime.PowerState == types.VirtualMachinePowerStatePoweredOn {
+		current := o.Config.Hardware.NumCPU
+		switch {
+		case n > current && (o.Config.CpuHotAddEnabled == nil || !*o.Config.CpuHotAddEnabled):
+			return nil, fmt.Errorf("%s is powered on and CPU hot add is not enabled", v.Reference())
+		case n < current && (o.Config.CpuHotRemoveEnabled == nil || !*o.Config.CpuHotRemoveEnabled):
+			return nil, fmt.Errorf("%s is powered on and CPU hot remove is not enabled", v.Reference())
+		}
+	}
+
+	return v.Reconfigure(ctx, types.VirtualMachineConfigSpec{NumCPUs: n})
+}
+
+// EnableHotAdd enables or disables CPU and memory hot add.
+// The VirtualMachine must be powered off.
+func (v VirtualMachine) EnableHotAdd(ctx context.Context, cpu, memory bool) (*Task, error) {
+	state, err := v.PowerState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if state != types.VirtualMachinePowerStatePoweredOff {
+		return nil, fmt.Errorf("%s must be powered off to change hot add settings, power state is %s", v.Reference(), state)
+	}
+
+	spec := types.VirtualMachineConfigSpec{
+		CpuHotAddEnabled:    types.NewBool(cpu),
+		MemoryHotAddEnabled: types.NewBool(memory),
+	}
+
+	return v.Reconfigure(ctx, spec)
+}
+
 func (v VirtualMachine) RefreshStorageInfo(ctx context.Context) error {
 	req := types.RefreshStorageInfo{
 		This: v.Reference(),
@@ -407,6 +634,112 @@ func (v VirtualMachine) WaitForNetIP(ctx context.Context, v4 bool, device ...str
 	return macs, nil
 }
 
+// GuestInfoIP returns a best guess of the VirtualMachine's primary routable IP address,
+// using the guest.net and guest.ipStack properties reported by VMware Tools,
+// as the guest.ipAddress property is not always set or reachable.
+// Addresses of the NIC with the default route are preferred, in particular an address
+// on the same network as the default gateway, followed by addresses of virtual NICs and IPv4 over IPv6.
+// Loopback and link-local addresses are ignored.
+// An empty string is returned if the guest has not reported a routable address.
+func (v VirtualMachine) GuestInfoIP(ctx context.Context) (string, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"guest.ipAddress", "guest.net", "guest.ipStack"}, &o)
+	if err != nil {
+		return "", err
+	}
+
+	if o.Guest == nil {
+		return "", nil
+	}
+
+	return guestPrimaryIP(o.Guest), nil
+}
+
+func isRoutableIP(ip net.IP) bool {
+	return ip != nil && !ip.IsUnspecified() && !ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsMulticast()
+}
+
+// guestPrimaryIP returns the highest scoring routable address of the given guest NICs, see GuestInfoIP.
+func guestPrimaryIP(guest *types.GuestInfo) string {
+	// Default gateways by guest.net index
+	gateways := make(map[int][]net.IP)
+
+	for _, stack := range guest.IpStack {
+		if stack.IpRouteConfig == nil {
+			continue
+		}
+		for _, route := range stack.IpRouteConfig.IpRoute {
+			if route.PrefixLength != 0 {
+				continue // not a default route
+			}
+			i, err := strconv.Atoi(route.Gateway.Device)
+			if err != nil {
+				continue
+			}
+			gateways[i] = append(gateways[i], net.ParseIP(route.Gateway.IpAddress))
+		}
+	}
+
+	best, score := "", -1
+
+	for i, nic := range guest.Net {
+		var addrs []types.NetIpConfigInfoIpAddress
+
+		if nic.IpConfig != nil {
+			addrs = nic.IpConfig.IpAddress
+		} else {
+			for _, ip := range nic.IpAddress {
+				addrs = append(addrs, types.NetIpConfigInfoIpAddress{IpAddress: ip})
+			}
+		}
+
+		for _, addr := range addrs {
+			if addr.State != "" && addr.State != string(types.NetIpConfigInfoIpAddressStatusPreferred) {
+				continue
+			}
+
+			ip := net.ParseIP(addr.IpAddress)
+			if !isRoutableIP(ip) {
+				continue
+			}
+
+			s := 0
+			if ip.To4() != nil {
+				s++
+			}
+			if nic.DeviceConfigId != -1 {
+				s += 2
+			}
+			if gws, ok := gateways[i]; ok {
+				s += 4
+				bits := 8 * len(ip)
+				if ip.To4() != nil {
+					bits = 8 * net.IPv4len
+				}
+				network := &net.IPNet{IP: ip, Mask: net.CIDRMask(int(addr.PrefixLength), bits)}
+				for _, gw := range gws {
+					if addr.PrefixLength != 0 && gw != nil && network.Contains(gw) {
+						s += 8
+						break
+					}
+				}
+			}
+
+			if s > score {
+				best, score = addr.IpAddress, s
+			}
+		}
+	}
+
+	if best == "" && isRoutableIP(net.ParseIP(guest.IpAddress)) {
+		return guest.IpAddress
+	}
+
+	return best
+}
+
 // Device returns the VirtualMachine's config.hardware.device property.
 func (v VirtualMachine) Device(ctx context.Context) (VirtualDeviceList, error) {
 	var o mo.VirtualMachine
@@ -429,6 +762,12 @@ func (v VirtualMachine) Device(ctx context.Context) (VirtualDeviceList, error) {
 	return VirtualDeviceList(o.Config.Hardware.Device), nil
 }
 
+// MoveToResourcePool moves the VirtualMachine into the given pool, which must be part of the same compute resource.
+// This is a convenience wrapper around ResourcePool.MoveInto, which completes synchronously.
+func (v VirtualMachine) MoveToResourcePool(ctx context.Context, pool *ResourcePool) error {
+	return pool.MoveInto(ctx, v.Reference())
+}
+
 func (v VirtualMachine) HostSystem(ctx context.Context) (*HostSystem, error) {
 	var o mo.VirtualMachine
 
@@ -502,6 +841,149 @@ func (v VirtualMachine) AddDevice(ctx context.Context, device ...types.BaseVirtu
 	return v.configureDevice(ctx, types.VirtualDeviceConfigSpecOperationAdd, types.VirtualDeviceConfigSpecFileOperationCreate, device...)
 }
 
+// AddNetworkAdapter adds an ethernet card of the given type, such as "vmxnet3", to the VirtualMachine,
+// with the backing for the given network, be it a Network, DistributedVirtualPortgroup or OpaqueNetwork.
+// The default ethernet card type is used if adapterType is empty.
+func (v VirtualMachine) AddNetworkAdapter(ctx context.Context, network NetworkReference, adapterType string) (types.BaseVirtualDevice, error) {
+	backing, err := network.EthernetCardBackingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := VirtualDeviceList{}.CreateEthernetCard(adapterType, backing)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = v.AddDevice(ctx, device); err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+// SerialPortOptions configures the backing of a serial port added via AddSerialPort.
+// Exactly one of ServiceURI, FileName or PipeName must be set.
+type SerialPortOptions struct {
+	// ServiceURI selects network backing, such as "telnet://:33001" or "tcp://10.0.0.1:33001".
+	ServiceURI string
+	// Client connects to ServiceURI rather than listening on it.
+	Client bool
+	// ProxyURI is the optional virtual serial port concentrator URI for network backing.
+	ProxyURI string
+
+	// FileName selects file backing, a datastore path such as "[datastore1] vm/serial.out".
+	FileName string
+
+	// PipeName selects named pipe backing.
+	PipeName string
+	// PipeClient sets the pipe endpoint to client, defaults to server.
+	PipeClient bool
+	// NoRxLoss enables optimized data transfer for pipe backing.
+	NoRxLoss bool
+}
+
+// serialPortSchemes are the URI schemes supported by serial port network backing.
+var serialPortSchemes = []string{
+	"telnet", "telnets", "tcp", "tcp4", "tcp6", "ssl", "tcp+ssl", "tcp4+ssl", "tcp6+ssl",
+}
+
+func (o SerialPortOptions) backing() (types.BaseVirtualDeviceBackingInfo, error) {
+	n := 0
+	for _, s := range []string{o.ServiceURI, o.FileName, o.PipeName} {
+		if s != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, errors.New("exactly one of ServiceURI, FileName or PipeName must be specified")
+	}
+
+	switch {
+	case o.FileName != "":
+		return &types.VirtualSerialPortFileBackingInfo{
+			VirtualDeviceFileBackingInfo: types.VirtualDeviceFileBackingInfo{
+				FileName: o.FileName,
+			},
+		}, nil
+	case o.PipeName != "":
+		endpoint := types.VirtualSerialPortEndPointServer
+		if o.PipeClient {
+			endpoint = types.VirtualSerialPortEndPointClient
+		}
+		return &types.VirtualSerialPortPipeBackingInfo{
+			VirtualDevicePipeBackingInfo: types.VirtualDevicePipeBackingInfo{
+				PipeName: o.PipeName,
+			},
+			Endpoint: string(endpoint),
+			NoRxLoss: types.NewBool(o.NoRxLoss),
+		}, nil
+	}
+
+	for _, uri := range []string{o.ServiceURI, o.ProxyURI} {
+		if uri == "" {
+			continue
+		}
+		u, err := url.Parse(uri)
+		if err != nil {
+			return nil, err
+		}
+		valid := false
+		for _, scheme := range serialPortSchemes {
+			if u.Scheme == scheme {
+				valid = true
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("unsupported serial port URI scheme %q, valid schemes=%s",
+				u.Scheme, strings.Join(serialPortSchemes, ","))
+		}
+	}
+
+	direction := types.VirtualDeviceURIBackingOptionDirectionServer
+	if o.Client {
+		direction = types.VirtualDeviceURIBackingOptionDirectionClient
+	}
+
+	return &types.VirtualSerialPortURIBackingInfo{
+		VirtualDeviceURIBackingInfo: types.VirtualDeviceURIBackingInfo{
+			Direction:  string(direction),
+			ServiceURI: o.ServiceURI,
+			ProxyURI:   o.ProxyURI,
+		},
+	}, nil
+}
+
+// AddSerialPort adds a serial port to the VirtualMachine with the backing described by opts,
+// returning the device as it was submitted with ReconfigVM_Task.
+func (v VirtualMachine) AddSerialPort(ctx context.Context, opts SerialPortOptions) (*types.VirtualSerialPort, error) {
+	backing, err := opts.backing()
+	if err != nil {
+		return nil, err
+	}
+
+	devices, err := v.Device(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device := &types.VirtualSerialPort{YieldOnPoll: true}
+	device.Key = devices.NewKey()
+
+	c := devices.PickController((*types.VirtualSIOController)(nil))
+	if c == nil {
+		return nil, errors.New("no available SIO controller")
+	}
+	devices.AssignController(device, c)
+	device.Backing = backing
+
+	if err = v.AddDevice(ctx, device); err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
 // EditDevice edits the given (existing) devices on the VirtualMachine
 func (v VirtualMachine) EditDevice(ctx context.Context, device ...types.BaseVirtualDevice) error {
 	return v.configureDevice(ctx, types.VirtualDeviceConfigSpecOperationEdit, types.VirtualDeviceConfigSpecFileOperationReplace, device...)
@@ -551,73 +1033,62 @@ func (v VirtualMachine) DetachDisk(ctx context.Context, id string) error {
 	return task.Wait(ctx)
 }
 
-// BootOptions returns the VirtualMachine's config.bootOptions property.
-func (v VirtualMachine) BootOptions(ctx context.Context) (*types.VirtualMachineBootOptions, error) {
-	var o mo.VirtualMachine
-
-	err := v.Properties(ctx, v.Reference(), []string{"config.bootOptions"}, &o)
+// disks returns the VirtualDisk devices with the given keys, or an error if any key is not a disk in the current config.
+func (v VirtualMachine) disks(ctx context.Context, keys ...int32) ([]types.VirtualDisk, error) {
+	devices, err := v.Device(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return o.Config.BootOptions, nil
-}
-
-// SetBootOptions reconfigures the VirtualMachine with the given options.
-func (v VirtualMachine) SetBootOptions(ctx context.Context, options *types.VirtualMachineBootOptions) error {
-	spec := types.VirtualMachineConfigSpec{}
-
-	spec.BootOptions = options
+	disks := make([]types.VirtualDisk, 0, len(keys))
 
-	task, err := v.Reconfigure(ctx, spec)
-	if err != nil {
-		return err
+	for _, key := range keys {
+		disk, ok := devices.FindByKey(key).(*types.VirtualDisk)
+		if !ok {
+			return nil, fmt.Errorf("disk with key %d not found", key)
+		}
+		disks = append(disks, *disk)
 	}
 
-	return task.Wait(ctx)
+	return disks, nil
 }
 
-// Answer answers a pending question.
-func (v VirtualMachine) Answer(ctx context.Context, id, answer string) error {
-	req := types.AnswerVM{
-		This:         v.Reference(),
-		QuestionId:   id,
-		AnswerChoice: answer,
+// DetachVirtualDisk removes the VirtualDisk with the given device key from the VirtualMachine,
+// leaving the disk's backing files in place.
+func (v VirtualMachine) DetachVirtualDisk(ctx context.Context, key int32) (*Task, error) {
+	disks, err := v.disks(ctx, key)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := methods.AnswerVM(ctx, v.c, &req)
-	if err != nil {
-		return err
+	spec := types.VirtualMachineConfigSpec{
+		DeviceChange: []types.BaseVirtualDeviceConfigSpec{
+			&types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationRemove,
+				Device:    &disks[0],
+			},
+		},
 	}
 
-	return nil
+	return v.Reconfigure(ctx, spec)
 }
 
-func (v VirtualMachine) AcquireTicket(ctx context.Context, kind string) (*types.VirtualMachineTicket, error) {
-	req := types.AcquireTicket{
-		This:       v.Reference(),
-		TicketType: kind,
-	}
-
-	res, err := methods.AcquireTicket(ctx, v.c, &req)
+// PromoteDisks promotes the given linked-clone disks of the VirtualMachine, or all disks if none are given.
+// If unlink is true, the disks are copied such that they no longer share a parent with other VMs,
+// otherwise only unshared parent disks are consolidated into the child.
+func (v VirtualMachine) PromoteDisks(ctx context.Context, unlink bool, keys []int32) (*Task, error) {
+	disks, err := v.disks(ctx, keys...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &res.Returnval, nil
-}
-
-// CreateSnapshot creates a new snapshot of a virtual machine.
-func (v VirtualMachine) CreateSnapshot(ctx context.Context, name string, description string, memory bool, quiesce bool) (*Task, error) {
-	req := types.CreateSnapshot_Task{
-		This:        v.Reference(),
-		Name:        name,
-		Description: description,
-		Memory:      memory,
-		Quiesce:     quiesce,
+	req := types.PromoteDisks_Task{
+		This:   v.Reference(),
+		Unlink: unlink,
+		Disks:  disks,
 	}
 
-	res, err := methods.CreateSnapshot_Task(ctx, v.c, &req)
+	res, err := methods.PromoteDisks_Task(ctx, v.c, &req)
 	if err != nil {
 		return nil, err
 	}
@@ -625,22 +1096,431 @@ func (v VirtualMachine) CreateSnapshot(ctx context.Context, name string, descrip
 	return NewTask(v.c, res.Returnval), nil
 }
 
-// RemoveAllSnapshot removes all snapshots of a virtual machine
-func (v VirtualMachine) RemoveAllSnapshot(ctx context.Context, consolidate *bool) (*Task, error) {
-	req := types.RemoveAllSnapshots_Task{
-		This:        v.Reference(),
-		Consolidate: consolidate,
-	}
+// VAppProperties returns the vApp properties of the VirtualMachine's config.vAppConfig,
+// which is empty if the VirtualMachine has no vApp config.
+func (v VirtualMachine) VAppProperties(ctx context.Context) ([]types.VAppPropertyInfo, error) {
+	var o mo.VirtualMachine
 
-	res, err := methods.RemoveAllSnapshots_Task(ctx, v.c, &req)
+	err := v.Properties(ctx, v.Reference(), []string{"config.vAppConfig"}, &o)
 	if err != nil {
 		return nil, err
 	}
 
-	return NewTask(v.c, res.Returnval), nil
+	if o.Config == nil || o.Config.VAppConfig == nil {
+		return nil, nil
+	}
+
+	return o.Config.VAppConfig.GetVmConfigInfo().Property, nil
 }
 
-type snapshotMap map[string][]types.ManagedObjectReference
+// SetVAppProperty sets the value of the vApp property with the given ID.
+// An error is returned if the VirtualMachine has no such property.
+func (v VirtualMachine) SetVAppProperty(ctx context.Context, key, value string) error {
+	props, err := v.VAppProperties(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range props {
+		if p.Id != key {
+			continue
+		}
+
+		p.Value = value
+
+		spec := types.VirtualMachineConfigSpec{
+			VAppConfig: &types.VmConfigSpec{
+				Property: []types.VAppPropertySpec{
+					{
+						ArrayUpdateSpec: types.ArrayUpdateSpec{Operation: types.ArrayUpdateOperationEdit},
+						Info:            &p,
+					},
+				},
+			},
+		}
+
+		task, err := v.Reconfigure(ctx, spec)
+		if err != nil {
+			return err
+		}
+
+		return task.Wait(ctx)
+	}
+
+	return fmt.Errorf("vApp property %q not found", key)
+}
+
+// OvfEnvironment reconstructs the OVF environment of the VirtualMachine from its config.vAppConfig,
+// as presented to the guest via the guestinfo.ovfEnv transport.
+// Property values default to the property's DefaultValue when not set.
+// If the VirtualMachine has no vApp config, the returned environment has no properties.
+func (v VirtualMachine) OvfEnvironment(ctx context.Context) (*ovf.Env, error) {
+	props, err := v.VAppProperties(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := v.c.ServiceContent.About
+
+	env := &ovf.Env{
+		EsxID: v.Reference().Value,
+		Platform: &ovf.PlatformSection{
+			Kind:    a.Name,
+			Version: a.Version,
+			Vendor:  a.Vendor,
+			Locale:  "US",
+		},
+		Property: new(ovf.PropertySection),
+	}
+
+	for _, p := range props {
+		val := p.Value
+		if val == "" {
+			val = p.DefaultValue
+		}
+		env.Property.Properties = append(env.Property.Properties, ovf.EnvProperty{Key: p.Id, Value: val})
+	}
+
+	return env, nil
+}
+
+// PortConnection describes the distributed virtual port connection of a VirtualMachine ethernet card.
+type PortConnection struct {
+	Device       string // Device name, such as "ethernet-0"
+	MacAddress   string
+	SwitchUUID   string
+	PortgroupKey string
+	PortKey      string                        // PortKey is empty if the card is not bound to a port
+	Port         *types.DistributedVirtualPort // Port is the DVPort with PortKey, if found
+}
+
+// PortConnections returns the DVPort connection of each ethernet card with a distributed virtual port backing.
+// The connections are read from the device backing, then the DVPort of each is fetched from the card's portgroup.
+func (v VirtualMachine) PortConnections(ctx context.Context) ([]PortConnection, error) {
+	devices, err := v.Device(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var conns []PortConnection
+
+	for _, device := range devices.SelectByType((*types.VirtualEthernetCard)(nil)) {
+		card := device.(types.BaseVirtualEthernetCard).GetVirtualEthernetCard()
+
+		backing, ok := card.Backing.(*types.VirtualEthernetCardDistributedVirtualPortBackingInfo)
+		if !ok {
+			continue
+		}
+
+		conn := PortConnection{
+			Device:       devices.Name(device),
+			MacAddress:   card.MacAddress,
+			SwitchUUID:   backing.Port.SwitchUuid,
+			PortgroupKey: backing.Port.PortgroupKey,
+			PortKey:      backing.Port.PortKey,
+		}
+
+		if conn.PortKey != "" && conn.PortgroupKey != "" {
+			ref := types.ManagedObjectReference{Type: "DistributedVirtualPortgroup", Value: conn.PortgroupKey}
+
+			ports, err := NewDistributedVirtualPortgroup(v.c, ref).Ports(ctx, types.DistributedVirtualSwitchPortCriteria{
+				PortKey: []string{conn.PortKey},
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if len(ports) != 0 {
+				conn.Port = &ports[0]
+			}
+		}
+
+		conns = append(conns, conn)
+	}
+
+	return conns, nil
+}
+
+// BootOptions returns the VirtualMachine's config.bootOptions property.
+func (v VirtualMachine) BootOptions(ctx context.Context) (*types.VirtualMachineBootOptions, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config.bootOptions"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	return o.Config.BootOptions, nil
+}
+
+// SetBootOptions reconfigures the VirtualMachine with the given options.
+func (v VirtualMachine) SetBootOptions(ctx context.Context, options *types.VirtualMachineBootOptions) error {
+	spec := types.VirtualMachineConfigSpec{}
+
+	spec.BootOptions = options
+
+	task, err := v.Reconfigure(ctx, spec)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+// Answer answers the pending question with the given id using the choice with the given key.
+// See also VirtualMachine.Question and AnswerByLabel.
+func (v VirtualMachine) Answer(ctx context.Context, id, answer string) error {
+	req := types.AnswerVM{
+		This:         v.Reference(),
+		QuestionId:   id,
+		AnswerChoice: answer,
+	}
+
+	_, err := methods.AnswerVM(ctx, v.c, &req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ErrNoQuestion is returned by VirtualMachine.Question and AnswerByLabel when the VM has no pending question.
+var ErrNoQuestion = errors.New("no pending question")
+
+// Question returns the VirtualMachine's pending question, or ErrNoQuestion if there is none.
+func (v VirtualMachine) Question(ctx context.Context) (*types.VirtualMachineQuestionInfo, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"runtime.question"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Runtime.Question == nil {
+		return nil, ErrNoQuestion
+	}
+
+	return o.Runtime.Question, nil
+}
+
+// AnswerByLabel answers the pending question using the choice with the given label, ignoring case,
+// such as "I Copied It". ErrNoQuestion is returned if there is no pending question.
+func (v VirtualMachine) AnswerByLabel(ctx context.Context, label string) error {
+	q, err := v.Question(ctx)
+	if err != nil {
+		return err
+	}
+
+	key, err := questionAnswer(q, map[string]string{q.Text: label})
+	if err != nil {
+		return err
+	}
+
+	return v.Answer(ctx, q.Id, key)
+}
+
+// questionAnswer returns the choice key to answer the given question with,
+// matching the question's message IDs or text against answers and the answer against the choice keys and labels.
+func questionAnswer(q *types.VirtualMachineQuestionInfo, answers map[string]string) (string, error) {
+	keys := []string{q.Text}
+	for _, m := range q.Message {
+		keys = append(keys, m.Id)
+	}
+
+	var choices []string
+	for _, c := range q.Choice.ChoiceInfo {
+		d := c.GetElementDescription()
+		choices = append(choices, fmt.Sprintf("%s (%s)", d.Key, d.Label))
+	}
+
+	for _, key := range keys {
+		answer, ok := answers[key]
+		if !ok {
+			continue
+		}
+
+		for _, c := range q.Choice.ChoiceInfo {
+			d := c.GetElementDescription()
+			if answer == d.Key || strings.EqualFold(answer, d.Label) || strings.EqualFold(answer, d.Summary) {
+				return d.Key, nil
+			}
+		}
+
+		return "", fmt.Errorf("answer %q to question %q is not one of: %s", answer, key, strings.Join(choices, ", "))
+	}
+
+	return "", fmt.Errorf("no answer to question %q (%s), choices: %s", q.Text, strings.Join(keys[1:], ", "), strings.Join(choices, ", "))
+}
+
+// powerOnAnswering calls PowerOn and waits for the task to complete, answering any questions using answers.
+// The returned bool is true if the task failed after a question was answered, in which case power on can be retried.
+func (v VirtualMachine) powerOnAnswering(ctx context.Context, answers map[string]string) (bool, error) {
+	task, err := v.PowerOn(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	filter := new(property.WaitFilter).
+		Add(task.Reference(), "Task", []string{"info.state"}).
+		Add(v.Reference(), "VirtualMachine", []string{"runtime.question"})
+
+	answered := false
+	seen := make(map[string]bool)
+	var qerr error
+
+	err = property.WaitForUpdates(ctx, property.DefaultCollector(v.c), filter, func(updates []types.ObjectUpdate) bool {
+		for _, update := range updates {
+			for _, change := range update.ChangeSet {
+				switch val := change.Val.(type) {
+				case types.TaskInfoState:
+					if val == types.TaskInfoStateSuccess || val == types.TaskInfoStateError {
+						return true
+					}
+				case types.VirtualMachineQuestionInfo:
+					if seen[val.Id] {
+						continue
+					}
+					seen[val.Id] = true
+
+					var choice string
+					choice, qerr = questionAnswer(&val, answers)
+					if qerr == nil {
+						qerr = v.Answer(ctx, val.Id, choice)
+					}
+					if qerr != nil {
+						return true
+					}
+					answered = true
+				}
+			}
+		}
+		return false
+	})
+
+	if qerr != nil {
+		return false, fmt.Errorf("%s: %s", v.Reference(), qerr)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = task.Wait(ctx)
+	return answered && err != nil, err
+}
+
+// PowerOnWithAnswers powers on the VirtualMachine and waits for the PowerOn task to complete, answering any question
+// asked by the VirtualMachine in the meantime, such as the "msg.uuid.altered" question when a VM was copied or moved.
+// The answers map a question message ID or question text to the key, label or summary of the choice to answer with:
+//
+//	vm.PowerOnWithAnswers(ctx, map[string]string{"msg.uuid.altered": "button.uuid.copiedTheVM"})
+//
+// If a question has no answer in the map, an error listing the question's choices is returned and the question
+// is left pending. If the PowerOn task fails after a question was answered, power on is retried.
+func (v VirtualMachine) PowerOnWithAnswers(ctx context.Context, answers map[string]string) error {
+	const attempts = 3
+
+	for i := 1; ; i++ {
+		retry, err := v.powerOnAnswering(ctx, answers)
+		if !retry || i == attempts {
+			return err
+		}
+	}
+}
+
+func (v VirtualMachine) AcquireTicket(ctx context.Context, kind string) (*types.VirtualMachineTicket, error) {
+	req := types.AcquireTicket{
+		This:       v.Reference(),
+		TicketType: kind,
+	}
+
+	res, err := methods.AcquireTicket(ctx, v.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Returnval, nil
+}
+
+// CreateSnapshot creates a new snapshot of a virtual machine.
+func (v VirtualMachine) CreateSnapshot(ctx context.Context, name string, description string, memory bool, quiesce bool) (*Task, error) {
+	req := types.CreateSnapshot_Task{
+		This:        v.Reference(),
+		Name:        name,
+		Description: description,
+		Memory:      memory,
+		Quiesce:     quiesce,
+	}
+
+	res, err := methods.CreateSnapshot_Task(ctx, v.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(v.c, res.Returnval), nil
+}
+
+// CreateSnapshotAndWait creates a new snapshot of a virtual machine, waits for the task to complete
+// and returns the reference of the new snapshot.
+// If quiesce is true and the guest fails to quiesce, the snapshot is retried once without quiesce
+// when retryWithoutQuiesce is true, otherwise the quiesce fault is returned.
+func (v VirtualMachine) CreateSnapshotAndWait(ctx context.Context, name string, description string, memory bool, quiesce bool, retryWithoutQuiesce bool) (types.ManagedObjectReference, error) {
+	var ref types.ManagedObjectReference
+
+	for {
+		task, err := v.CreateSnapshot(ctx, name, description, memory, quiesce)
+		if err != nil {
+			return ref, err
+		}
+
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			if quiesce && retryWithoutQuiesce && isQuiesceFault(err) {
+				quiesce = false
+				continue
+			}
+			return ref, err
+		}
+
+		ref, ok := info.Result.(types.ManagedObjectReference)
+		if !ok {
+			return ref, fmt.Errorf("%s: unexpected snapshot task result %T", v.Reference(), info.Result)
+		}
+
+		return ref, nil
+	}
+}
+
+// isQuiesceFault returns true if err is caused by the guest failing to quiesce.
+func isQuiesceFault(err error) bool {
+	_, fault, ok := soap.FaultDetail(err)
+	if !ok {
+		return false
+	}
+
+	switch fault.(type) {
+	case *types.ApplicationQuiesceFault, *types.FilesystemQuiesceFault:
+		return true
+	}
+
+	return false
+}
+
+// RemoveAllSnapshot removes all snapshots of a virtual machine
+func (v VirtualMachine) RemoveAllSnapshot(ctx context.Context, consolidate *bool) (*Task, error) {
+	req := types.RemoveAllSnapshots_Task{
+		This:        v.Reference(),
+		Consolidate: consolidate,
+	}
+
+	res, err := methods.RemoveAllSnapshots_Task(ctx, v.c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTask(v.c, res.Returnval), nil
+}
+
+type snapshotMap map[string][]types.ManagedObjectReference
 
 func (m snapshotMap) add(parent string, tree []types.VirtualMachineSnapshotTree) {
 	for i, st := range tree {
@@ -661,6 +1541,141 @@ func (m snapshotMap) add(parent string, tree []types.VirtualMachineSnapshotTree)
 	}
 }
 
+// SnapshotEntry is a snapshot in the flattened tree returned by FlattenSnapshotTree.
+type SnapshotEntry struct {
+	Snapshot    types.ManagedObjectReference
+	Parent      *types.ManagedObjectReference // nil for root snapshots
+	Name        string
+	Path        string // Full tree path of the snapshot, as accepted by FindSnapshot
+	Description string
+	CreateTime  time.Time
+	State       types.VirtualMachinePowerState
+	Quiesced    bool
+	Depth       int  // Depth in the tree, starting at 0 for root snapshots
+	Current     bool // Current is true if this is the VirtualMachine's current snapshot
+}
+
+// FlattenSnapshotTree returns the snapshot tree as a flat list, in depth-first order such that
+// each snapshot is followed by its children, suitable for rendering the tree with Depth based indentation.
+func FlattenSnapshotTree(info *types.VirtualMachineSnapshotInfo) []SnapshotEntry {
+	var entries []SnapshotEntry
+
+	if info == nil {
+		return nil
+	}
+
+	var walk func(parent *types.ManagedObjectReference, ppath string, depth int, tree []types.VirtualMachineSnapshotTree)
+
+	walk = func(parent *types.ManagedObjectReference, ppath string, depth int, tree []types.VirtualMachineSnapshotTree) {
+		for i := range tree {
+			st := &tree[i]
+			entry := SnapshotEntry{
+				Snapshot:    st.Snapshot,
+				Parent:      parent,
+				Name:        st.Name,
+				Path:        path.Join(ppath, st.Name),
+				Description: st.Description,
+				CreateTime:  st.CreateTime,
+				State:       st.State,
+				Quiesced:    st.Quiesced,
+				Depth:       depth,
+				Current:     info.CurrentSnapshot != nil && *info.CurrentSnapshot == st.Snapshot,
+			}
+			entries = append(entries, entry)
+
+			walk(&st.Snapshot, entry.Path, depth+1, st.ChildSnapshotList)
+		}
+	}
+
+	walk(nil, "", 0, info.RootSnapshotList)
+
+	return entries
+}
+
+// SnapshotList returns the VirtualMachine's snapshot tree flattened via FlattenSnapshotTree.
+// The list is empty if the VirtualMachine has no snapshots.
+func (v VirtualMachine) SnapshotList(ctx context.Context) ([]SnapshotEntry, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"snapshot"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	return FlattenSnapshotTree(o.Snapshot), nil
+}
+
+// SnapshotNode is a snapshot in the tree returned by VirtualMachine.SnapshotTree.
+type SnapshotNode struct {
+	Snapshot    types.ManagedObjectReference
+	Name        string
+	Description string
+	CreateTime  time.Time
+	State       types.VirtualMachinePowerState
+	Quiesced    bool
+	Current     bool // Current is true if this is the VirtualMachine's current snapshot
+	Children    []SnapshotNode
+}
+
+func newSnapshotNodes(current *types.ManagedObjectReference, tree []types.VirtualMachineSnapshotTree) []SnapshotNode {
+	var nodes []SnapshotNode
+
+	for _, st := range tree {
+		nodes = append(nodes, SnapshotNode{
+			Snapshot:    st.Snapshot,
+			Name:        st.Name,
+			Description: st.Description,
+			CreateTime:  st.CreateTime,
+			State:       st.State,
+			Quiesced:    st.Quiesced,
+			Current:     current != nil && *current == st.Snapshot,
+			Children:    newSnapshotNodes(current, st.ChildSnapshotList),
+		})
+	}
+
+	return nodes
+}
+
+// SnapshotTree returns the VirtualMachine's root snapshots, each with its tree of child snapshots.
+// The list is empty if the VirtualMachine has no snapshots.
+func (v VirtualMachine) SnapshotTree(ctx context.Context) ([]SnapshotNode, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"snapshot"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Snapshot == nil {
+		return nil, nil
+	}
+
+	return newSnapshotNodes(o.Snapshot.CurrentSnapshot, o.Snapshot.RootSnapshotList), nil
+}
+
+// SnapshotSize returns the size in bytes of each of the VirtualMachine's snapshots, as calculated by
+// the SnapshotSize function, including the growth since the current snapshot was taken in the current snapshot's size.
+func (v VirtualMachine) SnapshotSize(ctx context.Context) (map[types.ManagedObjectReference]int64, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"snapshot", "layoutEx"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make(map[types.ManagedObjectReference]int64)
+
+	if o.LayoutEx == nil {
+		return sizes, nil
+	}
+
+	for _, entry := range FlattenSnapshotTree(o.Snapshot) {
+		sizes[entry.Snapshot] = int64(SnapshotSize(entry.Snapshot, entry.Parent, o.LayoutEx, entry.Current))
+	}
+
+	return sizes, nil
+}
+
 // SnapshotSize calculates the size of a given snapshot in bytes. If the
 // snapshot is current, disk files not associated with any parent snapshot are
 // included in size calculations. This allows for measuring and including the
@@ -718,10 +1733,22 @@ func SnapshotSize(info types.ManagedObjectReference, parent *types.ManagedObject
 	return size
 }
 
+// MultipleFoundError is returned by FindSnapshot when the given name matches more than one snapshot.
+type MultipleFoundError struct {
+	Kind  string
+	Name  string
+	Found []types.ManagedObjectReference
+}
+
+func (e *MultipleFoundError) Error() string {
+	return fmt.Sprintf("path '%s' resolves to multiple %ss", e.Name, e.Kind)
+}
+
 // FindSnapshot supports snapshot lookup by name, where name can be:
 // 1) snapshot ManagedObjectReference.Value (unique)
 // 2) snapshot name (may not be unique)
 // 3) snapshot tree path (may not be unique)
+// A *MultipleFoundError is returned if name matches more than one snapshot.
 func (v VirtualMachine) FindSnapshot(ctx context.Context, name string) (*types.ManagedObjectReference, error) {
 	var o mo.VirtualMachine
 
@@ -744,7 +1771,7 @@ func (v VirtualMachine) FindSnapshot(ctx context.Context, name string) (*types.M
 	case 1:
 		return &s[0], nil
 	default:
-		return nil, fmt.Errorf("%q resolves to %d snapshots", name, len(s))
+		return nil, &MultipleFoundError{Kind: "snapshot", Name: name, Found: s}
 	}
 }
 
@@ -813,7 +1840,36 @@ func (v VirtualMachine) IsToolsRunning(ctx context.Context) (bool, error) {
 		return false, err
 	}
 
-	return o.Guest.ToolsRunningStatus == string(types.VirtualMachineToolsRunningStatusGuestToolsRunning), nil
+	return o.Guest.ToolsRunningStatus == string(types.VirtualMachineToolsRunningStatusGuestToolsRunning), nil
+}
+
+// WaitForGuestReady waits until VMware Tools is running in the guest and the guest is ready to accept guest operations.
+// Tools can report running before guest operations are ready, such that waiting on guest.toolsRunningStatus alone
+// is not enough to avoid failures from the guest.FileManager or guest.ProcessManager.
+// If timeout is greater than zero, an error is returned if the guest is not ready within that duration.
+func (v VirtualMachine) WaitForGuestReady(ctx context.Context, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	running := false
+	ready := false
+
+	p := property.DefaultCollector(v.c)
+	return property.Wait(ctx, p, v.Reference(), []string{"guest.toolsRunningStatus", "guest.guestOperationsReady"}, func(pc []types.PropertyChange) bool {
+		for _, c := range pc {
+			switch c.Name {
+			case "guest.toolsRunningStatus":
+				running = c.Val == string(types.VirtualMachineToolsRunningStatusGuestToolsRunning)
+			case "guest.guestOperationsReady":
+				ready = c.Val == true
+			}
+		}
+
+		return running && ready
+	})
 }
 
 // Wait for the VirtualMachine to change to the desired power state.
@@ -839,12 +1895,39 @@ func (v VirtualMachine) WaitForPowerState(ctx context.Context, state types.Virtu
 	return err
 }
 
+// templateState returns the VM's config.template flag and power state.
+func (v VirtualMachine) templateState(ctx context.Context) (bool, types.VirtualMachinePowerState, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{PropConfigTemplate, PropRuntimePowerState}, &o)
+	if err != nil {
+		return false, "", err
+	}
+
+	return o.Summary.Config.Template, o.Summary.Runtime.PowerState, nil
+}
+
+// MarkAsTemplate marks the VM as a template.
+// An error is returned if the VM is already a template or is not powered off.
 func (v VirtualMachine) MarkAsTemplate(ctx context.Context) error {
+	template, state, err := v.templateState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if template {
+		return fmt.Errorf("%s is already a template", v.Reference())
+	}
+
+	if state != types.VirtualMachinePowerStatePoweredOff {
+		return fmt.Errorf("%s must be powered off to mark as template (power state is %s)", v.Reference(), state)
+	}
+
 	req := types.MarkAsTemplate{
 		This: v.Reference(),
 	}
 
-	_, err := methods.MarkAsTemplate(ctx, v.c, &req)
+	_, err = methods.MarkAsTemplate(ctx, v.c, &req)
 	if err != nil {
 		return err
 	}
@@ -852,7 +1935,18 @@ func (v VirtualMachine) MarkAsTemplate(ctx context.Context) error {
 	return nil
 }
 
+// MarkAsVirtualMachine converts the template to a VM, placed in the given pool and, optionally, on the given host.
+// An error is returned if the VM is not a template.
 func (v VirtualMachine) MarkAsVirtualMachine(ctx context.Context, pool ResourcePool, host *HostSystem) error {
+	template, _, err := v.templateState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !template {
+		return fmt.Errorf("%s is not a template", v.Reference())
+	}
+
 	req := types.MarkAsVirtualMachine{
 		This: v.Reference(),
 		Pool: pool.Reference(),
@@ -863,7 +1957,7 @@ func (v VirtualMachine) MarkAsVirtualMachine(ctx context.Context, pool ResourceP
 		req.Host = &ref
 	}
 
-	_, err := methods.MarkAsVirtualMachine(ctx, v.c, &req)
+	_, err = methods.MarkAsVirtualMachine(ctx, v.c, &req)
 	if err != nil {
 		return err
 	}
@@ -926,7 +2020,43 @@ func (v VirtualMachine) QueryConfigTarget(ctx context.Context) (*types.ConfigTar
 	return res.Returnval, nil
 }
 
+// checkToolsState returns an error if the VirtualMachine is not powered on,
+// or if requireTools is true and VMware Tools is not installed in the guest.
+func (v VirtualMachine) checkToolsState(ctx context.Context, requireTools bool) error {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"runtime.powerState", "guest.toolsStatus", "guest.toolsVersionStatus2"}, &o)
+	if err != nil {
+		return err
+	}
+
+	if o.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		return fmt.Errorf("%s must be powered on, power state is %s", v.Reference(), o.Runtime.PowerState)
+	}
+
+	if !requireTools || o.Guest == nil {
+		return nil
+	}
+
+	installed := o.Guest.ToolsStatus != types.VirtualMachineToolsStatusToolsNotInstalled
+	if o.Guest.ToolsVersionStatus2 != "" {
+		installed = o.Guest.ToolsVersionStatus2 != string(types.VirtualMachineToolsVersionStatusGuestToolsNotInstalled)
+	}
+
+	if !installed {
+		return fmt.Errorf("VMware Tools is not installed in %s", v.Reference())
+	}
+
+	return nil
+}
+
+// MountToolsInstaller mounts the VMware Tools installer CD image in the guest.
+// The VirtualMachine must be powered on, Tools need not be installed, as the installer is used for the initial install.
 func (v VirtualMachine) MountToolsInstaller(ctx context.Context) error {
+	if err := v.checkToolsState(ctx, false); err != nil {
+		return err
+	}
+
 	req := types.MountToolsInstaller{
 		This: v.Reference(),
 	}
@@ -935,7 +2065,13 @@ func (v VirtualMachine) MountToolsInstaller(ctx context.Context) error {
 	return err
 }
 
+// UnmountToolsInstaller unmounts the VMware Tools installer CD image.
+// The VirtualMachine must be powered on.
 func (v VirtualMachine) UnmountToolsInstaller(ctx context.Context) error {
+	if err := v.checkToolsState(ctx, false); err != nil {
+		return err
+	}
+
 	req := types.UnmountToolsInstaller{
 		This: v.Reference(),
 	}
@@ -944,7 +2080,13 @@ func (v VirtualMachine) UnmountToolsInstaller(ctx context.Context) error {
 	return err
 }
 
+// UpgradeTools upgrades VMware Tools in the guest, passing the given options to the installer.
+// The VirtualMachine must be powered on with VMware Tools installed.
 func (v VirtualMachine) UpgradeTools(ctx context.Context, options string) (*Task, error) {
+	if err := v.checkToolsState(ctx, true); err != nil {
+		return nil, err
+	}
+
 	req := types.UpgradeTools_Task{
 		This:             v.Reference(),
 		InstallerOptions: options,
@@ -1000,6 +2142,155 @@ func (v VirtualMachine) UUID(ctx context.Context) string {
 	return ""
 }
 
+// DiskChangeID returns the changed block tracking change ID of the given disk backing.
+// The returned bool is false if the backing type does not support changed block tracking.
+// As per VDDK programming guide, these are the four types of disks
+// that support CBT, see "Gathering Changed Block Information".
+func DiskChangeID(backing types.BaseVirtualDeviceBackingInfo) (string, bool) {
+	switch b := backing.(type) {
+	case *types.VirtualDiskFlatVer2BackingInfo:
+		return b.ChangeId, true
+	case *types.VirtualDiskSparseVer2BackingInfo:
+		return b.ChangeId, true
+	case *types.VirtualDiskRawDiskMappingVer1BackingInfo:
+		return b.ChangeId, true
+	case *types.VirtualDiskRawDiskVer2BackingInfo:
+		return b.ChangeId, true
+	}
+	return "", false
+}
+
+// DiskChangeTracking is the changed block tracking state of a VirtualDisk.
+type DiskChangeTracking struct {
+	Key       int32
+	Label     string
+	Supported bool   // Supported is true if the disk backing type supports changed block tracking
+	ChangeID  string // ChangeID as required by QueryChangedDiskAreas, empty if tracking is not active
+	Enabled   bool   // Enabled is true if changed block tracking is enabled for the VirtualMachine and active on the disk
+}
+
+// DiskChangeTracking returns the changed block tracking state of each VirtualDisk.
+// If snapshot is nil, the VirtualMachine's current config is used, otherwise the config of the given snapshot,
+// such that Enabled reports whether the snapshot has a valid change ID for use with QueryChangedDiskAreas.
+func (v VirtualMachine) DiskChangeTracking(ctx context.Context, snapshot *types.ManagedObjectReference) ([]DiskChangeTracking, error) {
+	var config *types.VirtualMachineConfigInfo
+
+	props := []string{"config.changeTrackingEnabled", "config.hardware.device"}
+
+	if snapshot == nil {
+		var o mo.VirtualMachine
+		if err := v.Properties(ctx, v.Reference(), props, &o); err != nil {
+			return nil, err
+		}
+		config = o.Config
+	} else {
+		var o mo.VirtualMachineSnapshot
+		if err := v.Properties(ctx, *snapshot, props, &o); err != nil {
+			return nil, err
+		}
+		config = &o.Config
+	}
+
+	if config == nil {
+		return nil, fmt.Errorf("%s config is not available", v.Reference())
+	}
+
+	devices := VirtualDeviceList(config.Hardware.Device)
+
+	var disks []DiskChangeTracking
+
+	for _, disk := range devices.SelectByType((*types.VirtualDisk)(nil)) {
+		disks = append(disks, *newDiskChangeTracking(config, disk.GetVirtualDevice().Key))
+	}
+
+	return disks, nil
+}
+
+// newDiskChangeTracking returns the changed block tracking state of the VirtualDisk with the given key,
+// or nil if there is no such disk in config.
+func newDiskChangeTracking(config *types.VirtualMachineConfigInfo, key int32) *DiskChangeTracking {
+	if config == nil {
+		return nil
+	}
+
+	devices := VirtualDeviceList(config.Hardware.Device)
+
+	disk, ok := devices.FindByKey(key).(*types.VirtualDisk)
+	if !ok {
+		return nil
+	}
+
+	id, ok := DiskChangeID(disk.Backing)
+	enabled := config.ChangeTrackingEnabled != nil && *config.ChangeTrackingEnabled
+
+	return &DiskChangeTracking{
+		Key:       key,
+		Label:     devices.Name(disk),
+		Supported: ok,
+		ChangeID:  id,
+		Enabled:   enabled && ok && id != "",
+	}
+}
+
+// ChangeTrackingEnabled returns true if changed block tracking is enabled for the VirtualMachine.
+func (v VirtualMachine) ChangeTrackingEnabled(ctx context.Context) (bool, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config.changeTrackingEnabled"}, &o)
+	if err != nil {
+		return false, err
+	}
+
+	if o.Config == nil {
+		return false, fmt.Errorf("%s config is not available", v.Reference())
+	}
+
+	return o.Config.ChangeTrackingEnabled != nil && *o.Config.ChangeTrackingEnabled, nil
+}
+
+// SetChangeTracking enables or disables changed block tracking for the VirtualMachine.
+// A powered on VirtualMachine only applies the change to its disks after a stun/unstun cycle.
+// When stun is true and the VirtualMachine is powered on, a snapshot is created and removed
+// once the reconfigure completes to force that cycle. Otherwise the change takes effect at the
+// next stun/unstun cycle, such as a power cycle or snapshot operation.
+func (v VirtualMachine) SetChangeTracking(ctx context.Context, enabled bool, stun bool) error {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config.changeTrackingEnabled", "runtime.powerState"}, &o)
+	if err != nil {
+		return err
+	}
+
+	if o.Config != nil && o.Config.ChangeTrackingEnabled != nil && *o.Config.ChangeTrackingEnabled == enabled {
+		return nil
+	}
+
+	task, err := v.Reconfigure(ctx, types.VirtualMachineConfigSpec{ChangeTrackingEnabled: types.NewBool(enabled)})
+	if err != nil {
+		return err
+	}
+
+	if err = task.Wait(ctx); err != nil {
+		return err
+	}
+
+	if !stun || o.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		return nil
+	}
+
+	snapshot, err := v.CreateSnapshotAndWait(ctx, "govmomi-change-tracking", "", false, false, false)
+	if err != nil {
+		return err
+	}
+
+	task, err = v.RemoveSnapshot(ctx, snapshot.Value, false, nil)
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
 func (v VirtualMachine) QueryChangedDiskAreas(ctx context.Context, baseSnapshot, curSnapshot *types.ManagedObjectReference, disk *types.VirtualDisk, offset int64) (types.DiskChangeInfo, error) {
 	var noChange types.DiskChangeInfo
 	var err error
@@ -1023,22 +2314,8 @@ func (v VirtualMachine) QueryChangedDiskAreas(ctx context.Context, baseSnapshot,
 			continue
 		}
 
-		// As per VDDK programming guide, these are the four types of disks
-		// that support CBT, see "Gathering Changed Block Information".
-		if b, ok := d.Backing.(*types.VirtualDiskFlatVer2BackingInfo); ok {
-			changeId = &b.ChangeId
-			break
-		}
-		if b, ok := d.Backing.(*types.VirtualDiskSparseVer2BackingInfo); ok {
-			changeId = &b.ChangeId
-			break
-		}
-		if b, ok := d.Backing.(*types.VirtualDiskRawDiskMappingVer1BackingInfo); ok {
-			changeId = &b.ChangeId
-			break
-		}
-		if b, ok := d.Backing.(*types.VirtualDiskRawDiskVer2BackingInfo); ok {
-			changeId = &b.ChangeId
+		if id, ok := DiskChangeID(d.Backing); ok {
+			changeId = &id
 			break
 		}
 
@@ -1063,3 +2340,340 @@ func (v VirtualMachine) QueryChangedDiskAreas(ctx context.Context, baseSnapshot,
 
 	return res.Returnval, nil
 }
+
+// DiskLayout maps each virtual disk of a VirtualMachine to the chain of files backing it,
+// as decoded from the VirtualMachine's layoutEx property.
+type DiskLayout struct {
+	Disks []DiskChain
+}
+
+// DiskChain is the chain of files backing a virtual disk, ordered from the base disk to the running point.
+type DiskChain struct {
+	Key   int32              // VirtualDisk device key
+	Disk  *types.VirtualDisk // nil if the disk is not in config.hardware.device
+	Units []DiskChainUnit
+}
+
+// DiskChainUnit is a single link in a DiskChain, a disk descriptor file and its extent files.
+type DiskChainUnit struct {
+	// Snapshot is set if this unit is the point in time disk state of a snapshot.
+	// Otherwise, this unit is the running point, written to by the VirtualMachine.
+	Snapshot *types.ManagedObjectReference
+	Files    []types.VirtualMachineFileLayoutExFileInfo
+	Size     int64 // Sum of Files size
+	// ChangeTracking is the changed block tracking state of the disk as of this unit.
+	// It is nil for units that are neither a snapshot's disk state nor the running point.
+	ChangeTracking *DiskChangeTracking
+}
+
+// Name returns the datastore path of the unit's disk descriptor file.
+func (u DiskChainUnit) Name() string {
+	for _, file := range u.Files {
+		if file.Type == string(types.VirtualMachineFileLayoutExFileTypeDiskDescriptor) {
+			return file.Name
+		}
+	}
+	if len(u.Files) != 0 {
+		return u.Files[0].Name
+	}
+	return ""
+}
+
+// Size returns the sum of all unit sizes in the chain.
+func (c DiskChain) Size() int64 {
+	var size int64
+	for _, unit := range c.Units {
+		size += unit.Size
+	}
+	return size
+}
+
+// newDiskLayout decodes layout, where config is the VirtualMachine's current config and
+// snapshots is the config of each snapshot, used to populate the change tracking state of each unit.
+func newDiskLayout(config *types.VirtualMachineConfigInfo, snapshots map[types.ManagedObjectReference]*types.VirtualMachineConfigInfo, layout *types.VirtualMachineFileLayoutEx) *DiskLayout {
+	files := make(map[int32]types.VirtualMachineFileLayoutExFileInfo, len(layout.File))
+	for _, file := range layout.File {
+		files[file.Key] = file
+	}
+
+	// The unit at the end of a snapshot's disk chain is the snapshot's point in time state of that disk
+	points := make(map[int32]map[int]types.ManagedObjectReference)
+	for _, snapshot := range layout.Snapshot {
+		for _, disk := range snapshot.Disk {
+			if len(disk.Chain) == 0 {
+				continue
+			}
+			if points[disk.Key] == nil {
+				points[disk.Key] = make(map[int]types.ManagedObjectReference)
+			}
+			points[disk.Key][len(disk.Chain)-1] = snapshot.Key
+		}
+	}
+
+	var devices VirtualDeviceList
+	if config != nil {
+		devices = config.Hardware.Device
+	}
+
+	dl := new(DiskLayout)
+
+	for _, disk := range layout.Disk {
+		chain := DiskChain{Key: disk.Key}
+
+		if d, ok := devices.FindByKey(disk.Key).(*types.VirtualDisk); ok {
+			chain.Disk = d
+		}
+
+		for i, link := range disk.Chain {
+			var unit DiskChainUnit
+
+			if ref, ok := points[disk.Key][i]; ok {
+				unit.Snapshot = &ref
+				unit.ChangeTracking = newDiskChangeTracking(snapshots[ref], disk.Key)
+			} else if i == len(disk.Chain)-1 {
+				unit.ChangeTracking = newDiskChangeTracking(config, disk.Key)
+			}
+
+			for _, key := range link.FileKey {
+				if file, ok := files[key]; ok {
+					unit.Files = append(unit.Files, file)
+					unit.Size += file.Size
+				}
+			}
+
+			chain.Units = append(chain.Units, unit)
+		}
+
+		dl.Disks = append(dl.Disks, chain)
+	}
+
+	return dl
+}
+
+// DiskLayout returns the VirtualMachine's disk file layout, decoded from the layoutEx property.
+func (v VirtualMachine) DiskLayout(ctx context.Context) (*DiskLayout, error) {
+	var o mo.VirtualMachine
+
+	props := []string{"config.changeTrackingEnabled", "config.hardware.device"}
+
+	err := v.Properties(ctx, v.Reference(), append(props, "layoutEx"), &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.LayoutEx == nil {
+		return nil, fmt.Errorf("%s layoutEx is not available", v.Reference())
+	}
+
+	snapshots := make(map[types.ManagedObjectReference]*types.VirtualMachineConfigInfo)
+
+	if len(o.LayoutEx.Snapshot) != 0 {
+		refs := make([]types.ManagedObjectReference, len(o.LayoutEx.Snapshot))
+		for i, snapshot := range o.LayoutEx.Snapshot {
+			refs[i] = snapshot.Key
+		}
+
+		var content []mo.VirtualMachineSnapshot
+		err = property.DefaultCollector(v.c).Retrieve(ctx, refs, props, &content)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range content {
+			snapshots[content[i].Self] = &content[i].Config
+		}
+	}
+
+	return newDiskLayout(o.Config, snapshots, o.LayoutEx), nil
+}
+
+// ChangedDiskAreas returns the areas of the given disk that changed since baseChangeID,
+// as of the given snapshot or the running point if snapshot is nil.
+// Use a baseChangeID of "*" to get all allocated areas of the disk.
+// Unlike QueryChangedDiskAreas, all areas are returned, from offset 0 up to the disk capacity.
+func (v VirtualMachine) ChangedDiskAreas(ctx context.Context, disk *types.VirtualDisk, snapshot *types.ManagedObjectReference, baseChangeID string) ([]types.DiskChangeExtent, error) {
+	var areas []types.DiskChangeExtent
+	var offset int64
+
+	capacity := disk.CapacityInBytes
+	if capacity == 0 {
+		capacity = disk.CapacityInKB * 1024
+	}
+
+	for offset < capacity {
+		req := types.QueryChangedDiskAreas{
+			This:        v.Reference(),
+			Snapshot:    snapshot,
+			DeviceKey:   disk.Key,
+			StartOffset: offset,
+			ChangeId:    baseChangeID,
+		}
+
+		res, err := methods.QueryChangedDiskAreas(ctx, v.c, &req)
+		if err != nil {
+			return nil, err
+		}
+
+		areas = append(areas, res.Returnval.ChangedArea...)
+
+		if res.Returnval.Length <= 0 {
+			break
+		}
+
+		offset = res.Returnval.StartOffset + res.Returnval.Length
+	}
+
+	return areas, nil
+}
+
+// ScheduledPowerOp is a recurring VirtualMachine power operation, as used by VirtualMachine.SetScheduledPowerOps.
+type ScheduledPowerOp struct {
+	Method   string // Method is one of PowerOnVM_Task, PowerOffVM_Task, ShutdownGuest, RebootGuest, ResetVM_Task or SuspendVM_Task
+	Hour     int32  // Hour of the day in UTC
+	Minute   int32  // Minute of the hour
+	Interval int32  // Interval in days between each run, defaults to 1
+	Name     string // Name of the scheduled task, defaults to the VirtualMachine ID, Method and time
+}
+
+var scheduledPowerMethods = map[string]bool{
+	"PowerOnVM_Task":  true,
+	"PowerOffVM_Task": true,
+	"ShutdownGuest":   true,
+	"RebootGuest":     true,
+	"ResetVM_Task":    true,
+	"SuspendVM_Task":  true,
+}
+
+func (op ScheduledPowerOp) spec(vm types.ManagedObjectReference) (*types.ScheduledTaskSpec, error) {
+	if !scheduledPowerMethods[op.Method] {
+		return nil, fmt.Errorf("unsupported power method: %q", op.Method)
+	}
+	if op.Hour < 0 || op.Hour > 23 || op.Minute < 0 || op.Minute > 59 {
+		return nil, fmt.Errorf("invalid time for %s: %02d:%02d", op.Method, op.Hour, op.Minute)
+	}
+
+	scheduler := new(types.DailyTaskScheduler)
+	scheduler.Hour = op.Hour
+	scheduler.Minute = op.Minute
+	scheduler.Interval = op.Interval
+	if scheduler.Interval == 0 {
+		scheduler.Interval = 1
+	}
+
+	name := op.Name
+	if name == "" {
+		name = fmt.Sprintf("%s %s %02d:%02d UTC", vm.Value, op.Method, op.Hour, op.Minute)
+	}
+
+	return &types.ScheduledTaskSpec{
+		Name:        name,
+		Description: fmt.Sprintf("%s %s", op.Method, vm),
+		Enabled:     true,
+		Scheduler:   scheduler,
+		Action:      &types.MethodAction{Name: op.Method},
+	}, nil
+}
+
+// ScheduledPowerOps returns the VirtualMachine's scheduled tasks with a power operation action.
+func (v VirtualMachine) ScheduledPowerOps(ctx context.Context) ([]*ScheduledTask, error) {
+	m := NewScheduledTaskManager(v.c)
+
+	tasks, err := m.RetrieveEntityScheduledTask(ctx, v)
+	if err != nil || len(tasks) == 0 {
+		return nil, err
+	}
+
+	refs := make([]types.ManagedObjectReference, len(tasks))
+	for i := range tasks {
+		refs[i] = tasks[i].Reference()
+	}
+
+	var st []mo.ScheduledTask
+	err = property.DefaultCollector(v.c).Retrieve(ctx, refs, []string{"info"}, &st)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []*ScheduledTask
+	for i := range st {
+		if action, ok := st[i].Info.Action.(*types.MethodAction); ok && scheduledPowerMethods[action.Name] {
+			ops = append(ops, NewScheduledTask(v.c, st[i].Self))
+		}
+	}
+
+	return ops, nil
+}
+
+// SetScheduledPowerOps replaces the VirtualMachine's scheduled power operations with the given ops,
+// each of which is created as a ScheduledTask with a DailyTaskScheduler and MethodAction.
+// For example, to power on at 8am and power off at 6pm UTC each day:
+//
+//	vm.SetScheduledPowerOps(ctx,
+//	  object.ScheduledPowerOp{Method: "PowerOnVM_Task", Hour: 8},
+//	  object.ScheduledPowerOp{Method: "ShutdownGuest", Hour: 18})
+//
+// Calling SetScheduledPowerOps without any ops removes all scheduled power operations.
+// If any of the new tasks cannot be created, those already created are removed and the
+// previous schedule is restored before the error is returned.
+// Scheduled tasks require vCenter.
+func (v VirtualMachine) SetScheduledPowerOps(ctx context.Context, ops ...ScheduledPowerOp) ([]*ScheduledTask, error) {
+	specs := make([]types.BaseScheduledTaskSpec, len(ops))
+	for i, op := range ops {
+		spec, err := op.spec(v.Reference())
+		if err != nil {
+			return nil, err
+		}
+		specs[i] = spec
+	}
+
+	existing, err := v.ScheduledPowerOps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save the existing specs so they can be restored, as tasks are removed first
+	// to avoid a DuplicateName fault when a new op has the same name as an existing one.
+	var previous []types.BaseScheduledTaskSpec
+	for _, task := range existing {
+		info, err := task.Info(ctx)
+		if err != nil {
+			return nil, err
+		}
+		previous = append(previous, &info.ScheduledTaskSpec)
+	}
+
+	for i, task := range existing {
+		if err = task.Remove(ctx); err != nil {
+			_, _ = v.createScheduledTasks(ctx, previous[:i])
+			return nil, err
+		}
+	}
+
+	tasks, err := v.createScheduledTasks(ctx, specs)
+	if err != nil {
+		_, _ = v.createScheduledTasks(ctx, previous)
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// createScheduledTasks creates a ScheduledTask for each of the given specs.
+// If any create fails, the tasks already created are removed.
+func (v VirtualMachine) createScheduledTasks(ctx context.Context, specs []types.BaseScheduledTaskSpec) ([]*ScheduledTask, error) {
+	m := NewScheduledTaskManager(v.c)
+	tasks := make([]*ScheduledTask, 0, len(specs))
+
+	for _, spec := range specs {
+		task, err := m.CreateScheduledTask(ctx, v, spec)
+		if err != nil {
+			for _, task := range tasks {
+				_ = task.Remove(ctx)
+			}
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, nil
+}
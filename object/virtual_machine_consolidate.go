@@ -0,0 +1,212 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// FileLockedError is returned by VirtualMachine.Consolidate when a disk file is locked.
+// The lock Owner is the VMFS lock owner UUID, when included in the fault messages,
+// the last component of which is the MAC address of the host holding the lock.
+type FileLockedError struct {
+	VirtualMachine types.ManagedObjectReference
+	File           string                        // File that is locked, if provided by the fault
+	Owner          string                        // Owner UUID of the lock, if provided by the fault messages
+	MAC            string                        // MAC address of the host holding the lock, derived from Owner
+	Host           *types.ManagedObjectReference // Host with a NIC matching MAC, if any
+	HostName       string                        // HostName of Host, if any
+	Err            error
+}
+
+func (e *FileLockedError) Error() string {
+	file := e.File
+	if file == "" {
+		file = "disk file"
+	}
+
+	msg := fmt.Sprintf("%s: %s is locked", e.VirtualMachine, file)
+
+	switch {
+	case e.Host != nil:
+		msg += fmt.Sprintf(" by host %s (%s)", e.HostName, e.Host)
+	case e.MAC != "":
+		msg += fmt.Sprintf(" by the host with MAC address %s", e.MAC)
+	default:
+		msg += ", see vmkernel.log on the hosts with access to the datastore for the lock owner"
+	}
+
+	return fmt.Sprintf("%s: %s", msg, e.Err)
+}
+
+func (e *FileLockedError) Unwrap() error {
+	return e.Err
+}
+
+// lockOwner matches the owner of a VMFS lock, as logged by vmkernel and included in fault messages.
+// For example: "owner 5b1e4d6c-1a2b3c4d-5e6f-001b21857c2c"
+var lockOwner = regexp.MustCompile(`owner\s+([0-9a-fA-F]{8}-[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-([0-9a-fA-F]{12}))`)
+
+// newFileLockedError returns a FileLockedError if err is caused by a locked file, otherwise nil.
+func newFileLockedError(vm types.ManagedObjectReference, err error) *FileLockedError {
+	_, fault, ok := soap.FaultDetail(err)
+	if !ok {
+		return nil
+	}
+
+	messages := []string{err.Error()}
+	for _, m := range fault.GetMethodFault().FaultMessage {
+		messages = append(messages, m.Message)
+	}
+	text := strings.Join(messages, "\n")
+
+	e := &FileLockedError{VirtualMachine: vm, Err: err}
+
+	if ff, ok := fault.(types.BaseFileFault); ok {
+		e.File = ff.GetFileFault().File
+	}
+
+	if _, ok := fault.(*types.FileLocked); !ok {
+		lower := strings.ToLower(text)
+		if !strings.Contains(lower, "locked") && !strings.Contains(lower, "failed to lock") {
+			return nil
+		}
+	}
+
+	if match := lockOwner.FindStringSubmatch(text); match != nil && strings.Trim(match[2], "0") != "" {
+		e.Owner = match[1]
+		mac := strings.ToLower(match[2])
+		for i := 0; i < len(mac); i += 2 {
+			if i != 0 {
+				e.MAC += ":"
+			}
+			e.MAC += mac[i : i+2]
+		}
+	}
+
+	return e
+}
+
+// findLockHost sets e.Host to the only HostSystem with a physical or virtual NIC matching e.MAC.
+func (e *FileLockedError) findLockHost(ctx context.Context, c *Common) error {
+	if e.MAC == "" {
+		return nil
+	}
+
+	req := types.CreateContainerView{
+		This:      *c.c.ServiceContent.ViewManager,
+		Container: c.c.ServiceContent.RootFolder,
+		Type:      []string{"HostSystem"},
+		Recursive: true,
+	}
+
+	res, err := methods.CreateContainerView(ctx, c.c, &req)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		_, _ = methods.DestroyView(ctx, c.c, &types.DestroyView{This: res.Returnval})
+	}()
+
+	spec := new(property.FilterSpec).
+		Object(res.Returnval, true).
+		Traverse("view", "ContainerView", "view").
+		Properties("HostSystem", "name", "config.network.pnic", "config.network.vnic")
+
+	var hosts []mo.HostSystem
+	err = property.DefaultCollector(c.c).RetrieveFiltered(ctx, spec, &hosts)
+	if err != nil {
+		return err
+	}
+
+	var found []mo.HostSystem
+
+	for _, host := range hosts {
+		if host.Config == nil {
+			continue
+		}
+		var macs []string
+		for _, nic := range host.Config.Network.Pnic {
+			macs = append(macs, nic.Mac)
+		}
+		for _, nic := range host.Config.Network.Vnic {
+			macs = append(macs, nic.Spec.Mac)
+		}
+		for _, mac := range macs {
+			if strings.EqualFold(mac, e.MAC) {
+				found = append(found, host)
+				break
+			}
+		}
+	}
+
+	if len(found) == 1 {
+		e.Host = &found[0].Self
+		e.HostName = found[0].Name
+	}
+
+	return nil
+}
+
+// NeedsConsolidation returns the VirtualMachine's runtime.consolidationNeeded property,
+// which is true when redundant delta disks, such as those left behind by a failed snapshot removal, should be
+// consolidated using Consolidate.
+func (v VirtualMachine) NeedsConsolidation(ctx context.Context) (bool, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"runtime.consolidationNeeded"}, &o)
+	if err != nil {
+		return false, err
+	}
+
+	return o.Runtime.ConsolidationNeeded != nil && *o.Runtime.ConsolidationNeeded, nil
+}
+
+// Consolidate consolidates the VirtualMachine's disks, waiting for the ConsolidateVMDisks_Task to complete.
+// If consolidation fails due to a locked file, a *FileLockedError is returned, including the file
+// and the host holding the lock if they can be determined from the fault.
+func (v VirtualMachine) Consolidate(ctx context.Context) error {
+	req := types.ConsolidateVMDisks_Task{
+		This: v.Reference(),
+	}
+
+	res, err := methods.ConsolidateVMDisks_Task(ctx, v.c, &req)
+	if err == nil {
+		err = NewTask(v.c, res.Returnval).Wait(ctx)
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	if lerr := newFileLockedError(v.Reference(), err); lerr != nil {
+		_ = lerr.findLockHost(ctx, &v.Common) // best effort, MAC is included in the error regardless
+		return lerr
+	}
+
+	return err
+}
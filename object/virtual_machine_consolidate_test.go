@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineConsolidate(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		needed := func(expect bool) {
+			ok, err := vm.NeedsConsolidation(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != expect {
+				t.Errorf("NeedsConsolidation=%t", ok)
+			}
+		}
+
+		needed(false)
+		simulator.Map.Update(svm, []types.PropertyChange{{Name: "runtime.consolidationNeeded", Val: true}})
+		needed(true)
+
+		if err := vm.Consolidate(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		needed(false)
+
+		host := simulator.Map.Any("HostSystem").(*simulator.HostSystem)
+		host.Config.Network.Pnic[0].Mac = "00:1b:21:85:7c:2c"
+
+		file := "[LocalDS_0] DC0_H0_VM0/DC0_H0_VM0-000001.vmdk"
+		msg := "Lock [type 10c00001 offset 13058048 v 20, hb offset 3444736 gen 7, mode 1, owner 5b1e4d6c-1a2b3c4d-5e6f-001b21857c2c mtime 1234]"
+
+		simulator.Override("ConsolidateVMDisks_Task", func(ctx *simulator.Context, _ types.AnyType) (soap.HasFault, bool) {
+			task := simulator.CreateTask(vm, "consolidateDisks", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+				fault := new(types.FileLocked)
+				fault.File = file
+				fault.FaultMessage = []types.LocalizableMessage{{Key: "msg.fileio.lock", Message: msg}}
+				return nil, fault
+			})
+			return &methods.ConsolidateVMDisks_TaskBody{
+				Res: &types.ConsolidateVMDisks_TaskResponse{Returnval: task.Run(ctx)},
+			}, true
+		})
+
+		err := vm.Consolidate(ctx)
+		var lerr *object.FileLockedError
+		if !errors.As(err, &lerr) {
+			t.Fatalf("err=%#v", err)
+		}
+		if lerr.File != file {
+			t.Errorf("file=%s", lerr.File)
+		}
+		if lerr.MAC != "00:1b:21:85:7c:2c" {
+			t.Errorf("mac=%s", lerr.MAC)
+		}
+		if lerr.Host == nil || *lerr.Host != host.Reference() || lerr.HostName != host.Name {
+			t.Errorf("host=%v (%s)", lerr.Host, lerr.HostName)
+		}
+
+		// a host NIC MAC not matching the lock owner
+		host.Config.Network.Pnic[0].Mac = "00:0c:29:81:d8:a0"
+
+		err = vm.Consolidate(ctx)
+		if !errors.As(err, &lerr) {
+			t.Fatalf("err=%#v", err)
+		}
+		if lerr.Host != nil {
+			t.Errorf("host=%s", lerr.Host)
+		}
+
+		simulator.Override("ConsolidateVMDisks_Task", func(*simulator.Context, types.AnyType) (soap.HasFault, bool) {
+			return simulator.MethodFault(&types.InvalidPowerState{}), true
+		})
+
+		err = vm.Consolidate(ctx)
+		if err == nil || errors.As(err, &lerr) {
+			t.Errorf("err=%#v", err)
+		}
+	})
+}
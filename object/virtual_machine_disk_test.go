@@ -0,0 +1,104 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineDetachVirtualDisk(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		devices, err := vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		disks := devices.SelectByType((*types.VirtualDisk)(nil))
+		if len(disks) == 0 {
+			t.Fatal("no disks")
+		}
+		disk := disks[0].(*types.VirtualDisk)
+		file := disk.Backing.(types.BaseVirtualDeviceFileBackingInfo).GetVirtualDeviceFileBackingInfo().FileName
+
+		if _, err = vm.DetachVirtualDisk(ctx, -1); err == nil {
+			t.Error("expected error")
+		}
+
+		if _, err = vm.PromoteDisks(ctx, true, []int32{-1}); err == nil {
+			t.Error("expected error")
+		}
+
+		// give the disk a parent for PromoteDisks to unlink
+		svm := simulator.Map.Get(vm.Reference()).(*simulator.VirtualMachine)
+		sdisk := object.VirtualDeviceList(svm.Config.Hardware.Device).FindByKey(disk.Key).(*types.VirtualDisk)
+		sdisk.Backing.(*types.VirtualDiskFlatVer2BackingInfo).Parent = &types.VirtualDiskFlatVer2BackingInfo{
+			VirtualDeviceFileBackingInfo: types.VirtualDeviceFileBackingInfo{FileName: file},
+		}
+
+		task, err := vm.PromoteDisks(ctx, true, []int32{disk.Key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err = vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		promoted := devices.FindByKey(disk.Key).(*types.VirtualDisk)
+		if p := promoted.Backing.(*types.VirtualDiskFlatVer2BackingInfo).Parent; p != nil {
+			t.Errorf("parent=%s", p.FileName)
+		}
+
+		task, err = vm.DetachVirtualDisk(ctx, disk.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		devices, err = vm.Device(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if devices.FindByKey(disk.Key) != nil {
+			t.Error("disk not removed")
+		}
+
+		dc, err := find.NewFinder(c).DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		fm := object.NewVirtualDiskManager(c)
+		if _, err = fm.QueryVirtualDiskUuid(ctx, file, dc); err != nil {
+			t.Errorf("disk file removed: %s", err)
+		}
+	})
+}
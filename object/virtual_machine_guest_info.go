@@ -0,0 +1,121 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"net"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// GuestInfo is a summary of the VirtualMachine guest property, as reported by VMware Tools.
+type GuestInfo struct {
+	HostName           string
+	GuestFamily        string
+	GuestFullName      string
+	ToolsRunningStatus string
+	ToolsVersionStatus string
+	IpAddress          string // IpAddress is the guest.ipAddress property
+	NICs               []GuestNIC
+}
+
+// GuestNIC is a summary of a guest.net entry, with addresses split by IP version.
+type GuestNIC struct {
+	MAC       string
+	Network   string
+	Connected bool
+	DeviceKey int32 // DeviceKey is the key of the VirtualEthernetCard, -1 if the NIC is not a virtual device
+	IPv4      []string
+	IPv6      []string
+}
+
+func newGuestInfo(g *types.GuestInfo) *GuestInfo {
+	info := &GuestInfo{
+		HostName:           g.HostName,
+		GuestFamily:        g.GuestFamily,
+		GuestFullName:      g.GuestFullName,
+		ToolsRunningStatus: g.ToolsRunningStatus,
+		ToolsVersionStatus: g.ToolsVersionStatus2,
+		IpAddress:          g.IpAddress,
+	}
+
+	for _, n := range g.Net {
+		nic := GuestNIC{
+			MAC:       n.MacAddress,
+			Network:   n.Network,
+			Connected: n.Connected,
+			DeviceKey: n.DeviceConfigId,
+		}
+
+		addrs := n.IpAddress
+		if n.IpConfig != nil {
+			addrs = nil
+			for _, ip := range n.IpConfig.IpAddress {
+				addrs = append(addrs, ip.IpAddress)
+			}
+		}
+
+		for _, addr := range addrs {
+			ip := net.ParseIP(addr)
+			switch {
+			case ip == nil:
+				continue
+			case ip.To4() != nil:
+				nic.IPv4 = append(nic.IPv4, addr)
+			default:
+				nic.IPv6 = append(nic.IPv6, addr)
+			}
+		}
+
+		info.NICs = append(info.NICs, nic)
+	}
+
+	return info
+}
+
+// PrimaryIP returns the first routable IPv4 address of the guest NICs,
+// or an empty string if there is no routable IPv4 address.
+// See also VirtualMachine.GuestInfoIP, which takes the guest's default route into account and may return IPv6.
+func (g *GuestInfo) PrimaryIP() string {
+	for _, nic := range g.NICs {
+		for _, addr := range nic.IPv4 {
+			if isRoutableIP(net.ParseIP(addr)) {
+				return addr
+			}
+		}
+	}
+
+	return ""
+}
+
+// GuestInfo returns a summary of the VirtualMachine's guest property.
+func (v VirtualMachine) GuestInfo(ctx context.Context) (*GuestInfo, error) {
+	var o mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"guest"}, &o)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.Guest == nil {
+		return new(GuestInfo), nil
+	}
+
+	return newGuestInfo(o.Guest), nil
+}
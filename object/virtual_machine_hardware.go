@@ -0,0 +1,137 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// hardwareVersion returns the number of a hardware version string such as "vmx-19".
+func hardwareVersion(version string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimPrefix(version, "vmx-"))
+	if err != nil || !strings.HasPrefix(version, "vmx-") {
+		return 0, fmt.Errorf("invalid hardware version: %q", version)
+	}
+	return n, nil
+}
+
+// SupportedHardwareVersions returns the hardware versions, such as "vmx-19", that the VM can be upgraded to
+// on its runtime host, as reported by the VM's EnvironmentBrowser.
+func (v VirtualMachine) SupportedHardwareVersions(ctx context.Context) ([]string, error) {
+	var vm mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"environmentBrowser", "runtime.host"}, &vm)
+	if err != nil {
+		return nil, err
+	}
+
+	req := types.QueryConfigOptionDescriptor{
+		This: vm.EnvironmentBrowser,
+	}
+
+	res, err := methods.QueryConfigOptionDescriptor(ctx, v.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return supportedHardwareVersions(res.Returnval, vm.Runtime.Host), nil
+}
+
+func supportedHardwareVersions(descriptors []types.VirtualMachineConfigOptionDescriptor, host *types.ManagedObjectReference) []string {
+	var versions []string
+
+	for _, d := range descriptors {
+		if d.UpgradeSupported != nil && !*d.UpgradeSupported {
+			continue
+		}
+
+		if host != nil && len(d.Host) != 0 {
+			found := false
+			for _, ref := range d.Host {
+				if ref == *host {
+					found = true
+					break
+				}
+			}
+			if !found {
+				continue
+			}
+		}
+
+		if _, err := hardwareVersion(d.Key); err == nil {
+			versions = append(versions, d.Key)
+		}
+	}
+
+	return versions
+}
+
+// UpgradeHardware upgrades the VM to the given hardware version, such as "vmx-19",
+// or to the latest version supported by the host if version is empty.
+// An error is returned if the VM is not powered off, if version is not one of the SupportedHardwareVersions,
+// or if version is not newer than the VM's current version.
+func (v VirtualMachine) UpgradeHardware(ctx context.Context, version string) (*Task, error) {
+	var vm mo.VirtualMachine
+
+	err := v.Properties(ctx, v.Reference(), []string{"config.version", PropRuntimePowerState}, &vm)
+	if err != nil {
+		return nil, err
+	}
+
+	if state := vm.Summary.Runtime.PowerState; state != types.VirtualMachinePowerStatePoweredOff {
+		return nil, fmt.Errorf("%s must be powered off to upgrade hardware (power state is %s)", v.Reference(), state)
+	}
+
+	if version != "" {
+		target, err := hardwareVersion(version)
+		if err != nil {
+			return nil, err
+		}
+
+		if vm.Config != nil {
+			current, err := hardwareVersion(vm.Config.Version)
+			if err == nil && target <= current {
+				return nil, fmt.Errorf("cannot upgrade %s to %s: current hardware version is %s", v.Reference(), version, vm.Config.Version)
+			}
+		}
+
+		versions, err := v.SupportedHardwareVersions(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		supported := false
+		for _, s := range versions {
+			if s == version {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, fmt.Errorf("cannot upgrade %s to %s: supported versions are %s", v.Reference(), version, versions)
+		}
+	}
+
+	return v.UpgradeVM(ctx, version)
+}
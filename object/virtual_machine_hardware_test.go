@@ -0,0 +1,130 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/task"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineUpgradeHardware(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		versions, err := vm.SupportedHardwareVersions(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(versions) != 1 || versions[0] != esx.HardwareVersion {
+			t.Errorf("versions=%v", versions)
+		}
+
+		if _, err = vm.UpgradeHardware(ctx, esx.HardwareVersion); err == nil {
+			t.Error("expected error upgrading a powered on VM")
+		}
+
+		// a powered off VM with an older hardware version
+		folder, err := finder.DefaultFolder(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := finder.ResourcePool(ctx, "DC0_H0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := types.VirtualMachineConfigSpec{
+			Name:    "upgrade-vm",
+			GuestId: string(types.VirtualMachineGuestOsIdentifierOtherGuest),
+			Version: "vmx-10",
+			Files: &types.VirtualMachineFileInfo{
+				VmPathName: "[LocalDS_0]",
+			},
+		}
+
+		create, err := folder.CreateVM(ctx, spec, pool, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := create.WaitForResult(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vm = object.NewVirtualMachine(c, info.Result.(types.ManagedObjectReference))
+
+		for _, version := range []string{"19", "vmx-", "vmx-4", "vmx-10", "vmx-99"} {
+			if _, err = vm.UpgradeHardware(ctx, version); err == nil {
+				t.Errorf("expected error upgrading to %q", version)
+			}
+		}
+
+		// vcsim faults for versions that are invalid, not supported or not newer
+		faults := map[string]interface{}{
+			"bogus":  new(types.InvalidArgument),
+			"vmx-99": new(types.NotSupported),
+			"vmx-10": new(types.AlreadyUpgraded),
+		}
+		for version, fault := range faults {
+			upgrade, err := vm.UpgradeVM(ctx, version)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = upgrade.Wait(ctx)
+			if err == nil {
+				t.Fatalf("expected error upgrading to %q", version)
+			}
+			if f := err.(task.Error).Fault(); reflect.TypeOf(f) != reflect.TypeOf(fault) {
+				t.Errorf("%s: fault=%T", version, f)
+			}
+		}
+
+		upgrade, err := vm.UpgradeHardware(ctx, esx.HardwareVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = upgrade.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		var mvm mo.VirtualMachine
+		if err = vm.Properties(ctx, vm.Reference(), []string{"config.version"}, &mvm); err != nil {
+			t.Fatal(err)
+		}
+		if mvm.Config.Version != esx.HardwareVersion {
+			t.Errorf("version=%s", mvm.Config.Version)
+		}
+
+		if _, err = vm.UpgradeHardware(ctx, esx.HardwareVersion); err == nil {
+			t.Error("expected error upgrading to the current version")
+		}
+	})
+}
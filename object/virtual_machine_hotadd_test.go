@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+func TestVirtualMachineHotAdd(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		wait := func(task *object.Task, err error) error {
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		hardware := func() (int32, int32) {
+			var o mo.VirtualMachine
+			err := vm.Properties(ctx, vm.Reference(), []string{"config.hardware"}, &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return o.Config.Hardware.NumCPU, o.Config.Hardware.MemoryMB
+		}
+
+		cpu, mem := hardware()
+
+		// powered on without hot add
+		if err := wait(vm.SetMemoryMB(ctx, int64(mem)*2)); err == nil {
+			t.Error("expected error")
+		}
+		if err := wait(vm.SetNumCPU(ctx, cpu+1)); err == nil {
+			t.Error("expected error")
+		}
+		if err := wait(vm.EnableHotAdd(ctx, true, true)); err == nil {
+			t.Error("expected error")
+		}
+
+		if err := wait(vm.PowerOff(ctx)); err != nil {
+			t.Fatal(err)
+		}
+
+		// powered off, no restrictions
+		if err := wait(vm.SetNumCPU(ctx, cpu+1)); err != nil {
+			t.Fatal(err)
+		}
+		if err := wait(vm.EnableHotAdd(ctx, true, true)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := wait(vm.PowerOn(ctx)); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := wait(vm.SetMemoryMB(ctx, int64(mem)*2)); err != nil {
+			t.Fatal(err)
+		}
+		if err := wait(vm.SetNumCPU(ctx, cpu+2)); err != nil {
+			t.Fatal(err)
+		}
+
+		// hot remove is not enabled
+		if err := wait(vm.SetNumCPU(ctx, cpu)); err == nil {
+			t.Error("expected error")
+		}
+		if err := wait(vm.SetMemoryMB(ctx, int64(mem))); err == nil {
+			t.Error("expected error")
+		}
+
+		ncpu, nmem := hardware()
+		if ncpu != cpu+2 || nmem != mem*2 {
+			t.Errorf("cpu=%d mem=%d", ncpu, nmem)
+		}
+	})
+}
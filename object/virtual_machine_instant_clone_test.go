@@ -0,0 +1,85 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineInstantCloneFolder(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		dc, err := finder.DefaultDatacenter(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		finder.SetDatacenter(dc)
+
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		folder, err := folders.VmFolder.CreateFolder(ctx, "forks")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ref := folder.Reference()
+		spec := object.NewInstantCloneSpec("DC0_H0_VM0_fork", types.VirtualMachineRelocateSpec{Folder: &ref}, nil)
+
+		task, err := vm.InstantClone(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := task.WaitForResult(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var clone mo.VirtualMachine
+		err = vm.Properties(ctx, info.Result.(types.ManagedObjectReference), []string{"parent"}, &clone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if *clone.Parent != ref {
+			t.Errorf("parent=%s", clone.Parent)
+		}
+
+		// an invalid folder is rejected
+		spec.Name += "2"
+		spec.Location.Folder = &types.ManagedObjectReference{Type: "Folder", Value: "enoent"}
+		if _, err = vm.InstantClone(ctx, spec); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
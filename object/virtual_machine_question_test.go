@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+var movedOrCopied = types.VirtualMachineQuestionInfo{
+	Id:   "57_1",
+	Text: "This virtual machine might have been moved or copied.",
+	Choice: types.ChoiceOption{
+		ChoiceInfo: []types.BaseElementDescription{
+			&types.ElementDescription{Key: "0", Description: types.Description{Label: "Cancel"}},
+			&types.ElementDescription{Key: "1", Description: types.Description{Label: "I Moved It"}},
+			&types.ElementDescription{Key: "2", Description: types.Description{Label: "I Copied It"}},
+		},
+	},
+	Message: []types.VirtualMachineMessage{{Id: "msg.uuid.altered"}},
+}
+
+// askOnPowerOn overrides PowerOnVM_Task to ask the movedOrCopied question, with the PowerOn task returning the
+// result of fn once the question is answered. The choice of each answer is sent to the returned channel.
+func askOnPowerOn(vm *simulator.VirtualMachine, fn func(int) types.BaseMethodFault) chan string {
+	answers := make(chan string, 10)
+	answered := make(chan struct{}, 10)
+	attempt := 0
+
+	simulator.Override("AnswerVM", func(_ *simulator.Context, req types.AnyType) (soap.HasFault, bool) {
+		answers <- req.(*types.AnswerVM).AnswerChoice
+		answered <- struct{}{}
+		return nil, false
+	})
+
+	simulator.Override("PowerOnVM_Task", func(ctx *simulator.Context, _ types.AnyType) (soap.HasFault, bool) {
+		attempt++
+		n := attempt
+
+		ctx.Map.Update(vm, []types.PropertyChange{{Name: "runtime.question", Val: movedOrCopied}})
+
+		// vcsim locks a task's entity until the task completes, the host is used here so the VM can be answered
+		host := ctx.Map.Get(*vm.Runtime.Host)
+
+		task := simulator.CreateTask(host, "powerOn", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+			select {
+			case <-answered:
+			case <-time.After(5 * time.Second):
+			}
+
+			return nil, fn(n)
+		})
+
+		return &methods.PowerOnVM_TaskBody{
+			Res: &types.PowerOnVM_TaskResponse{Returnval: task.Run(ctx)},
+		}, true
+	})
+
+	return answers
+}
+
+func TestVirtualMachinePowerOnWithAnswers(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		var attempts int32
+		answers := askOnPowerOn(svm, func(n int) types.BaseMethodFault {
+			atomic.StoreInt32(&attempts, int32(n))
+			if n == 1 {
+				return &types.FileLocked{} // retried
+			}
+			return nil
+		})
+
+		err := vm.PowerOnWithAnswers(ctx, map[string]string{"msg.uuid.altered": "I copied it"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := atomic.LoadInt32(&attempts)
+		if n != 2 {
+			t.Errorf("attempts=%d", n)
+		}
+		for i := int32(0); i < n; i++ {
+			if answer := <-answers; answer != "2" {
+				t.Errorf("answer=%s", answer)
+			}
+		}
+
+		// invalid choice
+		err = vm.PowerOnWithAnswers(ctx, map[string]string{"msg.uuid.altered": "I forget"})
+		if err == nil || !strings.Contains(err.Error(), "I Copied It") {
+			t.Errorf("err=%v", err)
+		}
+		if err = vm.Answer(ctx, movedOrCopied.Id, "0"); err != nil {
+			t.Fatal(err)
+		}
+		<-answers
+
+		// unanswered question
+		err = vm.PowerOnWithAnswers(ctx, nil)
+		if err == nil || !strings.Contains(err.Error(), "msg.uuid.altered") {
+			t.Errorf("err=%v", err)
+		}
+		if err = vm.Answer(ctx, movedOrCopied.Id, "0"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestVirtualMachineAnswerByLabel(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		if _, err := vm.Question(ctx); err != object.ErrNoQuestion {
+			t.Fatalf("err=%v", err)
+		}
+		if err := vm.AnswerByLabel(ctx, "I Moved It"); err != object.ErrNoQuestion {
+			t.Fatalf("err=%v", err)
+		}
+
+		simulator.Map.Update(svm, []types.PropertyChange{{Name: "runtime.question", Val: movedOrCopied}})
+
+		q, err := vm.Question(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.Id != movedOrCopied.Id {
+			t.Errorf("id=%s", q.Id)
+		}
+
+		if err = vm.AnswerByLabel(ctx, "I forget"); err == nil || !strings.Contains(err.Error(), "I Moved It") {
+			t.Errorf("err=%v", err)
+		}
+
+		if err = vm.AnswerByLabel(ctx, "i moved it"); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err = vm.Question(ctx); err != object.ErrNoQuestion {
+			t.Errorf("err=%v", err)
+		}
+
+		simulator.Map.Update(svm, []types.PropertyChange{{Name: "runtime.question", Val: movedOrCopied}})
+
+		if err = vm.Answer(ctx, "enoent", "1"); err == nil {
+			t.Error("expected error")
+		}
+		if err = vm.Answer(ctx, movedOrCopied.Id, "1"); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// NewServiceLocator returns a ServiceLocator for the vCenter at the given URL, for use as
+// VirtualMachineRelocateSpec.Service when relocating a VM to another vCenter.
+// The instanceUUID is the about.instanceUuid of the destination vCenter and thumbprint its SSL certificate thumbprint.
+// Credentials are taken from u.User, the URL path defaults to "/sdk".
+func NewServiceLocator(u *url.URL, thumbprint string, instanceUUID string) *types.ServiceLocator {
+	ref := *u
+	if ref.Path == "" {
+		ref.Path = vim25.Path
+	}
+	ref.User = nil
+
+	locator := &types.ServiceLocator{
+		InstanceUuid:  instanceUUID,
+		Url:           ref.String(),
+		SslThumbprint: thumbprint,
+	}
+
+	if u.User != nil {
+		password, _ := u.User.Password()
+		locator.Credential = &types.ServiceLocatorNamePassword{
+			Username: u.User.Username(),
+			Password: password,
+		}
+	}
+
+	return locator
+}
+
+// validateServiceLocator returns an error if the given ServiceLocator is incomplete,
+// as the resulting faults do not identify the missing field.
+func validateServiceLocator(s *types.ServiceLocator) error {
+	switch {
+	case s.Url == "":
+		return errors.New("relocate: service locator URL is not set")
+	case s.InstanceUuid == "":
+		return errors.New("relocate: service locator instance UUID is not set")
+	case s.SslThumbprint == "":
+		return fmt.Errorf("relocate: service locator SSL thumbprint for %s is not set", s.Url)
+	case s.Credential == nil:
+		return fmt.Errorf("relocate: service locator credential for %s is not set", s.Url)
+	}
+	return nil
+}
+
+// RelocateAndWait relocates the VirtualMachine and waits for the task to complete, returning the VirtualMachine
+// on the destination. When relocating to another vCenter, spec.Service must be set (see NewServiceLocator)
+// and dst must be a client of the destination vCenter, used to look up the relocated VM by its instance UUID.
+// Otherwise dst is not used and may be nil.
+func (v VirtualMachine) RelocateAndWait(ctx context.Context, spec types.VirtualMachineRelocateSpec, priority types.VirtualMachineMovePriority, dst *vim25.Client) (*VirtualMachine, error) {
+	var o mo.VirtualMachine
+
+	if spec.Service != nil {
+		if err := validateServiceLocator(spec.Service); err != nil {
+			return nil, err
+		}
+		if dst == nil {
+			return nil, errors.New("relocate: destination client is required with a service locator")
+		}
+
+		err := v.Properties(ctx, v.Reference(), []string{"config.instanceUuid"}, &o)
+		if err != nil {
+			return nil, err
+		}
+		if o.Config == nil {
+			return nil, fmt.Errorf("relocate: %s config is not available", v.Reference())
+		}
+	}
+
+	task, err := v.Relocate(ctx, spec, priority)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := task.WaitForResult(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Service == nil {
+		vm := v
+		return &vm, nil
+	}
+
+	if ref, ok := info.Result.(types.ManagedObjectReference); ok {
+		return NewVirtualMachine(dst, ref), nil
+	}
+
+	instance := true
+	ref, err := NewSearchIndex(dst).FindByUuid(ctx, nil, o.Config.InstanceUuid, true, &instance)
+	if err != nil {
+		return nil, err
+	}
+	if ref == nil {
+		return nil, fmt.Errorf("relocate: VM with instance UUID %s not found on %s", o.Config.InstanceUuid, spec.Service.Url)
+	}
+
+	return NewVirtualMachine(dst, ref.Reference()), nil
+}
@@ -0,0 +1,71 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineRelocateAndWait(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		obj := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, obj.Reference())
+
+		var spec types.VirtualMachineRelocateSpec
+
+		res, err := vm.RelocateAndWait(ctx, spec, types.VirtualMachineMovePriorityDefaultPriority, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Reference() != vm.Reference() {
+			t.Errorf("ref=%s", res.Reference())
+		}
+
+		u := c.URL()
+		u.User = simulator.DefaultLogin
+		spec.Service = object.NewServiceLocator(u, "", c.ServiceContent.About.InstanceUuid)
+
+		if spec.Service.Credential.(*types.ServiceLocatorNamePassword).Username != u.User.Username() {
+			t.Errorf("credential=%#v", spec.Service.Credential)
+		}
+		if strings.Contains(spec.Service.Url, "pass") {
+			t.Errorf("url=%s", spec.Service.Url)
+		}
+
+		_, err = vm.RelocateAndWait(ctx, spec, types.VirtualMachineMovePriorityDefaultPriority, c)
+		if err == nil || !strings.Contains(err.Error(), "thumbprint") {
+			t.Fatalf("err=%v", err)
+		}
+
+		// vcsim ignores the service locator, using the same client as the destination
+		spec.Service.SslThumbprint = "AA:BB:CC"
+		res, err = vm.RelocateAndWait(ctx, spec, types.VirtualMachineMovePriorityDefaultPriority, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.Reference() != vm.Reference() {
+			t.Errorf("ref=%s", res.Reference())
+		}
+	})
+}
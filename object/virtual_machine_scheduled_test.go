@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestVirtualMachineSetScheduledPowerOpsRollback(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		names := func() []string {
+			tasks, err := vm.ScheduledPowerOps(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, task := range tasks {
+				info, err := task.Info(ctx)
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, info.Name)
+			}
+			sort.Strings(names)
+			return names
+		}
+
+		_, err = vm.SetScheduledPowerOps(ctx,
+			object.ScheduledPowerOp{Method: "PowerOnVM_Task", Hour: 8, Name: "on"},
+			object.ScheduledPowerOp{Method: "PowerOffVM_Task", Hour: 18, Name: "off"},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the second create fails with DuplicateName
+		_, err = vm.SetScheduledPowerOps(ctx,
+			object.ScheduledPowerOp{Method: "PowerOnVM_Task", Hour: 7, Name: "dup"},
+			object.ScheduledPowerOp{Method: "PowerOffVM_Task", Hour: 19, Name: "dup"},
+		)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+
+		after := names()
+		if len(after) != 2 || after[0] != "off" || after[1] != "on" {
+			t.Errorf("schedule not restored: %v", after)
+		}
+	})
+}
@@ -0,0 +1,105 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineAddSerialPort(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		ref := simulator.Map.Any("VirtualMachine").Reference()
+		vm := object.NewVirtualMachine(c, ref)
+
+		invalid := []object.SerialPortOptions{
+			{},
+			{ServiceURI: "telnet://:33001", FileName: "[LocalDS_0] vm/serial.out"},
+			{ServiceURI: "http://:33001"},
+			{ServiceURI: "telnet://:33001", ProxyURI: "ftp://vspc:8080"},
+		}
+
+		for _, opts := range invalid {
+			if _, err := vm.AddSerialPort(ctx, opts); err == nil {
+				t.Errorf("expected error for %#v", opts)
+			}
+		}
+
+		tests := []struct {
+			opts  object.SerialPortOptions
+			check func(types.BaseVirtualDeviceBackingInfo) bool
+		}{
+			{
+				object.SerialPortOptions{ServiceURI: "telnet://:33001", ProxyURI: "telnets://vspc.example.com:13370"},
+				func(b types.BaseVirtualDeviceBackingInfo) bool {
+					u, ok := b.(*types.VirtualSerialPortURIBackingInfo)
+					return ok && u.Direction == "server" && u.ProxyURI == "telnets://vspc.example.com:13370"
+				},
+			},
+			{
+				object.SerialPortOptions{ServiceURI: "tcp://10.0.0.1:33001", Client: true},
+				func(b types.BaseVirtualDeviceBackingInfo) bool {
+					u, ok := b.(*types.VirtualSerialPortURIBackingInfo)
+					return ok && u.Direction == "client" && u.ServiceURI == "tcp://10.0.0.1:33001"
+				},
+			},
+			{
+				object.SerialPortOptions{FileName: "[LocalDS_0] vm/serial.out"},
+				func(b types.BaseVirtualDeviceBackingInfo) bool {
+					f, ok := b.(*types.VirtualSerialPortFileBackingInfo)
+					return ok && f.FileName == "[LocalDS_0] vm/serial.out"
+				},
+			},
+			{
+				object.SerialPortOptions{PipeName: `\\.\pipe\com1`, PipeClient: true},
+				func(b types.BaseVirtualDeviceBackingInfo) bool {
+					p, ok := b.(*types.VirtualSerialPortPipeBackingInfo)
+					return ok && p.Endpoint == "client" && p.PipeName == `\\.\pipe\com1`
+				},
+			},
+		}
+
+		for i, test := range tests {
+			port, err := vm.AddSerialPort(ctx, test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if port.Key >= 0 {
+				t.Errorf("key=%d", port.Key)
+			}
+
+			devices, err := vm.Device(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ports := devices.SelectByType((*types.VirtualSerialPort)(nil))
+			if len(ports) != i+1 {
+				t.Fatalf("%d serial ports", len(ports))
+			}
+
+			if !test.check(ports[i].GetVirtualDevice().Backing) {
+				t.Errorf("%d: backing=%#v", i, ports[i].GetVirtualDevice().Backing)
+			}
+		}
+	})
+}
@@ -0,0 +1,145 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineCreateSnapshotAndWait(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		defer simulator.ClearOverrides()
+
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		current := func() types.ManagedObjectReference {
+			var o mo.VirtualMachine
+			err := vm.Properties(ctx, vm.Reference(), []string{"snapshot"}, &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return *o.Snapshot.CurrentSnapshot
+		}
+
+		ref, err := vm.CreateSnapshotAndWait(ctx, "s1", "", false, false, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref.Type != "VirtualMachineSnapshot" || ref != current() {
+			t.Errorf("ref=%s", ref)
+		}
+
+		// the guest fails to quiesce
+		simulator.Override("CreateSnapshot_Task", func(ctx *simulator.Context, req types.AnyType) (soap.HasFault, bool) {
+			if !req.(*types.CreateSnapshot_Task).Quiesce {
+				return nil, false
+			}
+			task := simulator.CreateTask(svm, "createSnapshot", func(*simulator.Task) (types.AnyType, types.BaseMethodFault) {
+				return nil, new(types.FilesystemQuiesceFault)
+			})
+			return &methods.CreateSnapshot_TaskBody{
+				Res: &types.CreateSnapshot_TaskResponse{Returnval: task.Run(ctx)},
+			}, true
+		})
+
+		_, err = vm.CreateSnapshotAndWait(ctx, "s2", "", false, true, false)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if _, fault, _ := soap.FaultDetail(err); fault == nil {
+			t.Errorf("unexpected error: %s", err)
+		} else if _, ok := fault.(*types.FilesystemQuiesceFault); !ok {
+			t.Errorf("fault=%T", fault)
+		}
+
+		ref, err = vm.CreateSnapshotAndWait(ctx, "s2", "", false, true, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ref != current() {
+			t.Errorf("ref=%s", ref)
+		}
+	})
+}
+
+func TestVirtualMachineSetChangeTracking(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		snapshots := func() int {
+			n := 0
+			tm := simulator.Map.Get(*c.ServiceContent.TaskManager).(*simulator.TaskManager)
+			for _, ref := range tm.RecentTask {
+				task := simulator.Map.Get(ref).(*simulator.Task)
+				if task.Info.Entity != nil && *task.Info.Entity == vm.Reference() &&
+					task.Info.DescriptionId == "VirtualMachine.createSnapshot" {
+					n++
+				}
+			}
+			return n
+		}
+
+		tests := []struct {
+			enabled   bool
+			stun      bool
+			snapshots int
+		}{
+			{true, false, 0},
+			{false, true, 1},
+			{true, true, 2},
+			{true, true, 2}, // no change, no snapshot
+			{false, false, 2},
+		}
+
+		for _, test := range tests {
+			if err := vm.SetChangeTracking(ctx, test.enabled, test.stun); err != nil {
+				t.Fatal(err)
+			}
+
+			state, err := vm.ChangeTrackingEnabled(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if state != test.enabled {
+				t.Errorf("enabled=%t, expected %t", state, test.enabled)
+			}
+
+			if n := snapshots(); n != test.snapshots {
+				t.Errorf("snapshots=%d, expected %d", n, test.snapshots)
+			}
+		}
+
+		var o mo.VirtualMachine
+		if err := vm.Properties(ctx, vm.Reference(), []string{"snapshot"}, &o); err != nil {
+			t.Fatal(err)
+		}
+		if o.Snapshot != nil && len(o.Snapshot.RootSnapshotList) != 0 {
+			t.Errorf("snapshots=%d", len(o.Snapshot.RootSnapshotList))
+		}
+	})
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestVirtualMachineMarkAsTemplate(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		finder := find.NewFinder(c)
+
+		vm, err := finder.VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pool, err := vm.ResourcePool(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = vm.MarkAsVirtualMachine(ctx, *pool, nil); err == nil {
+			t.Error("expected error converting a VM that is not a template")
+		}
+
+		if err = vm.MarkAsTemplate(ctx); err == nil {
+			t.Error("expected error marking a powered on VM as template")
+		}
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = vm.MarkAsTemplate(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		isTemplate, err := vm.IsTemplate(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isTemplate {
+			t.Error("expected template")
+		}
+
+		if err = vm.MarkAsTemplate(ctx); err == nil {
+			t.Error("expected error marking a template as template")
+		}
+
+		if err = vm.MarkAsVirtualMachine(ctx, *pool, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		isTemplate, err = vm.IsTemplate(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isTemplate {
+			t.Error("expected VM")
+		}
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package object
 
 import (
+	"net"
 	"testing"
 	"time"
 
@@ -204,3 +205,339 @@ func TestVirtualMachineSnapshotMap(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyInstantCloneGuestInfo(t *testing.T) {
+	spec := NewInstantCloneSpec("fork", types.VirtualMachineRelocateSpec{}, map[string]string{
+		"ip":                 "10.0.0.1",
+		"guestinfo.hostname": "fork-0",
+	})
+
+	ApplyInstantCloneGuestInfo(&spec, map[string]string{
+		"hostname": "fork-1",
+		"domain":   "example.com",
+	})
+
+	expect := []types.OptionValue{
+		{Key: "guestinfo.hostname", Value: "fork-1"},
+		{Key: "guestinfo.ip", Value: "10.0.0.1"},
+		{Key: "guestinfo.domain", Value: "example.com"},
+	}
+
+	if len(spec.Config) != len(expect) {
+		t.Fatalf("config=%#v", spec.Config)
+	}
+
+	for i, opt := range spec.Config {
+		o := opt.GetOptionValue()
+		if o.Key != expect[i].Key || o.Value != expect[i].Value {
+			t.Errorf("config[%d]: %s=%v", i, o.Key, o.Value)
+		}
+	}
+}
+
+func TestNewDiskLayout(t *testing.T) {
+	snap1 := types.ManagedObjectReference{Type: "VirtualMachineSnapshot", Value: "snapshot-1"}
+	snap2 := types.ManagedObjectReference{Type: "VirtualMachineSnapshot", Value: "snapshot-2"}
+
+	file := func(key int32, name string, ftype types.VirtualMachineFileLayoutExFileType, size int64) types.VirtualMachineFileLayoutExFileInfo {
+		return types.VirtualMachineFileLayoutExFileInfo{Key: key, Name: name, Type: string(ftype), Size: size}
+	}
+	desc := types.VirtualMachineFileLayoutExFileTypeDiskDescriptor
+	extent := types.VirtualMachineFileLayoutExFileTypeDiskExtent
+
+	layout := &types.VirtualMachineFileLayoutEx{
+		File: []types.VirtualMachineFileLayoutExFileInfo{
+			file(0, "[ds] vm/vm.vmdk", desc, 1),
+			file(1, "[ds] vm/vm-flat.vmdk", extent, 100),
+			file(2, "[ds] vm/vm-000001.vmdk", desc, 1),
+			file(3, "[ds] vm/vm-000001-delta.vmdk", extent, 10),
+			file(4, "[ds] vm/vm-000002.vmdk", desc, 1),
+			file(5, "[ds] vm/vm-000002-delta.vmdk", extent, 20),
+		},
+		Disk: []types.VirtualMachineFileLayoutExDiskLayout{
+			{
+				Key: 2000,
+				Chain: []types.VirtualMachineFileLayoutExDiskUnit{
+					{FileKey: []int32{0, 1}},
+					{FileKey: []int32{2, 3}},
+					{FileKey: []int32{4, 5}},
+				},
+			},
+		},
+		Snapshot: []types.VirtualMachineFileLayoutExSnapshotLayout{
+			{
+				Key: snap1,
+				Disk: []types.VirtualMachineFileLayoutExDiskLayout{
+					{Key: 2000, Chain: []types.VirtualMachineFileLayoutExDiskUnit{{FileKey: []int32{0, 1}}}},
+				},
+			},
+			{
+				Key: snap2,
+				Disk: []types.VirtualMachineFileLayoutExDiskLayout{
+					{Key: 2000, Chain: []types.VirtualMachineFileLayoutExDiskUnit{{FileKey: []int32{0, 1}}, {FileKey: []int32{2, 3}}}},
+				},
+			},
+		},
+	}
+
+	newConfig := func(id string) *types.VirtualMachineConfigInfo {
+		return &types.VirtualMachineConfigInfo{
+			ChangeTrackingEnabled: types.NewBool(true),
+			Hardware: types.VirtualHardware{
+				Device: []types.BaseVirtualDevice{
+					&types.VirtualDisk{
+						VirtualDevice: types.VirtualDevice{
+							Key:     2000,
+							Backing: &types.VirtualDiskFlatVer2BackingInfo{ChangeId: id},
+						},
+					},
+				},
+			},
+		}
+	}
+	config := newConfig("52 cur")
+	disk := config.Hardware.Device[0]
+
+	snapshots := map[types.ManagedObjectReference]*types.VirtualMachineConfigInfo{
+		snap1: newConfig("52 s1"),
+		snap2: newConfig("52 s2"),
+	}
+
+	dl := newDiskLayout(config, snapshots, layout)
+	if len(dl.Disks) != 1 {
+		t.Fatalf("disks=%d", len(dl.Disks))
+	}
+
+	chain := dl.Disks[0]
+	if chain.Disk != disk {
+		t.Errorf("disk=%v", chain.Disk)
+	}
+	if chain.Size() != 133 {
+		t.Errorf("size=%d", chain.Size())
+	}
+
+	expect := []struct {
+		name     string
+		size     int64
+		snapshot *types.ManagedObjectReference
+		changeID string
+	}{
+		{"[ds] vm/vm.vmdk", 101, &snap1, "52 s1"},
+		{"[ds] vm/vm-000001.vmdk", 11, &snap2, "52 s2"},
+		{"[ds] vm/vm-000002.vmdk", 21, nil, "52 cur"},
+	}
+
+	if len(chain.Units) != len(expect) {
+		t.Fatalf("units=%d", len(chain.Units))
+	}
+
+	for i, e := range expect {
+		unit := chain.Units[i]
+		if unit.Name() != e.name {
+			t.Errorf("%d: name=%s", i, unit.Name())
+		}
+		if unit.Size != e.size {
+			t.Errorf("%d: size=%d", i, unit.Size)
+		}
+		if (unit.Snapshot == nil) != (e.snapshot == nil) || (e.snapshot != nil && *unit.Snapshot != *e.snapshot) {
+			t.Errorf("%d: snapshot=%v", i, unit.Snapshot)
+		}
+		if ct := unit.ChangeTracking; ct == nil || ct.ChangeID != e.changeID || !ct.Enabled {
+			t.Errorf("%d: changeTracking=%#v", i, ct)
+		}
+	}
+}
+
+func TestFlattenSnapshotTree(t *testing.T) {
+	entries := FlattenSnapshotTree(snapshot)
+
+	m := make(snapshotMap)
+	m.add("", snapshot.RootSnapshotList)
+
+	depth := make(map[types.ManagedObjectReference]int)
+	current := 0
+
+	for i, e := range entries {
+		if i == 0 && (e.Name != "root" || e.Depth != 0 || e.Parent != nil) {
+			t.Errorf("root=%#v", e)
+		}
+
+		if e.Parent != nil {
+			pd, ok := depth[*e.Parent]
+			if !ok {
+				t.Errorf("%s: parent %s not listed before child", e.Path, e.Parent)
+			}
+			if e.Depth != pd+1 {
+				t.Errorf("%s: depth=%d", e.Path, e.Depth)
+			}
+		}
+		depth[e.Snapshot] = e.Depth
+
+		// Path may not be unique, but must resolve to the snapshot
+		found := false
+		for _, ref := range m[e.Path] {
+			found = found || ref == e.Snapshot
+		}
+		if !found {
+			t.Errorf("%s: %v", e.Path, m[e.Path])
+		}
+
+		if e.Current {
+			current++
+			if e.Snapshot != *snapshot.CurrentSnapshot {
+				t.Errorf("current=%s", e.Snapshot)
+			}
+		}
+	}
+
+	if current != 1 {
+		t.Errorf("current=%d", current)
+	}
+
+	if len(entries) != 10 {
+		t.Errorf("entries=%d", len(entries))
+	}
+
+	if FlattenSnapshotTree(nil) != nil {
+		t.Error("expected nil")
+	}
+}
+
+func TestNewSnapshotNodes(t *testing.T) {
+	nodes := newSnapshotNodes(snapshot.CurrentSnapshot, snapshot.RootSnapshotList)
+
+	// the tree must contain the same snapshots, in the same order, as the flattened tree
+	var walk func([]SnapshotNode) []types.ManagedObjectReference
+	walk = func(nodes []SnapshotNode) []types.ManagedObjectReference {
+		var refs []types.ManagedObjectReference
+		for _, node := range nodes {
+			refs = append(refs, node.Snapshot)
+			refs = append(refs, walk(node.Children)...)
+		}
+		return refs
+	}
+
+	entries := FlattenSnapshotTree(snapshot)
+	refs := walk(nodes)
+
+	if len(refs) != len(entries) {
+		t.Fatalf("%d nodes, %d entries", len(refs), len(entries))
+	}
+
+	for i := range entries {
+		if refs[i] != entries[i].Snapshot {
+			t.Errorf("%d: %s != %s", i, refs[i], entries[i].Snapshot)
+		}
+	}
+
+	if len(nodes) != 1 || nodes[0].Name != "root" {
+		t.Errorf("nodes=%v", nodes)
+	}
+}
+
+func TestGuestPrimaryIP(t *testing.T) {
+	nic := func(key int32, addrs ...string) types.GuestNicInfo {
+		info := types.GuestNicInfo{DeviceConfigId: key, IpConfig: new(types.NetIpConfigInfo)}
+		for _, addr := range addrs {
+			ip, prefix, _ := net.ParseCIDR(addr)
+			n, _ := prefix.Mask.Size()
+			info.IpConfig.IpAddress = append(info.IpConfig.IpAddress, types.NetIpConfigInfoIpAddress{
+				IpAddress:    ip.String(),
+				PrefixLength: int32(n),
+				State:        string(types.NetIpConfigInfoIpAddressStatusPreferred),
+			})
+		}
+		return info
+	}
+
+	route := func(device, gateway string) types.GuestStackInfo {
+		return types.GuestStackInfo{
+			IpRouteConfig: &types.NetIpRouteConfigInfo{
+				IpRoute: []types.NetIpRouteConfigInfoIpRoute{{
+					Network:      "0.0.0.0",
+					PrefixLength: 0,
+					Gateway:      types.NetIpRouteConfigInfoGateway{IpAddress: gateway, Device: device},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		guest types.GuestInfo
+		ip    string
+	}{
+		{"empty", types.GuestInfo{}, ""},
+		{"ipAddress", types.GuestInfo{IpAddress: "10.0.0.1"}, "10.0.0.1"},
+		{"link-local", types.GuestInfo{IpAddress: "169.254.0.1", Net: []types.GuestNicInfo{nic(4000, "fe80::1/64")}}, ""},
+		{"ipv4", types.GuestInfo{
+			IpAddress: "fe80::1",
+			Net:       []types.GuestNicInfo{nic(4000, "fe80::1/64", "2001:db8::1/64", "10.0.0.1/24")},
+		}, "10.0.0.1"},
+		{"virtual nic", types.GuestInfo{
+			Net: []types.GuestNicInfo{nic(-1, "172.17.0.1/16"), nic(4000, "10.0.0.1/24")},
+		}, "10.0.0.1"},
+		{"default route", types.GuestInfo{
+			IpAddress: "10.0.0.1",
+			Net:       []types.GuestNicInfo{nic(4000, "10.0.0.1/24"), nic(4001, "192.168.1.10/24")},
+			IpStack:   []types.GuestStackInfo{route("1", "192.168.1.1")},
+		}, "192.168.1.10"},
+		{"gateway network", types.GuestInfo{
+			Net:     []types.GuestNicInfo{nic(4000, "10.0.0.1/24", "192.168.1.10/24")},
+			IpStack: []types.GuestStackInfo{route("0", "192.168.1.1")},
+		}, "192.168.1.10"},
+	}
+
+	for _, test := range tests {
+		ip := guestPrimaryIP(&test.guest)
+		if ip != test.ip {
+			t.Errorf("%s: %q != %q", test.name, ip, test.ip)
+		}
+	}
+}
+
+func TestGuestInfoPrimaryIP(t *testing.T) {
+	guest := &types.GuestInfo{
+		HostName:  "vm-0",
+		IpAddress: "fe80::1",
+		Net: []types.GuestNicInfo{
+			{MacAddress: "00:50:56:00:00:01", IpAddress: []string{"fe80::1", "127.0.0.1", "2001:db8::1"}},
+			{MacAddress: "00:50:56:00:00:02", IpConfig: &types.NetIpConfigInfo{
+				IpAddress: []types.NetIpConfigInfoIpAddress{{IpAddress: "10.0.0.2"}, {IpAddress: "10.0.0.3"}},
+			}},
+		},
+	}
+
+	info := newGuestInfo(guest)
+
+	if len(info.NICs) != 2 {
+		t.Fatalf("NICs=%d", len(info.NICs))
+	}
+	if len(info.NICs[0].IPv4) != 1 || len(info.NICs[0].IPv6) != 2 {
+		t.Errorf("NICs[0]=%#v", info.NICs[0])
+	}
+	if ip := info.PrimaryIP(); ip != "10.0.0.2" {
+		t.Errorf("PrimaryIP=%s", ip)
+	}
+
+	// only NIC addresses are used
+	info = newGuestInfo(&types.GuestInfo{IpAddress: "10.0.0.1"})
+	if ip := info.PrimaryIP(); ip != "" {
+		t.Errorf("PrimaryIP=%s", ip)
+	}
+
+	// no routable IPv4
+	info = newGuestInfo(&types.GuestInfo{
+		IpAddress: "2001:db8::1",
+		Net:       []types.GuestNicInfo{{IpAddress: []string{"2001:db8::1", "169.254.0.1"}}},
+	})
+	if ip := info.PrimaryIP(); ip != "" {
+		t.Errorf("PrimaryIP=%s", ip)
+	}
+
+	// GuestInfo built by the caller
+	info = &GuestInfo{NICs: []GuestNIC{{IPv4: []string{"127.0.0.1"}}, {IPv4: []string{"192.168.1.10"}}}}
+	if ip := info.PrimaryIP(); ip != "192.168.1.10" {
+		t.Errorf("PrimaryIP=%s", ip)
+	}
+}
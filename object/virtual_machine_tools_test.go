@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineTools(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		svm := simulator.Map.Any("VirtualMachine").(*simulator.VirtualMachine)
+		vm := object.NewVirtualMachine(c, svm.Reference())
+
+		mounted := func() bool {
+			var o mo.VirtualMachine
+			err := vm.Properties(ctx, vm.Reference(), []string{"runtime.toolsInstallerMounted"}, &o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return o.Runtime.ToolsInstallerMounted
+		}
+
+		// Tools are not installed, but the installer can be mounted to install them
+		if err := vm.MountToolsInstaller(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if !mounted() {
+			t.Error("not mounted")
+		}
+		if err := vm.UnmountToolsInstaller(ctx); err != nil {
+			t.Fatal(err)
+		}
+		if mounted() {
+			t.Error("mounted")
+		}
+
+		if _, err := vm.UpgradeTools(ctx, ""); err == nil {
+			t.Error("expected error")
+		}
+
+		simulator.Map.Update(svm, []types.PropertyChange{
+			{Name: "guest.toolsStatus", Val: types.VirtualMachineToolsStatusToolsOld},
+		})
+
+		task, err := vm.UpgradeTools(ctx, "/S /v /qn")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		task, err = vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = vm.MountToolsInstaller(ctx); err == nil {
+			t.Error("expected error")
+		}
+		if err = vm.UnmountToolsInstaller(ctx); err == nil {
+			t.Error("expected error")
+		}
+		if _, err = vm.UpgradeTools(ctx, ""); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
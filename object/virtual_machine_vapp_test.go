@@ -0,0 +1,98 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestVirtualMachineSetVAppProperty(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm := object.NewVirtualMachine(c, simulator.Map.Any("VirtualMachine").Reference())
+
+		env, err := vm.OvfEnvironment(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(env.Property.Properties) != 0 {
+			t.Errorf("properties=%#v", env.Property.Properties)
+		}
+
+		if err = vm.SetVAppProperty(ctx, "hostname", "foo"); err == nil {
+			t.Error("expected error")
+		}
+
+		spec := types.VirtualMachineConfigSpec{
+			VAppConfig: &types.VmConfigSpec{
+				OvfEnvironmentTransport: []string{"com.vmware.guestInfo"},
+				Property: []types.VAppPropertySpec{
+					{
+						ArrayUpdateSpec: types.ArrayUpdateSpec{Operation: types.ArrayUpdateOperationAdd},
+						Info:            &types.VAppPropertyInfo{Key: 1, Id: "hostname", Type: "string", DefaultValue: "localhost"},
+					},
+					{
+						ArrayUpdateSpec: types.ArrayUpdateSpec{Operation: types.ArrayUpdateOperationAdd},
+						Info:            &types.VAppPropertyInfo{Key: 2, Id: "dns", Type: "string"},
+					},
+				},
+			},
+		}
+
+		task, err := vm.Reconfigure(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = vm.SetVAppProperty(ctx, "dns", "10.0.0.1"); err != nil {
+			t.Fatal(err)
+		}
+
+		props, err := vm.VAppProperties(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(props) != 2 || props[1].Value != "10.0.0.1" || props[1].Type != "string" {
+			t.Errorf("props=%#v", props)
+		}
+
+		env, err = vm.OvfEnvironment(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		expect := map[string]string{"hostname": "localhost", "dns": "10.0.0.1"}
+		for _, p := range env.Property.Properties {
+			if expect[p.Key] != p.Value {
+				t.Errorf("%s=%q", p.Key, p.Value)
+			}
+		}
+
+		if !strings.Contains(env.MarshalManual(), `oe:key="dns" oe:value="10.0.0.1"`) {
+			t.Error(env.MarshalManual())
+		}
+	})
+}
@@ -82,3 +82,48 @@ func TestVirtualMachineWaitForIP(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestVirtualMachineWaitForGuestReady(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		obj := simulator.Map.Get(vm.Reference()).(*simulator.VirtualMachine)
+
+		update := func(changes ...types.PropertyChange) {
+			simulator.Map.WithLock(simulator.SpoofContext(), obj.Reference(), func() {
+				simulator.Map.Update(obj, changes)
+			})
+		}
+
+		err = vm.WaitForGuestReady(ctx, 100*time.Millisecond)
+		if err == nil {
+			t.Fatal("expected timeout")
+		}
+
+		// tools running, but guest operations not yet ready
+		update(types.PropertyChange{Name: "guest.toolsRunningStatus", Val: string(types.VirtualMachineToolsRunningStatusGuestToolsRunning)})
+
+		err = vm.WaitForGuestReady(ctx, 100*time.Millisecond)
+		if err == nil {
+			t.Fatal("expected timeout")
+		}
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			time.Sleep(100 * time.Millisecond)
+			update(types.PropertyChange{Name: "guest.guestOperationsReady", Val: true})
+		}()
+
+		err = vm.WaitForGuestReady(ctx, time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		wg.Wait()
+	})
+}
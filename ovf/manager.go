@@ -18,6 +18,9 @@ package ovf
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -69,6 +72,146 @@ func (m *Manager) CreateImportSpec(ctx context.Context, ovfDescriptor string, re
 	return &res.Returnval, nil
 }
 
+// CreateMappedImportSpec wraps CreateImportSpec, adding cisp.NetworkMapping and cisp.PropertyMapping
+// from the given maps of OVF network name to vSphere network, such as an object.Network, and OVF property key to value.
+// Property keys include the ProductSection class and instance, if any, for example: "vami.ip0.VM_1".
+// The mappings are validated against the descriptor's NetworkSection and ProductSection before
+// calling CreateImportSpec, returning an error if a name or key is not in the descriptor,
+// if a network connected to the virtual hardware is not mapped or if a property without a default value is not set.
+func (m *Manager) CreateMappedImportSpec(ctx context.Context, ovfDescriptor string, resourcePool mo.Reference, datastore mo.Reference,
+	networks map[string]mo.Reference, properties map[string]string, cisp types.OvfCreateImportSpecParams) (*types.OvfCreateImportSpecResult, error) {
+	e, err := Unmarshal(strings.NewReader(ovfDescriptor))
+	if err != nil {
+		return nil, fmt.Errorf("ovf: parse descriptor: %s", err)
+	}
+
+	if err = addMapping(e, networks, properties, &cisp); err != nil {
+		return nil, err
+	}
+
+	return m.CreateImportSpec(ctx, ovfDescriptor, resourcePool, datastore, cisp)
+}
+
+// propertyKey returns the key of a ProductSection Property as used by OvfCreateImportSpecParams.PropertyMapping.
+func propertyKey(s ProductSection, p Property) string {
+	var key []string
+	if s.Class != nil && *s.Class != "" {
+		key = append(key, *s.Class)
+	}
+	key = append(key, p.Key)
+	if s.Instance != nil && *s.Instance != "" {
+		key = append(key, *s.Instance)
+	}
+	return strings.Join(key, ".")
+}
+
+// addMapping validates the given networks and properties against the Envelope and adds them to cisp.
+func addMapping(e *Envelope, networks map[string]mo.Reference, properties map[string]string, cisp *types.OvfCreateImportSpecParams) error {
+	var problems []string
+
+	mapped := make(map[string]bool)
+	for _, n := range cisp.NetworkMapping {
+		mapped[n.Name] = true
+	}
+
+	var names []string
+	valid := make(map[string]bool)
+	if e.Network != nil {
+		for _, n := range e.Network.Networks {
+			names = append(names, n.Name)
+			valid[n.Name] = true
+		}
+	}
+
+	var nkeys []string
+	for name := range networks {
+		nkeys = append(nkeys, name)
+	}
+	sort.Strings(nkeys)
+
+	for _, name := range nkeys {
+		if !valid[name] {
+			problems = append(problems, fmt.Sprintf("unknown network %q, valid names=%s", name, names))
+			continue
+		}
+		mapped[name] = true
+		cisp.NetworkMapping = append(cisp.NetworkMapping, types.OvfNetworkMapping{
+			Name:    name,
+			Network: networks[name].Reference(),
+		})
+	}
+
+	var keys []string
+	props := make(map[string]Property)
+	set := make(map[string]bool)
+	for _, p := range cisp.PropertyMapping {
+		set[p.Key] = true
+	}
+
+	if vs := e.VirtualSystem; vs != nil {
+		for _, hw := range vs.VirtualHardware {
+			for _, item := range hw.Item {
+				if item.Required != nil && !*item.Required {
+					continue
+				}
+				for _, name := range item.Connection {
+					if !mapped[name] {
+						mapped[name] = true // report once
+						problems = append(problems, fmt.Sprintf("network %q is not mapped", name))
+					}
+				}
+			}
+		}
+
+		for _, s := range vs.Product {
+			for _, p := range s.Property {
+				key := propertyKey(s, p)
+				keys = append(keys, key)
+				props[key] = p
+			}
+		}
+	}
+
+	var pkeys []string
+	for key := range properties {
+		pkeys = append(pkeys, key)
+	}
+	sort.Strings(pkeys)
+
+	for _, key := range pkeys {
+		if _, ok := props[key]; !ok {
+			problems = append(problems, fmt.Sprintf("unknown property %q, valid keys=%s", key, keys))
+			continue
+		}
+		set[key] = true
+		cisp.PropertyMapping = append(cisp.PropertyMapping, types.KeyValue{
+			Key:   key,
+			Value: properties[key],
+		})
+	}
+
+	for _, key := range keys {
+		p := props[key]
+		if p.Default != nil || set[key] {
+			continue
+		}
+		if p.UserConfigurable == nil || !*p.UserConfigurable {
+			continue
+		}
+		label := ""
+		if p.Label != nil {
+			label = fmt.Sprintf(" (%s)", *p.Label)
+		}
+		problems = append(problems, fmt.Sprintf("required property %q%s is not set", key, label))
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("ovf: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
 // ParseDescriptor wraps methods.ParseDescriptor
 func (m *Manager) ParseDescriptor(ctx context.Context, ovfDescriptor string, pdp types.OvfParseDescriptorParams) (*types.OvfParseDescriptorResult, error) {
 	req := types.ParseDescriptor{
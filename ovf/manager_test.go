@@ -0,0 +1,84 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ovf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestAddMapping(t *testing.T) {
+	e := testEnvelope(t, "fixtures/ttylinux.ovf")
+
+	class := "vami"
+	def := ""
+	configurable := true
+	e.VirtualSystem.Product = append(e.VirtualSystem.Product, ProductSection{
+		Class: &class,
+		Property: []Property{
+			{Key: "hostname", UserConfigurable: &configurable, Default: &def},
+			{Key: "ip0", UserConfigurable: &configurable},
+		},
+	})
+
+	network := types.ManagedObjectReference{Type: "Network", Value: "network-1"}
+
+	tests := []struct {
+		name       string
+		networks   map[string]mo.Reference
+		properties map[string]string
+		err        []string
+	}{
+		{"empty", nil, nil, []string{`network "nat" is not mapped`, `required property "vami.ip0" is not set`}},
+		{"unknown", map[string]mo.Reference{"VM Network": network}, map[string]string{"ip0": "10.0.0.1"},
+			[]string{`unknown network "VM Network"`, `unknown property "ip0"`}},
+		{"valid", map[string]mo.Reference{"nat": network}, map[string]string{"vami.ip0": "10.0.0.1"}, nil},
+	}
+
+	for _, test := range tests {
+		var cisp types.OvfCreateImportSpecParams
+
+		err := addMapping(e, test.networks, test.properties, &cisp)
+		if test.err == nil {
+			if err != nil {
+				t.Errorf("%s: %s", test.name, err)
+				continue
+			}
+			if len(cisp.NetworkMapping) != 1 || cisp.NetworkMapping[0].Network != network.Reference() {
+				t.Errorf("%s: NetworkMapping=%#v", test.name, cisp.NetworkMapping)
+			}
+			if len(cisp.PropertyMapping) != 1 || cisp.PropertyMapping[0].Value != "10.0.0.1" {
+				t.Errorf("%s: PropertyMapping=%#v", test.name, cisp.PropertyMapping)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf("%s: expected error", test.name)
+			continue
+		}
+
+		for _, msg := range test.err {
+			if !strings.Contains(err.Error(), msg) {
+				t.Errorf("%s: %q does not contain %q", test.name, err, msg)
+			}
+		}
+	}
+}
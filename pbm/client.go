@@ -22,7 +22,9 @@ import (
 
 	"github.com/vmware/govmomi/pbm/methods"
 	"github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
 	vim "github.com/vmware/govmomi/vim25/types"
 )
@@ -45,6 +47,8 @@ type Client struct {
 	ServiceContent types.PbmServiceInstanceContent
 
 	RoundTripper soap.RoundTripper
+
+	vim25Client *vim25.Client
 }
 
 func NewClient(ctx context.Context, c *vim25.Client) (*Client, error) {
@@ -59,7 +63,7 @@ func NewClient(ctx context.Context, c *vim25.Client) (*Client, error) {
 		return nil, err
 	}
 
-	return &Client{sc, res.Returnval, sc}, nil
+	return &Client{sc, res.Returnval, sc, c}, nil
 }
 
 // RoundTrip dispatches to the RoundTripper field.
@@ -285,3 +289,127 @@ func (c *Client) GetProfileNameByID(ctx context.Context, profileID string) (stri
 	}
 	return "", fmt.Errorf("no pbm profile found with id: %q", profileID)
 }
+
+func (c *Client) QueryAssociatedProfiles(ctx context.Context, entities []types.PbmServerObjectRef) ([]types.PbmQueryProfileResult, error) {
+	req := types.PbmQueryAssociatedProfiles{
+		This:     c.ServiceContent.ProfileManager,
+		Entities: entities,
+	}
+
+	res, err := methods.PbmQueryAssociatedProfiles(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+func (c *Client) CheckCompliance(ctx context.Context, entities []types.PbmServerObjectRef, profile *types.PbmProfileId) (ComplianceResult, error) {
+	req := types.PbmCheckCompliance{
+		This:     c.ServiceContent.ComplianceManager,
+		Entities: entities,
+		Profile:  profile,
+	}
+
+	res, err := methods.PbmCheckCompliance(ctx, c, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// VirtualMachineObjectRefs returns the PbmServerObjectRef entries for the given VM's home directory and each of its virtual disks.
+func (c *Client) VirtualMachineObjectRefs(ctx context.Context, vm vim.ManagedObjectReference) ([]types.PbmServerObjectRef, error) {
+	if c.vim25Client == nil {
+		return nil, fmt.Errorf("pbm client was not created with NewClient")
+	}
+
+	var props mo.VirtualMachine
+	pc := property.DefaultCollector(c.vim25Client)
+	err := pc.RetrieveOne(ctx, vm, []string{"config.hardware.device"}, &props)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []types.PbmServerObjectRef{{
+		ObjectType: string(types.PbmObjectTypeVirtualMachine),
+		Key:        vm.Value,
+	}}
+
+	if props.Config == nil {
+		return refs, nil
+	}
+
+	for _, device := range props.Config.Hardware.Device {
+		if disk, ok := device.(*vim.VirtualDisk); ok {
+			refs = append(refs, types.PbmServerObjectRef{
+				ObjectType: string(types.PbmObjectTypeVirtualDiskId),
+				Key:        fmt.Sprintf("%s:%d", vm.Value, disk.Key),
+			})
+		}
+	}
+
+	return refs, nil
+}
+
+// CheckVMCompliance checks the storage policy compliance of the given VM's home directory and virtual disks.
+// Objects that have no associated storage policy are not checked,
+// an empty result is returned if the VM has no associated storage policy at all.
+func (c *Client) CheckVMCompliance(ctx context.Context, vm vim.ManagedObjectReference) (ComplianceResult, error) {
+	refs, err := c.VirtualMachineObjectRefs(ctx, vm)
+	if err != nil {
+		return nil, err
+	}
+
+	associated, err := c.QueryAssociatedProfiles(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []types.PbmServerObjectRef
+
+	for _, res := range associated {
+		if len(res.ProfileId) != 0 {
+			entities = append(entities, res.Object)
+		}
+	}
+
+	if len(entities) == 0 {
+		return nil, nil
+	}
+
+	return c.CheckCompliance(ctx, entities, nil)
+}
+
+// ComplianceResult is a list of compliance results as returned by CheckCompliance.
+type ComplianceResult []types.PbmComplianceResult
+
+// ByEntity returns the compliance results keyed by PbmServerObjectRef.Key
+func (l ComplianceResult) ByEntity() map[string]types.PbmComplianceResult {
+	results := make(map[string]types.PbmComplianceResult, len(l))
+
+	for _, res := range l {
+		results[res.Entity.Key] = res
+	}
+
+	return results
+}
+
+// ComplianceStatusString returns a human readable form of the given PbmComplianceStatus value.
+func ComplianceStatusString(status string) string {
+	switch types.PbmComplianceStatus(status) {
+	case types.PbmComplianceStatusCompliant:
+		return "Compliant"
+	case types.PbmComplianceStatusNonCompliant:
+		return "Noncompliant"
+	case types.PbmComplianceStatusUnknown:
+		return "Unknown"
+	case types.PbmComplianceStatusNotApplicable:
+		return "Not applicable"
+	case types.PbmComplianceStatusOutOfDate:
+		return "Out of date"
+	}
+
+	return status
+}
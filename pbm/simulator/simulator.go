@@ -69,6 +69,10 @@ func New() *simulator.Registry {
 		ManagedObjectReference: content.PlacementSolver,
 	})
 
+	r.Put(&ComplianceManager{
+		ManagedObjectReference: content.ComplianceManager,
+	})
+
 	return r
 }
 
@@ -126,6 +130,19 @@ func (m *ProfileManager) PbmQueryAssociatedProfile(req *types.PbmQueryAssociated
 	return body
 }
 
+func (m *ProfileManager) PbmQueryAssociatedProfiles(req *types.PbmQueryAssociatedProfiles) soap.HasFault {
+	body := new(methods.PbmQueryAssociatedProfilesBody)
+	body.Res = new(types.PbmQueryAssociatedProfilesResponse)
+
+	for _, ref := range req.Entities {
+		body.Res.Returnval = append(body.Res.Returnval, types.PbmQueryProfileResult{
+			Object: ref,
+		})
+	}
+
+	return body
+}
+
 func (m *ProfileManager) PbmRetrieveContent(req *types.PbmRetrieveContent) soap.HasFault {
 	body := new(methods.PbmRetrieveContentBody)
 	if len(req.ProfileIds) == 0 {
@@ -235,3 +252,23 @@ func (m *PlacementSolver) PbmCheckRequirements(req *types.PbmCheckRequirements)
 
 	return body
 }
+
+type ComplianceManager struct {
+	vim.ManagedObjectReference
+}
+
+func (m *ComplianceManager) PbmCheckCompliance(req *types.PbmCheckCompliance) soap.HasFault {
+	body := new(methods.PbmCheckComplianceBody)
+	body.Res = new(types.PbmCheckComplianceResponse)
+
+	for _, ref := range req.Entities {
+		body.Res.Returnval = append(body.Res.Returnval, types.PbmComplianceResult{
+			CheckTime:        time.Now(),
+			Entity:           ref,
+			Profile:          req.Profile,
+			ComplianceStatus: string(types.PbmComplianceStatusCompliant),
+		})
+	}
+
+	return body
+}
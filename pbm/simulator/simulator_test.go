@@ -29,6 +29,7 @@ import (
 	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
 	vim "github.com/vmware/govmomi/vim25/types"
 )
@@ -313,3 +314,52 @@ func TestSimulator(t *testing.T) {
 	}
 	t.Logf("Profile: %+v successfully deleted", []types.PbmProfileId{*vsanProfileID, *vsansiocProfileID})
 }
+
+func TestCheckVMCompliance(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pc, err := pbm.NewClient(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+
+		refs, err := pc.VirtualMachineObjectRefs(ctx, vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// VM home + 1 disk
+		if len(refs) != 2 {
+			t.Errorf("refs=%d", len(refs))
+		}
+
+		for _, ref := range refs[1:] {
+			if ref.ObjectType != string(types.PbmObjectTypeVirtualDiskId) {
+				t.Errorf("type=%s", ref.ObjectType)
+			}
+		}
+
+		// No policy is associated with the VM or its disks
+		res, err := pc.CheckVMCompliance(ctx, vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(res) != 0 {
+			t.Errorf("res=%d", len(res))
+		}
+
+		res, err = pc.CheckCompliance(ctx, refs, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, ref := range refs {
+			status := res.ByEntity()[ref.Key].ComplianceStatus
+			if pbm.ComplianceStatusString(status) != "Compliant" {
+				t.Errorf("%s status=%s", ref.Key, status)
+			}
+		}
+	})
+}
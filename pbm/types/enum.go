@@ -17,7 +17,9 @@ limitations under the License.
 package types
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -30,6 +32,23 @@ const (
 	PbmAssociateAndApplyPolicyStatusPolicyStatusInvalid = PbmAssociateAndApplyPolicyStatusPolicyStatus("invalid")
 )
 
+func (e PbmAssociateAndApplyPolicyStatusPolicyStatus) String() string {
+	return string(e)
+}
+
+func ParsePbmAssociateAndApplyPolicyStatusPolicyStatus(s string) (PbmAssociateAndApplyPolicyStatusPolicyStatus, error) {
+	for _, e := range []PbmAssociateAndApplyPolicyStatusPolicyStatus{
+		PbmAssociateAndApplyPolicyStatusPolicyStatusSuccess,
+		PbmAssociateAndApplyPolicyStatusPolicyStatusFailed,
+		PbmAssociateAndApplyPolicyStatusPolicyStatusInvalid,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmAssociateAndApplyPolicyStatusPolicyStatus: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmAssociateAndApplyPolicyStatusPolicyStatus", reflect.TypeOf((*PbmAssociateAndApplyPolicyStatusPolicyStatus)(nil)).Elem())
 }
@@ -41,6 +60,22 @@ const (
 	PbmBuiltinGenericTypeVMW_SET   = PbmBuiltinGenericType("VMW_SET")
 )
 
+func (e PbmBuiltinGenericType) String() string {
+	return string(e)
+}
+
+func ParsePbmBuiltinGenericType(s string) (PbmBuiltinGenericType, error) {
+	for _, e := range []PbmBuiltinGenericType{
+		PbmBuiltinGenericTypeVMW_RANGE,
+		PbmBuiltinGenericTypeVMW_SET,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmBuiltinGenericType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmBuiltinGenericType", reflect.TypeOf((*PbmBuiltinGenericType)(nil)).Elem())
 }
@@ -60,6 +95,30 @@ const (
 	PbmBuiltinTypeVMW_POLICY   = PbmBuiltinType("VMW_POLICY")
 )
 
+func (e PbmBuiltinType) String() string {
+	return string(e)
+}
+
+func ParsePbmBuiltinType(s string) (PbmBuiltinType, error) {
+	for _, e := range []PbmBuiltinType{
+		PbmBuiltinTypeXSD_LONG,
+		PbmBuiltinTypeXSD_SHORT,
+		PbmBuiltinTypeXSD_INTEGER,
+		PbmBuiltinTypeXSD_INT,
+		PbmBuiltinTypeXSD_STRING,
+		PbmBuiltinTypeXSD_BOOLEAN,
+		PbmBuiltinTypeXSD_DOUBLE,
+		PbmBuiltinTypeXSD_DATETIME,
+		PbmBuiltinTypeVMW_TIMESPAN,
+		PbmBuiltinTypeVMW_POLICY,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmBuiltinType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmBuiltinType", reflect.TypeOf((*PbmBuiltinType)(nil)).Elem())
 }
@@ -70,6 +129,21 @@ const (
 	PbmCapabilityOperatorNOT = PbmCapabilityOperator("NOT")
 )
 
+func (e PbmCapabilityOperator) String() string {
+	return string(e)
+}
+
+func ParsePbmCapabilityOperator(s string) (PbmCapabilityOperator, error) {
+	for _, e := range []PbmCapabilityOperator{
+		PbmCapabilityOperatorNOT,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmCapabilityOperator: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmCapabilityOperator", reflect.TypeOf((*PbmCapabilityOperator)(nil)).Elem())
 }
@@ -86,6 +160,27 @@ const (
 	PbmCapabilityTimeUnitTypeYEARS   = PbmCapabilityTimeUnitType("YEARS")
 )
 
+func (e PbmCapabilityTimeUnitType) String() string {
+	return string(e)
+}
+
+func ParsePbmCapabilityTimeUnitType(s string) (PbmCapabilityTimeUnitType, error) {
+	for _, e := range []PbmCapabilityTimeUnitType{
+		PbmCapabilityTimeUnitTypeSECONDS,
+		PbmCapabilityTimeUnitTypeMINUTES,
+		PbmCapabilityTimeUnitTypeHOURS,
+		PbmCapabilityTimeUnitTypeDAYS,
+		PbmCapabilityTimeUnitTypeWEEKS,
+		PbmCapabilityTimeUnitTypeMONTHS,
+		PbmCapabilityTimeUnitTypeYEARS,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmCapabilityTimeUnitType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmCapabilityTimeUnitType", reflect.TypeOf((*PbmCapabilityTimeUnitType)(nil)).Elem())
 }
@@ -98,6 +193,23 @@ const (
 	PbmComplianceResultComplianceTaskStatusFailed     = PbmComplianceResultComplianceTaskStatus("failed")
 )
 
+func (e PbmComplianceResultComplianceTaskStatus) String() string {
+	return string(e)
+}
+
+func ParsePbmComplianceResultComplianceTaskStatus(s string) (PbmComplianceResultComplianceTaskStatus, error) {
+	for _, e := range []PbmComplianceResultComplianceTaskStatus{
+		PbmComplianceResultComplianceTaskStatusInProgress,
+		PbmComplianceResultComplianceTaskStatusSuccess,
+		PbmComplianceResultComplianceTaskStatusFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmComplianceResultComplianceTaskStatus: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmComplianceResultComplianceTaskStatus", reflect.TypeOf((*PbmComplianceResultComplianceTaskStatus)(nil)).Elem())
 }
@@ -112,6 +224,25 @@ const (
 	PbmComplianceStatusOutOfDate     = PbmComplianceStatus("outOfDate")
 )
 
+func (e PbmComplianceStatus) String() string {
+	return string(e)
+}
+
+func ParsePbmComplianceStatus(s string) (PbmComplianceStatus, error) {
+	for _, e := range []PbmComplianceStatus{
+		PbmComplianceStatusCompliant,
+		PbmComplianceStatusNonCompliant,
+		PbmComplianceStatusUnknown,
+		PbmComplianceStatusNotApplicable,
+		PbmComplianceStatusOutOfDate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmComplianceStatus: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmComplianceStatus", reflect.TypeOf((*PbmComplianceStatus)(nil)).Elem())
 }
@@ -125,6 +256,24 @@ const (
 	PbmHealthStatusForEntityUnknown = PbmHealthStatusForEntity("unknown")
 )
 
+func (e PbmHealthStatusForEntity) String() string {
+	return string(e)
+}
+
+func ParsePbmHealthStatusForEntity(s string) (PbmHealthStatusForEntity, error) {
+	for _, e := range []PbmHealthStatusForEntity{
+		PbmHealthStatusForEntityRed,
+		PbmHealthStatusForEntityYellow,
+		PbmHealthStatusForEntityGreen,
+		PbmHealthStatusForEntityUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmHealthStatusForEntity: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmHealthStatusForEntity", reflect.TypeOf((*PbmHealthStatusForEntity)(nil)).Elem())
 }
@@ -141,6 +290,27 @@ const (
 	PbmIofilterInfoFilterTypeDATASTOREIOCONTROL = PbmIofilterInfoFilterType("DATASTOREIOCONTROL")
 )
 
+func (e PbmIofilterInfoFilterType) String() string {
+	return string(e)
+}
+
+func ParsePbmIofilterInfoFilterType(s string) (PbmIofilterInfoFilterType, error) {
+	for _, e := range []PbmIofilterInfoFilterType{
+		PbmIofilterInfoFilterTypeINSPECTION,
+		PbmIofilterInfoFilterTypeCOMPRESSION,
+		PbmIofilterInfoFilterTypeENCRYPTION,
+		PbmIofilterInfoFilterTypeREPLICATION,
+		PbmIofilterInfoFilterTypeCACHE,
+		PbmIofilterInfoFilterTypeDATAPROVIDER,
+		PbmIofilterInfoFilterTypeDATASTOREIOCONTROL,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmIofilterInfoFilterType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmIofilterInfoFilterType", reflect.TypeOf((*PbmIofilterInfoFilterType)(nil)).Elem())
 }
@@ -159,6 +329,29 @@ const (
 	PbmLineOfServiceInfoLineOfServiceEnumDATA_PROTECTION      = PbmLineOfServiceInfoLineOfServiceEnum("DATA_PROTECTION")
 )
 
+func (e PbmLineOfServiceInfoLineOfServiceEnum) String() string {
+	return string(e)
+}
+
+func ParsePbmLineOfServiceInfoLineOfServiceEnum(s string) (PbmLineOfServiceInfoLineOfServiceEnum, error) {
+	for _, e := range []PbmLineOfServiceInfoLineOfServiceEnum{
+		PbmLineOfServiceInfoLineOfServiceEnumINSPECTION,
+		PbmLineOfServiceInfoLineOfServiceEnumCOMPRESSION,
+		PbmLineOfServiceInfoLineOfServiceEnumENCRYPTION,
+		PbmLineOfServiceInfoLineOfServiceEnumREPLICATION,
+		PbmLineOfServiceInfoLineOfServiceEnumCACHING,
+		PbmLineOfServiceInfoLineOfServiceEnumPERSISTENCE,
+		PbmLineOfServiceInfoLineOfServiceEnumDATA_PROVIDER,
+		PbmLineOfServiceInfoLineOfServiceEnumDATASTORE_IO_CONTROL,
+		PbmLineOfServiceInfoLineOfServiceEnumDATA_PROTECTION,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmLineOfServiceInfoLineOfServiceEnum: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmLineOfServiceInfoLineOfServiceEnum", reflect.TypeOf((*PbmLineOfServiceInfoLineOfServiceEnum)(nil)).Elem())
 }
@@ -175,6 +368,27 @@ const (
 	PbmObjectTypeUnknown                = PbmObjectType("unknown")
 )
 
+func (e PbmObjectType) String() string {
+	return string(e)
+}
+
+func ParsePbmObjectType(s string) (PbmObjectType, error) {
+	for _, e := range []PbmObjectType{
+		PbmObjectTypeVirtualMachine,
+		PbmObjectTypeVirtualMachineAndDisks,
+		PbmObjectTypeVirtualDiskId,
+		PbmObjectTypeVirtualDiskUUID,
+		PbmObjectTypeDatastore,
+		PbmObjectTypeFileShareId,
+		PbmObjectTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmObjectType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmObjectType", reflect.TypeOf((*PbmObjectType)(nil)).Elem())
 }
@@ -189,6 +403,25 @@ const (
 	PbmOperationCLONE       = PbmOperation("CLONE")
 )
 
+func (e PbmOperation) String() string {
+	return string(e)
+}
+
+func ParsePbmOperation(s string) (PbmOperation, error) {
+	for _, e := range []PbmOperation{
+		PbmOperationCREATE,
+		PbmOperationREGISTER,
+		PbmOperationRECONFIGURE,
+		PbmOperationMIGRATE,
+		PbmOperationCLONE,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmOperation: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmOperation", reflect.TypeOf((*PbmOperation)(nil)).Elem())
 }
@@ -201,6 +434,23 @@ const (
 	PbmProfileCategoryEnumDATA_SERVICE_POLICY = PbmProfileCategoryEnum("DATA_SERVICE_POLICY")
 )
 
+func (e PbmProfileCategoryEnum) String() string {
+	return string(e)
+}
+
+func ParsePbmProfileCategoryEnum(s string) (PbmProfileCategoryEnum, error) {
+	for _, e := range []PbmProfileCategoryEnum{
+		PbmProfileCategoryEnumREQUIREMENT,
+		PbmProfileCategoryEnumRESOURCE,
+		PbmProfileCategoryEnumDATA_SERVICE_POLICY,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmProfileCategoryEnum: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmProfileCategoryEnum", reflect.TypeOf((*PbmProfileCategoryEnum)(nil)).Elem())
 }
@@ -211,6 +461,21 @@ const (
 	PbmProfileResourceTypeEnumSTORAGE = PbmProfileResourceTypeEnum("STORAGE")
 )
 
+func (e PbmProfileResourceTypeEnum) String() string {
+	return string(e)
+}
+
+func ParsePbmProfileResourceTypeEnum(s string) (PbmProfileResourceTypeEnum, error) {
+	for _, e := range []PbmProfileResourceTypeEnum{
+		PbmProfileResourceTypeEnumSTORAGE,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmProfileResourceTypeEnum: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmProfileResourceTypeEnum", reflect.TypeOf((*PbmProfileResourceTypeEnum)(nil)).Elem())
 }
@@ -223,6 +488,23 @@ const (
 	PbmSystemCreatedProfileTypePmemDefaultProfile = PbmSystemCreatedProfileType("PmemDefaultProfile")
 )
 
+func (e PbmSystemCreatedProfileType) String() string {
+	return string(e)
+}
+
+func ParsePbmSystemCreatedProfileType(s string) (PbmSystemCreatedProfileType, error) {
+	for _, e := range []PbmSystemCreatedProfileType{
+		PbmSystemCreatedProfileTypeVsanDefaultProfile,
+		PbmSystemCreatedProfileTypeVVolDefaultProfile,
+		PbmSystemCreatedProfileTypePmemDefaultProfile,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmSystemCreatedProfileType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmSystemCreatedProfileType", reflect.TypeOf((*PbmSystemCreatedProfileType)(nil)).Elem())
 }
@@ -236,6 +518,24 @@ const (
 	PbmVmOperationCLONE       = PbmVmOperation("CLONE")
 )
 
+func (e PbmVmOperation) String() string {
+	return string(e)
+}
+
+func ParsePbmVmOperation(s string) (PbmVmOperation, error) {
+	for _, e := range []PbmVmOperation{
+		PbmVmOperationCREATE,
+		PbmVmOperationRECONFIGURE,
+		PbmVmOperationMIGRATE,
+		PbmVmOperationCLONE,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmVmOperation: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmVmOperation", reflect.TypeOf((*PbmVmOperation)(nil)).Elem())
 }
@@ -248,6 +548,23 @@ const (
 	PbmVvolTypeSwap   = PbmVvolType("Swap")
 )
 
+func (e PbmVvolType) String() string {
+	return string(e)
+}
+
+func ParsePbmVvolType(s string) (PbmVvolType, error) {
+	for _, e := range []PbmVvolType{
+		PbmVvolTypeConfig,
+		PbmVvolTypeData,
+		PbmVvolTypeSwap,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PbmVvolType: %q", s)
+}
+
 func init() {
 	types.Add("pbm:PbmVvolType", reflect.TypeOf((*PbmVvolType)(nil)).Elem())
 }
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property
+
+import (
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type cacheKey struct {
+	obj  types.ManagedObjectReference
+	path string
+}
+
+type cacheEntry struct {
+	val     types.AnyType
+	set     bool // false if the property has no value
+	expires time.Time
+}
+
+// cache is a read-through cache of property values keyed by (object, property path).
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[cacheKey]cacheEntry
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[cacheKey]cacheEntry),
+	}
+}
+
+// get returns the cached content for the given objects and properties,
+// only if every property of every object is cached and has not expired.
+func (c *cache) get(objs []types.ManagedObjectReference, ps []string) ([]types.ObjectContent, bool) {
+	now := time.Now()
+	content := make([]types.ObjectContent, 0, len(objs))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, obj := range objs {
+		oc := types.ObjectContent{Obj: obj}
+
+		for _, path := range ps {
+			e, ok := c.entries[cacheKey{obj, path}]
+			if !ok || now.After(e.expires) {
+				return nil, false
+			}
+			if e.set {
+				oc.PropSet = append(oc.PropSet, types.DynamicProperty{Name: path, Val: e.val})
+			}
+		}
+
+		content = append(content, oc)
+	}
+
+	return content, true
+}
+
+// put caches the given properties of each object in content.
+// Objects with a MissingSet are not cached.
+func (c *cache) put(content []types.ObjectContent, ps []string) {
+	expires := time.Now().Add(c.ttl)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, oc := range content {
+		if len(oc.MissingSet) != 0 {
+			continue
+		}
+
+		for _, path := range ps {
+			e := cacheEntry{expires: expires}
+
+			for _, prop := range oc.PropSet {
+				if prop.Name == path {
+					e.val = prop.Val
+					e.set = true
+					break
+				}
+			}
+
+			c.entries[cacheKey{oc.Obj, path}] = e
+		}
+	}
+}
+
+func (c *cache) invalidate(objs []types.ManagedObjectReference) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(objs) == 0 {
+		c.entries = make(map[cacheKey]cacheEntry)
+		return
+	}
+
+	remove := make(map[types.ManagedObjectReference]bool, len(objs))
+	for _, obj := range objs {
+		remove[obj] = true
+	}
+
+	for key := range c.entries {
+		if remove[key.obj] {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// Cache enables a read-through cache of property values for Retrieve calls made via this Collector,
+// including those made by RetrieveOne and RetrieveWithFilter.
+// Values are cached per (object, property path) and are reused until the given ttl has elapsed.
+// Requests for all properties (nil property list) are never cached.
+// Cached values are shared between callers and must be treated as read-only.
+// The cache is disabled by default, as cached values can be stale for up to ttl; a ttl <= 0 disables it.
+// Caching should be configured before the Collector is used concurrently.
+func (p *Collector) Cache(ttl time.Duration) {
+	if ttl > 0 {
+		p.cache = newCache(ttl)
+	} else {
+		p.cache = nil
+	}
+}
+
+// InvalidateCache removes the cached properties of the given objects, or of all objects if none are given.
+func (p *Collector) InvalidateCache(objs ...types.ManagedObjectReference) {
+	if p.cache != nil {
+		p.cache.invalidate(objs)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+func TestCollectorCache(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		rt := &slowRoundTripper{RoundTripper: c.RoundTripper}
+		c.RoundTripper = rt
+
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+
+		pc := property.DefaultCollector(c)
+		pc.Cache(time.Hour)
+
+		props := []string{"name", "config.hardware", "parentVApp"}
+
+		for i := 0; i < 3; i++ {
+			var content mo.VirtualMachine
+			err := pc.RetrieveOne(ctx, vm, props, &content)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if content.Name == "" || content.Config == nil || content.Config.Hardware.NumCPU == 0 {
+				t.Errorf("content=%#v", content)
+			}
+			if content.ParentVApp != nil {
+				t.Errorf("parentVApp=%s", content.ParentVApp)
+			}
+		}
+
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 1 {
+			t.Errorf("calls=%d", n)
+		}
+
+		// Retrieving all properties bypasses the cache
+		var content mo.VirtualMachine
+		if err := pc.RetrieveOne(ctx, vm, nil, &content); err != nil {
+			t.Fatal(err)
+		}
+
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 1 {
+			t.Errorf("calls=%d", n)
+		}
+
+		pc.InvalidateCache(vm)
+
+		if err := pc.RetrieveOne(ctx, vm, props, &content); err != nil {
+			t.Fatal(err)
+		}
+
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 1 {
+			t.Errorf("calls=%d", n)
+		}
+
+		pc.Cache(time.Nanosecond)
+		time.Sleep(time.Millisecond)
+
+		for i := 0; i < 2; i++ {
+			if err := pc.RetrieveOne(ctx, vm, props, &content); err != nil {
+				t.Fatal(err)
+			}
+			time.Sleep(time.Millisecond)
+		}
+
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 2 {
+			t.Errorf("expired calls=%d", n)
+		}
+	})
+}
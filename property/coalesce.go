@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+// call is an in-flight or completed RetrieveProperties round trip.
+type call struct {
+	done chan struct{}
+	res  *types.RetrievePropertiesResponse
+	err  error
+}
+
+// flight coalesces identical concurrent RetrieveProperties requests,
+// such that only one round trip is made and its response is shared with all callers.
+type flight struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+func newFlight() *flight {
+	return &flight{calls: make(map[string]*call)}
+}
+
+func (f *flight) do(ctx context.Context, req *types.RetrieveProperties, fn func() (*types.RetrievePropertiesResponse, error)) (*types.RetrievePropertiesResponse, error) {
+	b, err := xml.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	key := string(b)
+
+	f.mu.Lock()
+	if c, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+
+		select {
+		case <-c.done:
+			return c.res, c.err
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	c := &call{done: make(chan struct{})}
+	f.calls[key] = c
+	f.mu.Unlock()
+
+	c.res, c.err = fn()
+
+	f.mu.Lock()
+	delete(f.calls, key)
+	f.mu.Unlock()
+
+	close(c.done)
+
+	return c.res, c.err
+}
+
+// Coalesce enables or disables coalescing of identical concurrent RetrieveProperties calls made via this Collector,
+// including those made by Retrieve, RetrieveOne and RetrieveWithFilter.
+// When enabled, callers that issue a request identical to one already in flight wait for and share its response,
+// rather than making another round trip. Shared responses must be treated as read-only.
+// An error from the shared round trip, including cancellation of the context of the caller that issued it,
+// is returned to all callers waiting on that round trip.
+// Coalescing is disabled by default and should be configured before the Collector is used concurrently.
+func (p *Collector) Coalesce(enable bool) {
+	if enable {
+		if p.flight == nil {
+			p.flight = newFlight()
+		}
+	} else {
+		p.flight = nil
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// slowRoundTripper counts RetrieveProperties calls and delays their response
+type slowRoundTripper struct {
+	soap.RoundTripper
+	calls int32
+}
+
+func (rt *slowRoundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	if _, ok := req.(*methods.RetrievePropertiesBody); ok {
+		atomic.AddInt32(&rt.calls, 1)
+		time.Sleep(100 * time.Millisecond)
+	}
+	return rt.RoundTripper.RoundTrip(ctx, req, res)
+}
+
+func TestCollectorCoalesce(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		rt := &slowRoundTripper{RoundTripper: c.RoundTripper}
+		c.RoundTripper = rt
+
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+
+		retrieve := func(pc *property.Collector, n int) {
+			var wg sync.WaitGroup
+
+			for i := 0; i < n; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					var props mo.VirtualMachine
+					if err := pc.RetrieveOne(ctx, vm, []string{"name"}, &props); err != nil {
+						t.Error(err)
+					}
+					if props.Name == "" {
+						t.Error("empty name")
+					}
+				}()
+			}
+
+			wg.Wait()
+		}
+
+		pc := property.DefaultCollector(c)
+
+		retrieve(pc, 5)
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 5 {
+			t.Errorf("calls=%d", n)
+		}
+
+		pc.Coalesce(true)
+
+		retrieve(pc, 5)
+		if n := atomic.SwapInt32(&rt.calls, 0); n != 1 {
+			t.Errorf("coalesced calls=%d", n)
+		}
+	})
+}
@@ -19,6 +19,8 @@ package property
 import (
 	"context"
 	"errors"
+	"sort"
+	"sync"
 
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
@@ -35,6 +37,8 @@ import (
 type Collector struct {
 	roundTripper soap.RoundTripper
 	reference    types.ManagedObjectReference
+	flight       *flight
+	cache        *cache
 }
 
 // DefaultCollector returns the session's default property collector.
@@ -125,6 +129,13 @@ func (p *Collector) CancelWaitForUpdates(ctx context.Context) error {
 
 func (p *Collector) RetrieveProperties(ctx context.Context, req types.RetrieveProperties) (*types.RetrievePropertiesResponse, error) {
 	req.This = p.Reference()
+
+	if p.flight != nil {
+		return p.flight.do(ctx, &req, func() (*types.RetrievePropertiesResponse, error) {
+			return methods.RetrieveProperties(ctx, p.roundTripper, &req)
+		})
+	}
+
 	return methods.RetrieveProperties(ctx, p.roundTripper, &req)
 }
 
@@ -139,6 +150,23 @@ func (p *Collector) Retrieve(ctx context.Context, objs []types.ManagedObjectRefe
 		return errors.New("object references is empty")
 	}
 
+	cached := p.cache != nil && len(ps) != 0
+
+	load := func(content []types.ObjectContent) error {
+		if d, ok := dst.(*[]types.ObjectContent); ok {
+			*d = content
+			return nil
+		}
+
+		return mo.LoadObjectContent(content, dst)
+	}
+
+	if cached {
+		if content, ok := p.cache.get(objs, ps); ok {
+			return load(content)
+		}
+	}
+
 	kinds := make(map[string]bool)
 
 	var propSet []types.PropertySpec
@@ -180,12 +208,11 @@ func (p *Collector) Retrieve(ctx context.Context, objs []types.ManagedObjectRefe
 		return err
 	}
 
-	if d, ok := dst.(*[]types.ObjectContent); ok {
-		*d = res.Returnval
-		return nil
+	if cached {
+		p.cache.put(res.Returnval, ps)
 	}
 
-	return mo.LoadObjectContent(res.Returnval, dst)
+	return load(res.Returnval)
 }
 
 // RetrieveWithFilter populates dst as Retrieve does, but only for entities matching the given filter.
@@ -210,6 +237,144 @@ func (p *Collector) RetrieveWithFilter(ctx context.Context, objs []types.Managed
 	return p.Retrieve(ctx, objs, ps, dst)
 }
 
+// maxRetrieveBatch is the maximum number of objects retrieved per call by RetrieveBatch.
+const maxRetrieveBatch = 500
+
+// RetrieveBatch populates dst as Retrieve does, splitting refs into batches that are retrieved in parallel,
+// using at most concurrency RetrieveProperties calls at a time.
+// The results are loaded into dst in the same order as refs.
+// If any batch fails, the remaining batches are canceled and the first error is returned.
+func (p *Collector) RetrieveBatch(ctx context.Context, refs []types.ManagedObjectReference, ps []string, dst interface{}, concurrency int) error {
+	if len(refs) == 0 {
+		return errors.New("object references is empty")
+	}
+
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	size := (len(refs) + concurrency - 1) / concurrency
+	if size > maxRetrieveBatch {
+		size = maxRetrieveBatch
+	}
+
+	var batches [][]types.ManagedObjectReference
+	for i := 0; i < len(refs); i += size {
+		end := i + size
+		if end > len(refs) {
+			end = len(refs)
+		}
+		batches = append(batches, refs[i:end])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([][]types.ObjectContent, len(batches))
+	jobs := make(chan int)
+	errs := make(chan error, len(batches))
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < concurrency && i < len(batches); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				if err := p.Retrieve(ctx, batches[j], ps, &results[j]); err != nil {
+					errs <- err
+					cancel()
+				}
+			}
+		}()
+	}
+
+	for i := range batches {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+		}
+	}
+
+	close(jobs)
+	wg.Wait()
+	close(errs)
+
+	if err := <-errs; err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	order := make(map[types.ManagedObjectReference]int, len(refs))
+	for i, ref := range refs {
+		if _, ok := order[ref]; !ok {
+			order[ref] = i
+		}
+	}
+
+	var content []types.ObjectContent
+	for i := range results {
+		content = append(content, results[i]...)
+	}
+
+	sort.SliceStable(content, func(i, j int) bool {
+		return order[content[i].Obj] < order[content[j].Obj]
+	})
+
+	if d, ok := dst.(*[]types.ObjectContent); ok {
+		*d = content
+		return nil
+	}
+
+	return mo.LoadObjectContent(content, dst)
+}
+
+// RetrieveFiltered populates dst as Retrieve does, with the objects and properties selected by the given FilterSpec.
+// Results are retrieved using RetrievePropertiesEx, calling ContinueRetrievePropertiesEx until all pages are retrieved.
+func (p *Collector) RetrieveFiltered(ctx context.Context, spec *FilterSpec, dst interface{}) error {
+	req := types.RetrievePropertiesEx{
+		This:    p.Reference(),
+		SpecSet: []types.PropertyFilterSpec{spec.PropertyFilterSpec},
+		Options: types.RetrieveOptions{MaxObjects: spec.MaxObjects},
+	}
+
+	res, err := methods.RetrievePropertiesEx(ctx, p.roundTripper, &req)
+	if err != nil {
+		return err
+	}
+
+	if res.Returnval == nil {
+		return nil // no objects matched
+	}
+
+	content := res.Returnval.Objects
+	token := res.Returnval.Token
+
+	for token != "" {
+		req := types.ContinueRetrievePropertiesEx{
+			This:  p.Reference(),
+			Token: token,
+		}
+
+		res, err := methods.ContinueRetrievePropertiesEx(ctx, p.roundTripper, &req)
+		if err != nil {
+			return err
+		}
+
+		content = append(content, res.Returnval.Objects...)
+		token = res.Returnval.Token
+	}
+
+	if d, ok := dst.(*[]types.ObjectContent); ok {
+		*d = content
+		return nil
+	}
+
+	return mo.LoadObjectContent(content, dst)
+}
+
 // RetrieveOne calls Retrieve with a single managed object reference via Collector.Retrieve().
 func (p *Collector) RetrieveOne(ctx context.Context, obj types.ManagedObjectReference, ps []string, dst interface{}) error {
 	var objs = []types.ManagedObjectReference{obj}
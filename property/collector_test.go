@@ -0,0 +1,141 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestCollectorRetrieveBatch(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pc := property.DefaultCollector(c)
+
+		var refs []types.ManagedObjectReference
+		for _, kind := range []string{"VirtualMachine", "HostSystem", "Datastore"} {
+			for _, obj := range simulator.Map.All(kind) {
+				refs = append(refs, obj.Reference())
+			}
+		}
+
+		for _, concurrency := range []int{0, 1, 3, 100} {
+			var content []types.ObjectContent
+			err := pc.RetrieveBatch(ctx, refs, []string{"name"}, &content, concurrency)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(content) != len(refs) {
+				t.Fatalf("%d: content=%d", concurrency, len(content))
+			}
+			for i := range refs {
+				if content[i].Obj != refs[i] {
+					t.Errorf("%d: %d=%s", concurrency, i, content[i].Obj)
+				}
+			}
+		}
+
+		var vms []mo.VirtualMachine
+		err := pc.RetrieveBatch(ctx, refs[:2], []string{"name"}, &vms, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(vms) != 2 || vms[0].Name == "" {
+			t.Errorf("vms=%v", vms)
+		}
+
+		invalid := append(refs, types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"})
+		err = pc.RetrieveBatch(ctx, invalid, []string{"name"}, &vms, 2)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		err = pc.RetrieveBatch(ctx, nil, []string{"name"}, &vms, 2)
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+// latencyRoundTripper adds the given latency to RetrieveProperties calls
+type latencyRoundTripper struct {
+	soap.RoundTripper
+	latency time.Duration
+}
+
+func (rt *latencyRoundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	if _, ok := req.(*methods.RetrievePropertiesBody); ok {
+		time.Sleep(rt.latency)
+	}
+	return rt.RoundTripper.RoundTrip(ctx, req, res)
+}
+
+// BenchmarkRetrieveBatch compares retrieving the properties of 5000 VMs one-by-one, as a serial
+// RetrieveOne call per VM, with RetrieveBatch, with network latency added to each RetrieveProperties call.
+// Note that creating the simulator inventory takes several minutes.
+func BenchmarkRetrieveBatch(b *testing.B) {
+	model := simulator.VPX()
+	model.Host = 0
+	model.Cluster = 10
+	model.ClusterHost = 1
+	model.Machine = 500
+
+	model.Run(func(ctx context.Context, c *vim25.Client) error {
+		c.RoundTripper = &latencyRoundTripper{RoundTripper: c.RoundTripper, latency: time.Millisecond}
+		pc := property.DefaultCollector(c)
+
+		var refs []types.ManagedObjectReference
+		for _, obj := range simulator.Map.All("VirtualMachine") {
+			refs = append(refs, obj.Reference())
+		}
+		ps := []string{"name", "runtime.powerState", "config.hardware.numCPU"}
+
+		b.Run("RetrieveOne", func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				vms := make([]mo.VirtualMachine, len(refs))
+				for j, ref := range refs {
+					if err := pc.RetrieveOne(ctx, ref, ps, &vms[j]); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+
+		for _, concurrency := range []int{1, 4, 16} {
+			b.Run(fmt.Sprintf("RetrieveBatch-%d", concurrency), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					var vms []mo.VirtualMachine
+					if err := pc.RetrieveBatch(ctx, refs, ps, &vms, concurrency); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+
+		return nil
+	})
+}
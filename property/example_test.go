@@ -19,6 +19,8 @@ package property_test
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/vmware/govmomi/find"
@@ -117,3 +119,48 @@ func ExampleWait() {
 	// poweredOn
 	// poweredOff
 }
+
+// Example to retrieve properties of all VMs in a Datacenter's VM folder, including any sub folders
+func ExampleCollector_RetrieveFiltered() {
+	simulator.Run(func(ctx context.Context, c *vim25.Client) error {
+		pc := property.DefaultCollector(c)
+
+		dc, err := find.NewFinder(c).Datacenter(ctx, "DC0")
+		if err != nil {
+			return err
+		}
+
+		folders, err := dc.Folders(ctx)
+		if err != nil {
+			return err
+		}
+
+		spec := new(property.FilterSpec).
+			Object(folders.VmFolder.Reference(), true).
+			Traverse("folder", "Folder", "childEntity", "folder").
+			Properties("VirtualMachine", "name")
+
+		spec.MaxObjects = 1 // retrieve 1 object per page, for example purposes
+
+		var vms []mo.VirtualMachine
+		err = pc.RetrieveFiltered(ctx, spec, &vms)
+		if err != nil {
+			return err
+		}
+
+		var names []string
+		for _, vm := range vms {
+			names = append(names, vm.Name)
+		}
+		sort.Strings(names)
+
+		fmt.Println(strings.Join(names, "\n"))
+
+		return nil
+	})
+	// Output:
+	// DC0_C0_RP0_VM0
+	// DC0_C0_RP0_VM1
+	// DC0_H0_VM0
+	// DC0_H0_VM1
+}
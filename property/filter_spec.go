@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property
+
+import (
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// FilterSpec provides helpers to construct a types.PropertyFilterSpec for use with Collector.RetrieveFiltered
+type FilterSpec struct {
+	types.PropertyFilterSpec
+	MaxObjects int32 // MaxObjects is the page size used by RetrieveFiltered, the server chooses if 0
+}
+
+// Object adds an ObjectSpec for the given starting object to the FilterSpec.
+// The object's own properties are included if a PropertySpec for its type is added, unless skip is true.
+func (f *FilterSpec) Object(obj types.ManagedObjectReference, skip bool) *FilterSpec {
+	f.ObjectSet = append(f.ObjectSet, types.ObjectSpec{
+		Obj:  obj,
+		Skip: types.NewBool(skip),
+	})
+
+	return f
+}
+
+// Properties adds a PropertySpec for the given managed object type to the FilterSpec.
+// All properties are included if ps is empty.
+func (f *FilterSpec) Properties(kind string, ps ...string) *FilterSpec {
+	spec := types.PropertySpec{
+		Type:    kind,
+		PathSet: ps,
+	}
+
+	if len(ps) == 0 {
+		spec.All = types.NewBool(true)
+	}
+
+	f.PropSet = append(f.PropSet, spec)
+
+	return f
+}
+
+// Traverse adds a TraversalSpec to the most recently added ObjectSpec, following the given path of objects of type kind.
+// The name identifies the TraversalSpec, such that the optional selectSet names of this or any other TraversalSpec
+// can be used to continue traversal from the objects found, including recursively.
+// Traverse panics if called before Object.
+func (f *FilterSpec) Traverse(name, kind, path string, selectSet ...string) *FilterSpec {
+	if len(f.ObjectSet) == 0 {
+		panic("Traverse called before Object")
+	}
+
+	spec := &types.TraversalSpec{
+		SelectionSpec: types.SelectionSpec{Name: name},
+		Type:          kind,
+		Path:          path,
+		Skip:          types.NewBool(false),
+	}
+
+	for _, s := range selectSet {
+		spec.SelectSet = append(spec.SelectSet, &types.SelectionSpec{Name: s})
+	}
+
+	obj := &f.ObjectSet[len(f.ObjectSet)-1]
+	obj.SelectSet = append(obj.SelectSet, spec)
+
+	return f
+}
@@ -0,0 +1,136 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property
+
+import (
+	"context"
+	"sync"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// Session tracks the PropertyCollector and PropertyFilter objects created through it,
+// such that they can all be destroyed with a single call to Close, for example:
+//
+//	s := property.NewSession(property.DefaultCollector(c))
+//	defer s.Close(context.Background())
+//
+// Server side collectors and filters are not destroyed when the client goes away,
+// only when the vCenter session ends, so long lived sessions should destroy what they create.
+type Session struct {
+	c *Collector
+
+	mu         sync.Mutex
+	collectors []*Collector
+	filters    []types.ManagedObjectReference
+}
+
+// NewSession returns a Session that creates collectors using the given Collector.
+func NewSession(c *Collector) *Session {
+	return &Session{c: c}
+}
+
+// Create creates a new Collector, to be destroyed by Close.
+func (s *Session) Create(ctx context.Context) (*Collector, error) {
+	p, err := s.c.Create(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.collectors = append(s.collectors, p)
+	s.mu.Unlock()
+
+	return p, nil
+}
+
+// CreateFilter creates a filter on the given Collector, to be destroyed by Close.
+// The given Collector does not need to have been created by the Session.
+func (s *Session) CreateFilter(ctx context.Context, p *Collector, req types.CreateFilter) (types.ManagedObjectReference, error) {
+	req.This = p.Reference()
+
+	res, err := methods.CreateFilter(ctx, p.roundTripper, &req)
+	if err != nil {
+		return types.ManagedObjectReference{}, err
+	}
+
+	s.mu.Lock()
+	s.filters = append(s.filters, res.Returnval)
+	s.mu.Unlock()
+
+	return res.Returnval, nil
+}
+
+// WaitForUpdates is the same as the package WaitForUpdates function,
+// with the temporary Collector it creates tracked by the Session.
+func (s *Session) WaitForUpdates(ctx context.Context, filter *WaitFilter, f func([]types.ObjectUpdate) bool) error {
+	return waitForUpdates(ctx, s.c, filter, f, s)
+}
+
+// forget stops tracking the given Collector, once it has been destroyed.
+func (s *Session) forget(p *Collector) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.collectors {
+		if s.collectors[i] == p {
+			s.collectors = append(s.collectors[:i], s.collectors[i+1:]...)
+			return
+		}
+	}
+}
+
+// Close destroys the filters and collectors created by the Session.
+// Objects that no longer exist, such as a filter destroyed along with its collector, are ignored.
+// All objects are attempted, the first error encountered is returned.
+func (s *Session) Close(ctx context.Context) error {
+	s.mu.Lock()
+	filters, collectors := s.filters, s.collectors
+	s.filters, s.collectors = nil, nil
+	s.mu.Unlock()
+
+	var errs []error
+
+	for _, ref := range filters {
+		req := types.DestroyPropertyFilter{This: ref}
+		_, err := methods.DestroyPropertyFilter(ctx, s.c.roundTripper, &req)
+		errs = append(errs, err)
+	}
+
+	for _, p := range collectors {
+		errs = append(errs, p.Destroy(ctx))
+	}
+
+	for _, err := range errs {
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isNotFound returns true if err is a ManagedObjectNotFound fault.
+func isNotFound(err error) bool {
+	if soap.IsSoapFault(err) {
+		_, ok := soap.ToSoapFault(err).VimFault().(types.ManagedObjectNotFound)
+		return ok
+	}
+	return false
+}
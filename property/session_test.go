@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package property_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestSession(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		pc := property.DefaultCollector(c)
+		s := property.NewSession(pc)
+
+		vm := simulator.Map.Any("VirtualMachine").Reference()
+		filter := new(property.WaitFilter).Add(vm, vm.Type, []string{"name"})
+
+		p, err := s.Create(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		collector := p.Reference()
+
+		if _, err = s.CreateFilter(ctx, p, filter.CreateFilter); err != nil {
+			t.Fatal(err)
+		}
+
+		// a filter on a collector not created by the session
+		ref, err := s.CreateFilter(ctx, pc, filter.CreateFilter)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		filters := func() []types.ManagedObjectReference {
+			var mpc mo.PropertyCollector
+			if err := pc.RetrieveOne(ctx, pc.Reference(), []string{"filter"}, &mpc); err != nil {
+				t.Fatal(err)
+			}
+			return mpc.Filter
+		}
+
+		if len(filters()) != 1 || filters()[0] != ref {
+			t.Errorf("filters=%v", filters())
+		}
+
+		err = s.WaitForUpdates(ctx, filter, func([]types.ObjectUpdate) bool {
+			return true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = s.Close(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if len(filters()) != 0 {
+			t.Errorf("filters=%v", filters())
+		}
+
+		_, err = methods.DestroyPropertyCollector(ctx, c, &types.DestroyPropertyCollector{This: collector})
+		if err == nil {
+			t.Error("expected error destroying a destroyed collector")
+		}
+
+		// nothing left to destroy
+		if err = s.Close(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -87,7 +87,20 @@ func Wait(ctx context.Context, c *Collector, obj types.ManagedObjectReference, p
 // By default, ObjectUpdate.MissingSet faults are not propagated to the returned error,
 // set WaitFilter.PropagateMissing=true to enable MissingSet fault propagation.
 func WaitForUpdates(ctx context.Context, c *Collector, filter *WaitFilter, f func([]types.ObjectUpdate) bool) error {
-	p, err := c.Create(ctx)
+	return waitForUpdates(ctx, c, filter, f, nil)
+}
+
+// waitForUpdates implements WaitForUpdates, tracking the new collector with the given Session, if any,
+// such that the collector can be destroyed by Session.Close if it cannot be destroyed here.
+func waitForUpdates(ctx context.Context, c *Collector, filter *WaitFilter, f func([]types.ObjectUpdate) bool, s *Session) error {
+	var p *Collector
+	var err error
+
+	if s == nil {
+		p, err = c.Create(ctx)
+	} else {
+		p, err = s.Create(ctx)
+	}
 	if err != nil {
 		return err
 	}
@@ -95,7 +108,9 @@ func WaitForUpdates(ctx context.Context, c *Collector, filter *WaitFilter, f fun
 	// Attempt to destroy the collector using the background context, as the
 	// specified context may have timed out or have been canceled.
 	defer func() {
-		_ = p.Destroy(context.Background())
+		if err := p.Destroy(context.Background()); err == nil && s != nil {
+			s.forget(p)
+		}
 	}()
 
 	err = p.CreateFilter(ctx, filter.CreateFilter)
@@ -141,7 +141,7 @@ func (s *Session) Save(c Client) error {
 		return err
 	}
 
-	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY, 0600)
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/vmware/govmomi/session/cache"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+func TestSessionLoginRESTInvalid(t *testing.T) {
+	simulator.Test(func(ctx context.Context, sim *vim25.Client) {
+		dir, err := ioutil.TempDir("", "govmomi-session")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		u := sim.URL()
+		u.User = simulator.DefaultLogin
+
+		s := &cache.Session{
+			URL:      u,
+			Insecure: true,
+			DirSOAP:  dir,
+			DirREST:  dir,
+		}
+
+		rc := new(rest.Client)
+		if err = s.Login(ctx, rc, nil); err != nil {
+			t.Fatal(err)
+		}
+		id := rc.SessionID()
+
+		// Invalidate the cached session, Login should authenticate and save a new session
+		if err = rc.Logout(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		rc = new(rest.Client)
+		ok, err := s.Load(ctx, rc, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok {
+			t.Fatal("expected cached session to be invalid")
+		}
+
+		rc = new(rest.Client)
+		if err = s.Login(ctx, rc, nil); err != nil {
+			t.Fatal(err)
+		}
+		if rc.SessionID() == id {
+			t.Error("expected a new session")
+		}
+
+		rc = new(rest.Client)
+		ok, err = s.Load(ctx, rc, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Error("expected cached session to be valid")
+		}
+	})
+}
@@ -53,6 +53,21 @@ func (m *AuthorizationManager) init(r *Registry) {
 		m.privileges[id] = struct{}{}
 	}
 
+	if len(m.PrivilegeList) == 0 {
+		for _, id := range admin.Privilege {
+			group, name := "", id
+			if i := strings.LastIndex(id, "."); i > 0 {
+				group, name = id[:i], id[i+1:]
+			}
+
+			m.PrivilegeList = append(m.PrivilegeList, types.AuthorizationPrivilege{
+				PrivId:        id,
+				Name:          name,
+				PrivGroupName: group,
+			})
+		}
+	}
+
 	root := r.content().RootFolder
 
 	for _, u := range DefaultUserGroup {
@@ -123,6 +138,10 @@ func (m *AuthorizationManager) RemoveEntityPermission(req *types.RemoveEntityPer
 }
 
 func (m *AuthorizationManager) SetEntityPermissions(req *types.SetEntityPermissions) soap.HasFault {
+	for i := range req.Permission {
+		req.Permission[i].Entity = &req.Entity
+	}
+
 	m.permissions[req.Entity] = req.Permission
 
 	return &methods.SetEntityPermissionsBody{
@@ -41,6 +41,32 @@ func (c *ClusterComputeResource) RenameTask(ctx *Context, req *types.Rename_Task
 	return RenameTask(ctx, c, req)
 }
 
+func (c *ClusterComputeResource) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
+	task := CreateTask(c, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		for _, ref := range c.Host {
+			if len(Map.Get(ref).(*HostSystem).Vm) != 0 {
+				return nil, &types.ResourceInUse{}
+			}
+		}
+
+		for _, ref := range c.Host {
+			Map.Remove(ctx, ref)
+		}
+		Map.Remove(ctx, *c.ResourcePool)
+
+		f := Map.getEntityParent(c, "Folder").(*Folder)
+		folderRemoveChild(ctx, &f.Folder, c.Reference())
+
+		return nil, nil
+	})
+
+	return &methods.Destroy_TaskBody{
+		Res: &types.Destroy_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 type addHost struct {
 	*ClusterComputeResource
 
@@ -397,6 +423,22 @@ func (c *ClusterComputeResource) PlaceVm(ctx *Context, req *types.PlaceVm) soap.
 	return body
 }
 
+func (c *ClusterComputeResource) ApplyRecommendation(ctx *Context, req *types.ApplyRecommendation) soap.HasFault {
+	body := new(methods.ApplyRecommendationBody)
+
+	for i, r := range c.Recommendation {
+		if r.Key == req.Key {
+			recommendation := append(c.Recommendation[:i:i], c.Recommendation[i+1:]...)
+			ctx.Map.Update(c, []types.PropertyChange{{Name: "recommendation", Val: recommendation}})
+			body.Res = new(types.ApplyRecommendationResponse)
+			return body
+		}
+	}
+
+	body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "key"})
+	return body
+}
+
 func CreateClusterComputeResource(ctx *Context, f *Folder, name string, spec types.ClusterConfigSpecEx) (*ClusterComputeResource, types.BaseMethodFault) {
 	if e := Map.FindByName(name, f.ChildEntity); e != nil {
 		return nil, &types.DuplicateName{
@@ -0,0 +1,57 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// ComputeResource is the parent of a standalone HostSystem.
+type ComputeResource struct {
+	mo.ComputeResource
+}
+
+func (c *ComputeResource) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
+	task := CreateTask(c, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		for _, ref := range c.Host {
+			if len(Map.Get(ref).(*HostSystem).Vm) != 0 {
+				return nil, &types.ResourceInUse{}
+			}
+		}
+
+		for _, ref := range c.Host {
+			host := Map.Get(ref).(*HostSystem)
+			ctx.postEvent(&types.HostRemovedEvent{HostEvent: host.event()})
+			Map.Remove(ctx, ref)
+		}
+		Map.Remove(ctx, *c.ResourcePool)
+
+		f := Map.getEntityParent(c, "Folder").(*Folder)
+		folderRemoveChild(ctx, &f.Folder, c.Reference())
+
+		return nil, nil
+	})
+
+	return &methods.Destroy_TaskBody{
+		Res: &types.Destroy_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
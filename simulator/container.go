@@ -127,6 +127,11 @@ func (c *container) prepareGuestOperation(
 	if c.id == "" {
 		return new(types.GuestOperationsUnavailable)
 	}
+	return validateGuestOperation(vm, auth)
+}
+
+// validateGuestOperation checks the VM power state and guest credentials
+func validateGuestOperation(vm *VirtualMachine, auth types.BaseGuestAuthentication) types.BaseMethodFault {
 	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
 		return &types.InvalidPowerState{
 			RequestedState: types.VirtualMachinePowerStatePoweredOn,
@@ -208,11 +213,13 @@ var (
 	toolsRunning = []types.PropertyChange{
 		{Name: "guest.toolsStatus", Val: types.VirtualMachineToolsStatusToolsOk},
 		{Name: "guest.toolsRunningStatus", Val: string(types.VirtualMachineToolsRunningStatusGuestToolsRunning)},
+		{Name: "guest.guestOperationsReady", Val: true},
 	}
 
 	toolsNotRunning = []types.PropertyChange{
 		{Name: "guest.toolsStatus", Val: types.VirtualMachineToolsStatusToolsNotRunning},
 		{Name: "guest.toolsRunningStatus", Val: string(types.VirtualMachineToolsRunningStatusGuestToolsNotRunning)},
+		{Name: "guest.guestOperationsReady", Val: false},
 	}
 )
 
@@ -511,6 +518,11 @@ func ServeGuest(w http.ResponseWriter, r *http.Request) {
 	file := strings.TrimPrefix(r.URL.Path, guestPrefix[:len(guestPrefix)-1])
 	var err error
 
+	if ref := r.URL.Query().Get("vm"); ref != "" {
+		serveGuestFS(w, r, ref, file)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodPut:
 		err = guestUpload(id, file, r)
@@ -174,6 +174,18 @@ func (c *CustomFieldsManager) RenameCustomFieldDef(ctx *Context, req *types.Rena
 	return body
 }
 
+// setCustomFieldValue replaces the value with the same key as val, if any, otherwise val is appended.
+func setCustomFieldValue(values []types.BaseCustomFieldValue, val types.BaseCustomFieldValue) []types.BaseCustomFieldValue {
+	for i := range values {
+		if values[i].GetCustomFieldValue().Key == val.GetCustomFieldValue().Key {
+			values[i] = val
+			return values
+		}
+	}
+
+	return append(values, val)
+}
+
 func (c *CustomFieldsManager) SetField(ctx *Context, req *types.SetField) soap.HasFault {
 	body := &methods.SetFieldBody{}
 
@@ -190,8 +202,8 @@ func (c *CustomFieldsManager) SetField(ctx *Context, req *types.SetField) soap.H
 
 	entity := Map.Get(req.Entity).(mo.Entity).Entity()
 	ctx.WithLock(entity, func() {
-		entity.CustomValue = append(entity.CustomValue, newValue)
-		entity.Value = append(entity.Value, newValue)
+		entity.CustomValue = setCustomFieldValue(entity.CustomValue, newValue)
+		entity.Value = setCustomFieldValue(entity.Value, newValue)
 	})
 
 	body.Res = &types.SetFieldResponse{}
@@ -181,6 +181,31 @@ func (dc *Datacenter) PowerOnMultiVMTask(ctx *Context, req *types.PowerOnMultiVM
 	}
 }
 
+func (dc *Datacenter) QueryConnectionInfoViaSpec(ctx *Context, req *types.QueryConnectionInfoViaSpec) soap.HasFault {
+	body := new(methods.QueryConnectionInfoViaSpecBody)
+
+	if req.Spec.HostName == "" {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "spec.hostName"})
+		return body
+	}
+
+	host := esx.HostSystem.Summary
+	host.Host = nil
+	host.Config.Name = req.Spec.HostName
+
+	body.Res = &types.QueryConnectionInfoViaSpecResponse{
+		Returnval: types.HostConnectInfo{
+			ServerIp:               req.Spec.HostName,
+			InDasCluster:           types.NewBool(false),
+			Host:                   host,
+			VimAccountNameRequired: types.NewBool(false),
+			ClusterSupported:       types.NewBool(true),
+		},
+	}
+
+	return body
+}
+
 func (d *Datacenter) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	task := CreateTask(d, "destroy", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		folders := []types.ManagedObjectReference{
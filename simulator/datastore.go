@@ -107,3 +107,45 @@ func (ds *Datastore) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 		},
 	}
 }
+
+func (ds *Datastore) DatastoreEnterMaintenanceMode(ctx *Context, req *types.DatastoreEnterMaintenanceMode) soap.HasFault {
+	task := CreateTask(ds, "datastoreEnterMaintenanceMode", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		// Without Storage DRS, VMs must be migrated or unregistered before entering maintenance mode
+		if len(ds.Vm) != 0 {
+			return nil, &types.ResourceInUse{
+				Type: ds.Self.Type,
+				Name: ds.Name,
+			}
+		}
+
+		ctx.Map.Update(ds, []types.PropertyChange{
+			{Name: "summary.maintenanceMode", Val: string(types.DatastoreSummaryMaintenanceModeStateInMaintenance)},
+		})
+
+		return nil, nil
+	})
+
+	ref := task.Run(ctx)
+
+	return &methods.DatastoreEnterMaintenanceModeBody{
+		Res: &types.DatastoreEnterMaintenanceModeResponse{
+			Returnval: types.StoragePlacementResult{Task: &ref},
+		},
+	}
+}
+
+func (ds *Datastore) DatastoreExitMaintenanceModeTask(ctx *Context, req *types.DatastoreExitMaintenanceMode_Task) soap.HasFault {
+	task := CreateTask(ds, "datastoreExitMaintenanceMode", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		ctx.Map.Update(ds, []types.PropertyChange{
+			{Name: "summary.maintenanceMode", Val: string(types.DatastoreSummaryMaintenanceModeStateNormal)},
+		})
+
+		return nil, nil
+	})
+
+	return &methods.DatastoreExitMaintenanceMode_TaskBody{
+		Res: &types.DatastoreExitMaintenanceMode_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
@@ -256,18 +256,18 @@ func (s *DistributedVirtualSwitch) DestroyTask(ctx *Context, req *types.Destroy_
 	}
 }
 
-func (s *DistributedVirtualSwitch) dvPortgroups(_ *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
-	// TODO(agui): Filter is not implemented yet
+func (s *DistributedVirtualSwitch) dvPortgroups(criteria *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
 	res := s.FetchDVPortsResponse.Returnval
 	if len(res) != 0 {
-		return res
+		return filterDVPorts(res, criteria)
 	}
 
 	for _, ref := range s.Portgroup {
 		pg := Map.Get(ref).(*DistributedVirtualPortgroup)
 		res = append(res, types.DistributedVirtualPort{
-			DvsUuid: s.Uuid,
-			Key:     pg.Key,
+			DvsUuid:      s.Uuid,
+			Key:          pg.Key,
+			PortgroupKey: pg.Key,
 			Config: types.DVPortConfigInfo{
 				Setting: pg.Config.DefaultPortConfig,
 			},
@@ -275,13 +275,45 @@ func (s *DistributedVirtualSwitch) dvPortgroups(_ *types.DistributedVirtualSwitc
 
 		for _, key := range pg.PortKeys {
 			res = append(res, types.DistributedVirtualPort{
-				DvsUuid: s.Uuid,
-				Key:     key,
+				DvsUuid:      s.Uuid,
+				Key:          key,
+				PortgroupKey: pg.Key,
 				Config: types.DVPortConfigInfo{
 					Setting: pg.Config.DefaultPortConfig,
 				},
 			})
 		}
 	}
+	return filterDVPorts(res, criteria)
+}
+
+// filterDVPorts applies the portgroupKey, inside and portKey fields of the given criteria, other fields are ignored.
+func filterDVPorts(ports []types.DistributedVirtualPort, criteria *types.DistributedVirtualSwitchPortCriteria) []types.DistributedVirtualPort {
+	if criteria == nil {
+		return ports
+	}
+
+	match := func(keys []string, key string) bool {
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+		return false
+	}
+
+	inside := criteria.Inside == nil || *criteria.Inside
+	var res []types.DistributedVirtualPort
+
+	for _, port := range ports {
+		if len(criteria.PortgroupKey) != 0 && match(criteria.PortgroupKey, port.PortgroupKey) != inside {
+			continue
+		}
+		if len(criteria.PortKey) != 0 && !match(criteria.PortKey, port.Key) {
+			continue
+		}
+		res = append(res, port)
+	}
+
 	return res
 }
@@ -43,7 +43,7 @@ func (b *EnvironmentBrowser) hosts(ctx *Context) []types.ManagedObjectReference
 	defer ctx.Map.m.Unlock()
 	for _, obj := range ctx.Map.objects {
 		switch e := obj.(type) {
-		case *mo.ComputeResource:
+		case *ComputeResource:
 			if b.Self == *e.EnvironmentBrowser {
 				return e.Host
 			}
@@ -76,6 +76,8 @@ func (m *EventManager) createCollector(ctx *Context, req *types.CreateCollectorF
 	}
 	collector.Filter = req.Filter
 	collector.fillPage()
+	// as with vCenter, a new collector is positioned after its latest page, RewindCollector must be used to read older events
+	collector.pos = collector.page.Back()
 
 	return collector, nil
 }
@@ -187,7 +189,7 @@ type EventHistoryCollector struct {
 	m    *EventManager
 	size int
 	page *list.List
-	pos  *list.Element
+	pos  *list.Element // pos is the element before the read position, nil if positioned before the oldest element
 }
 
 // doEntityEventArgument calls f for each entity argument in the event.
@@ -396,6 +398,7 @@ func (c *EventHistoryCollector) SetCollectorPageSize(ctx *Context, req *types.Se
 	c.size = size
 	c.page = list.New()
 	ctx.WithLock(c.m, c.fillPage)
+	c.pos = c.page.Back()
 
 	body.Res = new(types.SetCollectorPageSizeResponse)
 	return body
@@ -410,7 +413,7 @@ func (c *EventHistoryCollector) ResetCollector(ctx *Context, req *types.ResetCol
 }
 
 func (c *EventHistoryCollector) RewindCollector(ctx *Context, req *types.RewindCollector) soap.HasFault {
-	c.pos = c.page.Front()
+	c.pos = nil
 
 	return &methods.RewindCollectorBody{
 		Res: new(types.RewindCollectorResponse),
@@ -418,7 +421,7 @@ func (c *EventHistoryCollector) RewindCollector(ctx *Context, req *types.RewindC
 }
 
 // readEvents returns the next max Events from the EventManager's history
-func (c *EventHistoryCollector) readEvents(ctx *Context, max int32, next func() *list.Element) []types.BaseEvent {
+func (c *EventHistoryCollector) readEvents(ctx *Context, max int32, next func() *list.Element, pos func(*list.Element) *list.Element) []types.BaseEvent {
 	var events []types.BaseEvent
 
 	for i := 0; i < int(max); i++ {
@@ -428,7 +431,7 @@ func (c *EventHistoryCollector) readEvents(ctx *Context, max int32, next func()
 		}
 
 		events = append(events, e.Value.(types.BaseEvent))
-		c.pos = e
+		c.pos = pos(e)
 	}
 
 	return events
@@ -449,7 +452,11 @@ func (c *EventHistoryCollector) ReadNextEvents(ctx *Context, req *types.ReadNext
 		return c.page.Front()
 	}
 
-	body.Res.Returnval = c.readEvents(ctx, req.MaxCount, next)
+	pos := func(e *list.Element) *list.Element {
+		return e
+	}
+
+	body.Res.Returnval = c.readEvents(ctx, req.MaxCount, next, pos)
 
 	return body
 }
@@ -463,13 +470,14 @@ func (c *EventHistoryCollector) ReadPreviousEvents(ctx *Context, req *types.Read
 	body.Res = new(types.ReadPreviousEventsResponse)
 
 	next := func() *list.Element {
-		if c.pos != nil {
-			return c.pos.Prev()
-		}
-		return c.page.Back()
+		return c.pos
+	}
+
+	pos := func(e *list.Element) *list.Element {
+		return e.Prev()
 	}
 
-	body.Res.Returnval = c.readEvents(ctx, req.MaxCount, next)
+	body.Res.Returnval = c.readEvents(ctx, req.MaxCount, next, pos)
 
 	return body
 }
@@ -168,6 +168,19 @@ func TestEventManagerRead(t *testing.T) {
 		t.Fatal(err)
 	}
 	nevents := len(page)
+
+	// a new collector is positioned after its latest page
+	events, err := c.ReadNextEvents(ctx, int32(nevents))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Errorf("expected 0 events, got %d", len(events))
+	}
+	if err = c.Rewind(ctx); err != nil {
+		t.Fatal(err)
+	}
+
 	tests := []struct {
 		max    int
 		rewind bool
@@ -233,7 +246,7 @@ func TestEventManagerRead(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	events, err := c.ReadNextEvents(ctx, int32(nevents))
+	events, err = c.ReadNextEvents(ctx, int32(nevents))
 	if err != nil {
 		t.Fatal(err)
 	}
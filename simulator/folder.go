@@ -371,7 +371,7 @@ func (c *createVM) Run(task *Task) (types.AnyType, types.BaseMethodFault) {
 		c.ctx.WithLock(cr, func() {
 			var hosts []types.ManagedObjectReference
 			switch cr := cr.(type) {
-			case *mo.ComputeResource:
+			case *ComputeResource:
 				hosts = cr.Host
 			case *ClusterComputeResource:
 				hosts = cr.Host
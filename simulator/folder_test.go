@@ -202,7 +202,7 @@ func TestFolderVC(t *testing.T) {
 				t.Error("expected new host summary Self reference")
 			}
 
-			pool := Map.Get(*host.Parent).(*mo.ComputeResource).ResourcePool
+			pool := Map.Get(*host.Parent).(*ComputeResource).ResourcePool
 			if *pool == esx.ResourcePool.Self {
 				t.Error("expected new pool Self reference")
 			}
@@ -0,0 +1,434 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// guestFile is a file or directory within a guestFS.
+type guestFile struct {
+	dir   bool
+	data  []byte
+	perm  int64
+	mtime time.Time
+}
+
+// guestFS is an in-memory file system, used for guest file operations on VMs that are not backed by a container.
+type guestFS struct {
+	mu    sync.Mutex
+	files map[string]*guestFile
+}
+
+var guestFSMu sync.Mutex
+
+func newGuestFS() *guestFS {
+	now := time.Now()
+
+	fs := &guestFS{files: make(map[string]*guestFile)}
+
+	for _, dir := range []string{"/", "/tmp"} {
+		fs.files[dir] = &guestFile{dir: true, perm: 0777, mtime: now}
+	}
+
+	return fs
+}
+
+// guestFileSystem returns the VM's in-memory guest file system, or nil if the VM is backed by a container.
+// A fault is returned if the VM is not ready for guest operations.
+func (vm *VirtualMachine) guestFileSystem(auth types.BaseGuestAuthentication) (*guestFS, types.BaseMethodFault) {
+	if vm.run.id != "" {
+		return nil, nil
+	}
+
+	if fault := validateGuestOperation(vm, auth); fault != nil {
+		return nil, fault
+	}
+
+	guestFSMu.Lock()
+	defer guestFSMu.Unlock()
+
+	if vm.fs == nil {
+		vm.fs = newGuestFS()
+	}
+
+	return vm.fs, nil
+}
+
+func (fs *guestFS) clean(name string) string {
+	return path.Clean("/" + name)
+}
+
+// parent returns the parent directory of the given file, or a fault if it does not exist.
+func (fs *guestFS) parent(name string) (string, types.BaseMethodFault) {
+	dir := path.Dir(name)
+
+	f, ok := fs.files[dir]
+	if !ok {
+		return "", &types.FileNotFound{FileFault: types.FileFault{File: dir}}
+	}
+	if !f.dir {
+		return "", &types.NotADirectory{FileFault: types.FileFault{File: dir}}
+	}
+
+	return dir, nil
+}
+
+// children returns the sorted names of the files directly within the given directory.
+func (fs *guestFS) children(dir string) []string {
+	var names []string
+
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+
+	for name := range fs.files {
+		if name == dir || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if !strings.Contains(name[len(prefix):], "/") {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (fs *guestFS) info(name string, f *guestFile) types.GuestFileInfo {
+	kind := types.GuestFileTypeFile
+	if f.dir {
+		kind = types.GuestFileTypeDirectory
+	}
+
+	return types.GuestFileInfo{
+		Path: name,
+		Type: string(kind),
+		Size: int64(len(f.data)),
+		Attributes: &types.GuestPosixFileAttributes{
+			GuestFileAttributes: types.GuestFileAttributes{
+				ModificationTime: types.NewTime(f.mtime),
+				AccessTime:       types.NewTime(f.mtime),
+			},
+			OwnerId:     new(int32),
+			GroupId:     new(int32),
+			Permissions: f.perm,
+		},
+	}
+}
+
+func (fs *guestFS) mkdir(name string, parents bool) types.BaseMethodFault {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	return fs.makeDir(fs.clean(name), parents)
+}
+
+func (fs *guestFS) makeDir(name string, parents bool) types.BaseMethodFault {
+	if f, ok := fs.files[name]; ok {
+		if parents && f.dir {
+			return nil
+		}
+		return &types.FileAlreadyExists{FileFault: types.FileFault{File: name}}
+	}
+
+	if _, fault := fs.parent(name); fault != nil {
+		if !parents {
+			return fault
+		}
+		if fault = fs.makeDir(path.Dir(name), true); fault != nil {
+			return fault
+		}
+	}
+
+	fs.files[name] = &guestFile{dir: true, perm: 0755, mtime: time.Now()}
+
+	return nil
+}
+
+func (fs *guestFS) mktemp(dir, prefix, suffix string, isDir bool) (string, types.BaseMethodFault) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	if dir == "" {
+		dir = "/tmp"
+	}
+	dir = fs.clean(dir)
+
+	if f, ok := fs.files[dir]; !ok || !f.dir {
+		return "", &types.FileNotFound{FileFault: types.FileFault{File: dir}}
+	}
+
+	for i := 0; ; i++ {
+		name := path.Join(dir, fmt.Sprintf("%svcsim-%05d%s", prefix, i, suffix))
+		if _, ok := fs.files[name]; !ok {
+			fs.files[name] = &guestFile{dir: isDir, perm: 0600, mtime: time.Now()}
+			return name, nil
+		}
+	}
+}
+
+func (fs *guestFS) list(name string, pattern string) ([]types.GuestFileInfo, types.BaseMethodFault) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name = fs.clean(name)
+
+	f, ok := fs.files[name]
+	if !ok {
+		return nil, &types.FileNotFound{FileFault: types.FileFault{File: name}}
+	}
+
+	names := []string{name}
+	if f.dir {
+		names = fs.children(name)
+	}
+
+	var res []types.GuestFileInfo
+
+	for _, file := range names {
+		if pattern != "" {
+			if ok, _ := path.Match(pattern, path.Base(file)); !ok {
+				continue
+			}
+		}
+		res = append(res, fs.info(file, fs.files[file]))
+	}
+
+	return res, nil
+}
+
+func (fs *guestFS) remove(name string, dir bool, recursive bool) types.BaseMethodFault {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name = fs.clean(name)
+
+	f, ok := fs.files[name]
+	if !ok {
+		return &types.FileNotFound{FileFault: types.FileFault{File: name}}
+	}
+
+	if f.dir != dir {
+		if dir {
+			return &types.NotADirectory{FileFault: types.FileFault{File: name}}
+		}
+		return &types.NotAFile{FileFault: types.FileFault{File: name}}
+	}
+
+	if dir {
+		if name == "/" {
+			return &types.CannotDeleteFile{FileFault: types.FileFault{File: name}}
+		}
+
+		children := fs.children(name)
+		if len(children) != 0 && !recursive {
+			return &types.DirectoryNotEmpty{FileFault: types.FileFault{File: name}}
+		}
+
+		prefix := name + "/"
+		for file := range fs.files {
+			if strings.HasPrefix(file, prefix) {
+				delete(fs.files, file)
+			}
+		}
+	}
+
+	delete(fs.files, name)
+
+	return nil
+}
+
+func (fs *guestFS) move(src, dst string, dir bool, overwrite bool) types.BaseMethodFault {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	src = fs.clean(src)
+	dst = fs.clean(dst)
+
+	f, ok := fs.files[src]
+	if !ok {
+		return &types.FileNotFound{FileFault: types.FileFault{File: src}}
+	}
+
+	if f.dir != dir {
+		if dir {
+			return &types.NotADirectory{FileFault: types.FileFault{File: src}}
+		}
+		return &types.NotAFile{FileFault: types.FileFault{File: src}}
+	}
+
+	if target, ok := fs.files[dst]; ok {
+		if !dir && target.dir {
+			// overwriting would orphan the target directory's children
+			return &types.NotAFile{FileFault: types.FileFault{File: dst}}
+		}
+		if dir || !overwrite {
+			return &types.FileAlreadyExists{FileFault: types.FileFault{File: dst}}
+		}
+	}
+
+	if _, fault := fs.parent(dst); fault != nil {
+		return fault
+	}
+
+	if dir {
+		prefix := src + "/"
+		if strings.HasPrefix(dst, prefix) {
+			return &types.InvalidArgument{InvalidProperty: "dstDirectoryPath"}
+		}
+
+		// collect the children first, entries added to a map while ranging over it may or may not be visited
+		var children []string
+		for file := range fs.files {
+			if strings.HasPrefix(file, prefix) {
+				children = append(children, file)
+			}
+		}
+
+		moved := make(map[string]*guestFile, len(children))
+		for _, file := range children {
+			moved[path.Join(dst, file[len(prefix):])] = fs.files[file]
+			delete(fs.files, file)
+		}
+		for file, child := range moved {
+			fs.files[file] = child
+		}
+	}
+
+	delete(fs.files, src)
+	fs.files[dst] = f
+
+	return nil
+}
+
+// prepareUpload validates that the given file can be written via InitiateFileTransferToGuest.
+func (fs *guestFS) prepareUpload(name string, overwrite bool) types.BaseMethodFault {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name = fs.clean(name)
+
+	if f, ok := fs.files[name]; ok {
+		if f.dir {
+			return &types.NotAFile{FileFault: types.FileFault{File: name}}
+		}
+		if !overwrite {
+			return &types.FileAlreadyExists{FileFault: types.FileFault{File: name}}
+		}
+	}
+
+	_, fault := fs.parent(name)
+	return fault
+}
+
+// stat returns the file info for InitiateFileTransferFromGuest.
+func (fs *guestFS) stat(name string) (*types.GuestFileInfo, types.BaseMethodFault) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name = fs.clean(name)
+
+	f, ok := fs.files[name]
+	if !ok {
+		return nil, &types.FileNotFound{FileFault: types.FileFault{File: name}}
+	}
+	if f.dir {
+		return nil, &types.NotAFile{FileFault: types.FileFault{File: name}}
+	}
+
+	info := fs.info(name, f)
+	return &info, nil
+}
+
+func (fs *guestFS) write(name string, r io.Reader) error {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	name = fs.clean(name)
+
+	if _, fault := fs.parent(name); fault != nil {
+		return fmt.Errorf("%s: %T", name, fault)
+	}
+
+	fs.files[name] = &guestFile{data: data, perm: 0644, mtime: time.Now()}
+
+	return nil
+}
+
+func (fs *guestFS) read(name string) ([]byte, bool) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	f, ok := fs.files[fs.clean(name)]
+	if !ok || f.dir {
+		return nil, false
+	}
+
+	return f.data, true
+}
+
+// serveGuestFS handles guest file upload/download for VMs that are not backed by a container.
+func serveGuestFS(w http.ResponseWriter, r *http.Request, ref string, file string) {
+	vm, ok := Map.Get(types.ManagedObjectReference{Type: "VirtualMachine", Value: ref}).(*VirtualMachine)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	guestFSMu.Lock()
+	fs := vm.fs
+	guestFSMu.Unlock()
+
+	if fs == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		if err := fs.write(file, r.Body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	case http.MethodGet:
+		data, ok := fs.read(file)
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		_, _ = io.Copy(w, bytes.NewReader(data))
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
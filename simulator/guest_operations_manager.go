@@ -37,6 +37,16 @@ type GuestOperationsManager struct {
 }
 
 func (m *GuestOperationsManager) init(r *Registry) {
+	am := new(GuestAuthManager)
+	if m.AuthManager == nil {
+		m.AuthManager = &types.ManagedObjectReference{
+			Type:  "GuestAuthManager",
+			Value: "guestOperationsAuthManager",
+		}
+	}
+	am.Self = *m.AuthManager
+	r.Put(am)
+
 	fm := new(GuestFileManager)
 	if m.FileManager == nil {
 		m.FileManager = &types.ManagedObjectReference{
@@ -59,19 +69,47 @@ func (m *GuestOperationsManager) init(r *Registry) {
 	r.Put(pm)
 }
 
+type GuestAuthManager struct {
+	mo.GuestAuthManager
+}
+
+func (m *GuestAuthManager) ValidateCredentialsInGuest(ctx *Context, req *types.ValidateCredentialsInGuest) soap.HasFault {
+	body := new(methods.ValidateCredentialsInGuestBody)
+
+	vm, ok := ctx.Map.Get(req.Vm).(*VirtualMachine)
+	if !ok {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Vm})
+		return body
+	}
+
+	if fault := validateGuestOperation(vm, req.Auth); fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.ValidateCredentialsInGuestResponse)
+
+	return body
+}
+
 type GuestFileManager struct {
 	mo.GuestFileManager
 }
 
 func guestURL(ctx *Context, vm *VirtualMachine, path string) string {
+	query := url.Values{
+		"id":    []string{vm.run.id},
+		"token": []string{ctx.Session.Key},
+	}
+	if vm.run.id == "" {
+		query.Set("vm", vm.Self.Value) // in-memory guest file system
+	}
+
 	return (&url.URL{
-		Scheme: ctx.svc.Listen.Scheme,
-		Host:   "*", // See guest.FileManager.TransferURL
-		Path:   guestPrefix + strings.TrimPrefix(path, "/"),
-		RawQuery: url.Values{
-			"id":    []string{vm.run.id},
-			"token": []string{ctx.Session.Key},
-		}.Encode(),
+		Scheme:   ctx.svc.Listen.Scheme,
+		Host:     "*", // See guest.FileManager.TransferURL
+		Path:     guestPrefix + strings.TrimPrefix(path, "/"),
+		RawQuery: query.Encode(),
 	}).String()
 }
 
@@ -79,7 +117,13 @@ func (m *GuestFileManager) InitiateFileTransferToGuest(ctx *Context, req *types.
 	body := new(methods.InitiateFileTransferToGuestBody)
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
-	err := vm.run.prepareGuestOperation(vm, req.Auth)
+
+	fs, err := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		err = fs.prepareUpload(req.GuestFilePath, req.Overwrite)
+	} else if err == nil {
+		err = vm.run.prepareGuestOperation(vm, req.Auth)
+	}
 	if err != nil {
 		body.Fault_ = Fault("", err)
 		return body
@@ -96,7 +140,24 @@ func (m *GuestFileManager) InitiateFileTransferFromGuest(ctx *Context, req *type
 	body := new(methods.InitiateFileTransferFromGuestBody)
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
-	err := vm.run.prepareGuestOperation(vm, req.Auth)
+
+	fs, err := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		var info *types.GuestFileInfo
+		info, err = fs.stat(req.GuestFilePath)
+		if err == nil {
+			body.Res = &types.InitiateFileTransferFromGuestResponse{
+				Returnval: types.FileTransferInformation{
+					Attributes: info.Attributes,
+					Size:       info.Size,
+					Url:        guestURL(ctx, vm, req.GuestFilePath),
+				},
+			}
+			return body
+		}
+	} else if err == nil {
+		err = vm.run.prepareGuestOperation(vm, req.Auth)
+	}
 	if err != nil {
 		body.Fault_ = Fault("", err)
 		return body
@@ -129,6 +190,7 @@ func (m *GuestProcessManager) StartProgramInGuest(ctx *Context, req *types.Start
 	fault := vm.run.prepareGuestOperation(vm, auth)
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
+		return body
 	}
 
 	args := []string{"exec"}
@@ -213,6 +275,14 @@ func (m *GuestFileManager) mktemp(ctx *Context, req *types.CreateTemporaryFileIn
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		return fs.mktemp(req.DirectoryPath, req.Prefix, req.Suffix, dir)
+	}
+	if fault != nil {
+		return "", fault
+	}
+
 	return vm.run.exec(ctx, vm, req.Auth, args)
 }
 
@@ -298,6 +368,21 @@ func (m *GuestFileManager) ListFilesInGuest(ctx *Context, req *types.ListFilesIn
 		return body
 	}
 
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		var files []types.GuestFileInfo
+		files, fault = fs.list(req.FilePath, req.MatchPattern)
+		if fault == nil {
+			body.Res = new(types.ListFilesInGuestResponse)
+			body.Res.Returnval.Files = files
+			return body
+		}
+	}
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
 	res, fault := vm.run.exec(ctx, vm, req.Auth, listFiles(req))
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
@@ -317,7 +402,12 @@ func (m *GuestFileManager) DeleteFileInGuest(ctx *Context, req *types.DeleteFile
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.run.exec(ctx, vm, req.Auth, args)
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		fault = fs.remove(req.FilePath, false, false)
+	} else if fault == nil {
+		_, fault = vm.run.exec(ctx, vm, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -338,7 +428,12 @@ func (m *GuestFileManager) DeleteDirectoryInGuest(ctx *Context, req *types.Delet
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.run.exec(ctx, vm, req.Auth, args)
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		fault = fs.remove(req.DirectoryPath, true, req.Recursive)
+	} else if fault == nil {
+		_, fault = vm.run.exec(ctx, vm, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -359,7 +454,12 @@ func (m *GuestFileManager) MakeDirectoryInGuest(ctx *Context, req *types.MakeDir
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.run.exec(ctx, vm, req.Auth, args)
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		fault = fs.mkdir(req.DirectoryPath, req.CreateParentDirectories)
+	} else if fault == nil {
+		_, fault = vm.run.exec(ctx, vm, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -381,7 +481,12 @@ func (m *GuestFileManager) MoveFileInGuest(ctx *Context, req *types.MoveFileInGu
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.run.exec(ctx, vm, req.Auth, args)
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		fault = fs.move(req.SrcFilePath, req.DstFilePath, false, req.Overwrite)
+	} else if fault == nil {
+		_, fault = vm.run.exec(ctx, vm, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
@@ -399,7 +504,12 @@ func (m *GuestFileManager) MoveDirectoryInGuest(ctx *Context, req *types.MoveDir
 
 	vm := ctx.Map.Get(req.Vm).(*VirtualMachine)
 
-	_, fault := vm.run.exec(ctx, vm, req.Auth, args)
+	fs, fault := vm.guestFileSystem(req.Auth)
+	if fs != nil {
+		fault = fs.move(req.SrcDirectoryPath, req.DstDirectoryPath, true, false)
+	} else if fault == nil {
+		_, fault = vm.run.exec(ctx, vm, req.Auth, args)
+	}
 	if fault != nil {
 		body.Fault_ = Fault("", fault)
 		return body
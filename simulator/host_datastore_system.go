@@ -126,6 +126,10 @@ func (dss *HostDatastoreSystem) CreateLocalDatastore(ctx *Context, c *types.Crea
 		return r
 	}
 
+	if shared, ok := Map.Get(ds.Self).(*Datastore); ok {
+		ds = shared // mounted by another host
+	}
+
 	ds.Host = append(ds.Host, types.DatastoreHostMount{
 		Key: dss.Host.Reference(),
 		MountInfo: types.HostMountInfo{
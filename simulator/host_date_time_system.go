@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostDateTimeSystem struct {
+	mo.HostDateTimeSystem
+
+	offset time.Duration // offset of the host clock, as set by UpdateDateTime
+}
+
+func NewHostDateTimeSystem(_ *mo.HostSystem) *HostDateTimeSystem {
+	return &HostDateTimeSystem{
+		HostDateTimeSystem: mo.HostDateTimeSystem{
+			DateTimeInfo: types.HostDateTimeInfo{
+				TimeZone: types.HostDateTimeSystemTimeZone{
+					Key:         "UTC",
+					Name:        "UTC",
+					Description: "UTC",
+				},
+				NtpConfig: &types.HostNtpConfig{},
+			},
+		},
+	}
+}
+
+func (s *HostDateTimeSystem) UpdateDateTimeConfig(ctx *Context, req *types.UpdateDateTimeConfig) soap.HasFault {
+	body := new(methods.UpdateDateTimeConfigBody)
+
+	if req.Config.NtpConfig != nil {
+		info := s.DateTimeInfo
+		info.NtpConfig = req.Config.NtpConfig
+		ctx.Map.Update(s, []types.PropertyChange{{Name: "dateTimeInfo", Val: info}})
+	}
+
+	body.Res = new(types.UpdateDateTimeConfigResponse)
+
+	return body
+}
+
+func (s *HostDateTimeSystem) UpdateDateTime(req *types.UpdateDateTime) soap.HasFault {
+	body := new(methods.UpdateDateTimeBody)
+
+	s.offset = time.Until(req.DateTime)
+
+	body.Res = new(types.UpdateDateTimeResponse)
+
+	return body
+}
+
+func (s *HostDateTimeSystem) QueryDateTime(req *types.QueryDateTime) soap.HasFault {
+	body := new(methods.QueryDateTimeBody)
+
+	body.Res = &types.QueryDateTimeResponse{
+		Returnval: time.Now().Add(s.offset),
+	}
+
+	return body
+}
@@ -30,6 +30,7 @@ type HostFirewallSystem struct {
 
 func NewHostFirewallSystem(_ *mo.HostSystem) *HostFirewallSystem {
 	info := esx.HostFirewallInfo
+	info.Ruleset = append([]types.HostFirewallRuleset(nil), info.Ruleset...) // per-host copy, as rulesets are modified
 
 	return &HostFirewallSystem{
 		HostFirewallSystem: mo.HostFirewallSystem{
@@ -85,3 +86,22 @@ func (s *HostFirewallSystem) EnableRuleset(req *types.EnableRuleset) soap.HasFau
 
 	return body
 }
+
+func (s *HostFirewallSystem) UpdateRuleset(req *types.UpdateRuleset) soap.HasFault {
+	body := &methods.UpdateRulesetBody{}
+
+	info := s.HostFirewallSystem.FirewallInfo
+
+	for i := range info.Ruleset {
+		if info.Ruleset[i].Key == req.Id {
+			allowed := req.Spec.AllowedHosts
+			info.Ruleset[i].AllowedHosts = &allowed
+			body.Res = new(types.UpdateRulesetResponse)
+			return body
+		}
+	}
+
+	body.Fault_ = Fault("", &types.NotFound{})
+
+	return body
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package simulator
 
 import (
+	"fmt"
+
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -41,6 +43,7 @@ func NewHostNetworkSystem(host *mo.HostSystem) *HostNetworkSystem {
 					},
 				},
 				Portgroup: host.Config.Network.Portgroup,
+				Vnic:      append([]types.HostVirtualNic(nil), host.Config.Network.Vnic...),
 			},
 		},
 	}
@@ -71,9 +74,15 @@ func (s *HostNetworkSystem) AddVirtualSwitch(c *types.AddVirtualSwitch) soap.Has
 		}
 	}
 
-	s.NetworkInfo.Vswitch = append(s.NetworkInfo.Vswitch, types.HostVirtualSwitch{
+	vswitch := types.HostVirtualSwitch{
 		Name: c.VswitchName,
-	})
+	}
+	if c.Spec != nil {
+		vswitch.Spec = *c.Spec
+		vswitch.NumPorts = c.Spec.NumPorts
+	}
+
+	s.NetworkInfo.Vswitch = append(s.NetworkInfo.Vswitch, vswitch)
 
 	r.Res = &types.AddVirtualSwitchResponse{}
 
@@ -185,6 +194,38 @@ func (s *HostNetworkSystem) RemovePortGroup(ctx *Context, c *types.RemovePortGro
 	return r
 }
 
+func (s *HostNetworkSystem) AddVirtualNic(c *types.AddVirtualNic) soap.HasFault {
+	r := &methods.AddVirtualNicBody{}
+
+	found := false
+	for _, pg := range s.NetworkInfo.Portgroup {
+		if pg.Spec.Name == c.Portgroup {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		r.Fault_ = Fault("", &types.NotFound{})
+		return r
+	}
+
+	device := fmt.Sprintf("vmk%d", len(s.NetworkInfo.Vnic))
+
+	s.NetworkInfo.Vnic = append(s.NetworkInfo.Vnic, types.HostVirtualNic{
+		Device:    device,
+		Key:       "key-vim.host.VirtualNic-" + device,
+		Portgroup: c.Portgroup,
+		Spec:      c.Nic,
+	})
+
+	r.Res = &types.AddVirtualNicResponse{
+		Returnval: device,
+	}
+
+	return r
+}
+
 func (s *HostNetworkSystem) UpdateNetworkConfig(req *types.UpdateNetworkConfig) soap.HasFault {
 	s.NetworkConfig = &req.Config
 
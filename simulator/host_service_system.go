@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type HostServiceSystem struct {
+	mo.HostServiceSystem
+}
+
+func NewHostServiceSystem(_ *mo.HostSystem) *HostServiceSystem {
+	info := *esx.HostConfigInfo.Service
+	info.Service = append([]types.HostService(nil), info.Service...) // per-host copy, as services are modified
+
+	return &HostServiceSystem{
+		HostServiceSystem: mo.HostServiceSystem{
+			ServiceInfo: info,
+		},
+	}
+}
+
+// updateService applies fn to a copy of the service with the given id, publishing the change via Map.Update.
+func (s *HostServiceSystem) updateService(ctx *Context, id string, fn func(*types.HostService)) types.BaseMethodFault {
+	info := s.ServiceInfo
+	info.Service = append([]types.HostService(nil), info.Service...)
+
+	for i := range info.Service {
+		if info.Service[i].Key == id {
+			fn(&info.Service[i])
+			ctx.Map.Update(s, []types.PropertyChange{{Name: "serviceInfo", Val: info}})
+			return nil
+		}
+	}
+
+	return &types.NotFound{}
+}
+
+func (s *HostServiceSystem) StartService(ctx *Context, req *types.StartService) soap.HasFault {
+	body := new(methods.StartServiceBody)
+
+	fault := s.updateService(ctx, req.Id, func(service *types.HostService) {
+		service.Running = true
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.StartServiceResponse)
+
+	return body
+}
+
+func (s *HostServiceSystem) StopService(ctx *Context, req *types.StopService) soap.HasFault {
+	body := new(methods.StopServiceBody)
+
+	fault := s.updateService(ctx, req.Id, func(service *types.HostService) {
+		service.Running = false
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.StopServiceResponse)
+
+	return body
+}
+
+func (s *HostServiceSystem) RestartService(ctx *Context, req *types.RestartService) soap.HasFault {
+	body := new(methods.RestartServiceBody)
+
+	fault := s.updateService(ctx, req.Id, func(service *types.HostService) {
+		service.Running = true
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.RestartServiceResponse)
+
+	return body
+}
+
+func (s *HostServiceSystem) UpdateServicePolicy(ctx *Context, req *types.UpdateServicePolicy) soap.HasFault {
+	body := new(methods.UpdateServicePolicyBody)
+
+	fault := s.updateService(ctx, req.Id, func(service *types.HostService) {
+		service.Policy = req.Policy
+	})
+	if fault != nil {
+		body.Fault_ = Fault("", fault)
+		return body
+	}
+
+	body.Res = new(types.UpdateServicePolicyResponse)
+
+	return body
+}
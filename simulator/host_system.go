@@ -77,6 +77,8 @@ func NewHostSystem(host mo.HostSystem) *HostSystem {
 		{&hs.ConfigManager.AdvancedOption, NewOptionManager(nil, esx.Setting)},
 		{&hs.ConfigManager.FirewallSystem, NewHostFirewallSystem(&hs.HostSystem)},
 		{&hs.ConfigManager.StorageSystem, NewHostStorageSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.DateTimeSystem, NewHostDateTimeSystem(&hs.HostSystem)},
+		{&hs.ConfigManager.ServiceSystem, NewHostServiceSystem(&hs.HostSystem)},
 	}
 
 	for _, c := range config {
@@ -132,8 +134,8 @@ func (h *HostSystem) eventArgumentParent() *types.ComputeResourceEventArgument {
 
 func hostParent(host *mo.HostSystem) *mo.ComputeResource {
 	switch parent := Map.Get(*host.Parent).(type) {
-	case *mo.ComputeResource:
-		return parent
+	case *ComputeResource:
+		return &parent.ComputeResource
 	case *ClusterComputeResource:
 		return &parent.ComputeResource
 	default:
@@ -163,10 +165,9 @@ func CreateDefaultESX(ctx *Context, f *Folder) {
 	summary := new(types.ComputeResourceSummary)
 	addComputeResource(summary, host)
 
-	cr := &mo.ComputeResource{
-		Summary: summary,
-		Network: esx.Datacenter.Network,
-	}
+	cr := &ComputeResource{}
+	cr.Summary = summary
+	cr.Network = esx.Datacenter.Network
 	cr.EnvironmentBrowser = newEnvironmentBrowser()
 	cr.Self = *host.Parent
 	cr.Name = host.Name
@@ -196,13 +197,12 @@ func CreateStandaloneHost(ctx *Context, f *Folder, spec types.HostConnectSpec) (
 	summary := new(types.ComputeResourceSummary)
 	addComputeResource(summary, host)
 
-	cr := &mo.ComputeResource{
-		ConfigurationEx: &types.ComputeResourceConfigInfo{
-			VmSwapPlacement: string(types.VirtualMachineConfigInfoSwapPlacementTypeVmDirectory),
-		},
-		Summary:            summary,
-		EnvironmentBrowser: newEnvironmentBrowser(),
+	cr := &ComputeResource{}
+	cr.ConfigurationEx = &types.ComputeResourceConfigInfo{
+		VmSwapPlacement: string(types.VirtualMachineConfigInfoSwapPlacementTypeVmDirectory),
 	}
+	cr.Summary = summary
+	cr.EnvironmentBrowser = newEnvironmentBrowser()
 
 	Map.PutEntity(cr, Map.NewEntity(host))
 	host.Summary.Host = &host.Self
@@ -229,6 +229,10 @@ func (h *HostSystem) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 
 		ctx.postEvent(&types.HostRemovedEvent{HostEvent: h.event()})
 
+		if cluster, ok := Map.Get(*h.Parent).(*ClusterComputeResource); ok {
+			Map.RemoveReference(ctx, cluster, &cluster.Host, h.Self)
+		}
+
 		f := Map.getEntityParent(h, "Folder").(*Folder)
 		folderRemoveChild(ctx, &f.Folder, h.Reference())
 
@@ -242,6 +246,38 @@ func (h *HostSystem) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.Has
 	}
 }
 
+func (h *HostSystem) DisconnectHostTask(ctx *Context, req *types.DisconnectHost_Task) soap.HasFault {
+	task := CreateTask(h, "disconnectHost", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		ctx.Map.Update(h, []types.PropertyChange{
+			{Name: "runtime.connectionState", Val: types.HostSystemConnectionStateDisconnected},
+			{Name: "summary.runtime.connectionState", Val: types.HostSystemConnectionStateDisconnected},
+		})
+		return nil, nil
+	})
+
+	return &methods.DisconnectHost_TaskBody{
+		Res: &types.DisconnectHost_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (h *HostSystem) ReconnectHostTask(ctx *Context, req *types.ReconnectHost_Task) soap.HasFault {
+	task := CreateTask(h, "reconnectHost", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		ctx.Map.Update(h, []types.PropertyChange{
+			{Name: "runtime.connectionState", Val: types.HostSystemConnectionStateConnected},
+			{Name: "summary.runtime.connectionState", Val: types.HostSystemConnectionStateConnected},
+		})
+		return nil, nil
+	})
+
+	return &methods.ReconnectHost_TaskBody{
+		Res: &types.ReconnectHost_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (h *HostSystem) EnterMaintenanceModeTask(ctx *Context, spec *types.EnterMaintenanceMode_Task) soap.HasFault {
 	task := CreateTask(h, "enterMaintenanceMode", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		h.Runtime.InMaintenanceMode = true
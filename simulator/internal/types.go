@@ -26,6 +26,7 @@ import (
 // Minimal set of internal types and methods:
 // - Fetch() - used by ovftool to collect various managed object properties
 // - RetrieveInternalContent() - used by ovftool to obtain a reference to NfcService (which it does not use by default)
+// - QueryVirtualDiskInfo_Task() - used by govc datastore.disk.info and object.Datastore.DiskInfo
 
 func init() {
 	types.Add("Fetch", reflect.TypeOf((*Fetch)(nil)).Elem())
@@ -71,3 +72,35 @@ type InternalServiceInstanceContent struct {
 
 	NfcService types.ManagedObjectReference `xml:"nfcService"`
 }
+
+func init() {
+	types.Add("QueryVirtualDiskInfo_Task", reflect.TypeOf((*QueryVirtualDiskInfo_Task)(nil)).Elem())
+}
+
+type QueryVirtualDiskInfo_Task struct {
+	This           types.ManagedObjectReference  `xml:"_this"`
+	Name           string                        `xml:"name"`
+	Datacenter     *types.ManagedObjectReference `xml:"datacenter,omitempty"`
+	IncludeParents bool                          `xml:"includeParents"`
+}
+
+type QueryVirtualDiskInfo_TaskResponse struct {
+	Returnval types.ManagedObjectReference `xml:"returnval"`
+}
+
+type QueryVirtualDiskInfo_TaskBody struct {
+	Res    *QueryVirtualDiskInfo_TaskResponse `xml:"QueryVirtualDiskInfo_TaskResponse,omitempty"`
+	Fault_ *soap.Fault                        `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *QueryVirtualDiskInfo_TaskBody) Fault() *soap.Fault { return b.Fault_ }
+
+type ArrayOfVirtualDiskInfo struct {
+	VirtualDiskInfo []VirtualDiskInfo `xml:"VirtualDiskInfo,omitempty"`
+}
+
+type VirtualDiskInfo struct {
+	Name     string `xml:"unit>name"`
+	DiskType string `xml:"diskType"`
+	Parent   string `xml:"parent,omitempty"`
+}
@@ -138,6 +138,17 @@ type Model struct {
 	// Delay configurations
 	DelayConfig DelayConfig `json:"-"`
 
+	// TaskProgress specifies the number of milliseconds over which a task updates info.progress from 0 to 100
+	// before it completes, keyed by task name, such as "CloneVm" or "ReconfigVm".
+	// This can be used to test code paths that report task progress. Tasks not listed complete without progress updates.
+	TaskProgress map[string]int `json:"-"`
+
+	// PerfData seeds the values returned by PerformanceManager.QueryPerf, keyed by counter name,
+	// such as "cpu.usage.average", or "cpu.usage" to match all rollup types.
+	// The values of a seeded counter cycle through the given slice, indexed by sample time and interval,
+	// making query results deterministic. Counters not seeded use the default sample data.
+	PerfData map[string][]int64 `json:"-"`
+
 	// total number of inventory objects, set by Count()
 	total int
 
@@ -229,6 +240,7 @@ func (*Model) fmtName(prefix string, num int) string {
 var kinds = map[string]reflect.Type{
 	"AuthorizationManager":            reflect.TypeOf((*AuthorizationManager)(nil)).Elem(),
 	"ClusterComputeResource":          reflect.TypeOf((*ClusterComputeResource)(nil)).Elem(),
+	"ComputeResource":                 reflect.TypeOf((*ComputeResource)(nil)).Elem(),
 	"CustomFieldsManager":             reflect.TypeOf((*CustomFieldsManager)(nil)).Elem(),
 	"CustomizationSpecManager":        reflect.TypeOf((*CustomizationSpecManager)(nil)).Elem(),
 	"Datacenter":                      reflect.TypeOf((*Datacenter)(nil)).Elem(),
@@ -252,6 +264,7 @@ var kinds = map[string]reflect.Type{
 	"PerformanceManager":              reflect.TypeOf((*PerformanceManager)(nil)).Elem(),
 	"PropertyCollector":               reflect.TypeOf((*PropertyCollector)(nil)).Elem(),
 	"ResourcePool":                    reflect.TypeOf((*ResourcePool)(nil)).Elem(),
+	"ScheduledTaskManager":            reflect.TypeOf((*ScheduledTaskManager)(nil)).Elem(),
 	"SearchIndex":                     reflect.TypeOf((*SearchIndex)(nil)).Elem(),
 	"SessionManager":                  reflect.TypeOf((*SessionManager)(nil)).Elem(),
 	"StoragePod":                      reflect.TypeOf((*StoragePod)(nil)).Elem(),
@@ -796,8 +809,16 @@ func (m *Model) Create() error {
 		}
 	}
 
+	if len(m.PerfData) != 0 {
+		pm := Map.Get(*m.Service.client.ServiceContent.PerfManager).(*PerformanceManager)
+		if err := pm.seed(m.PerfData); err != nil {
+			return err
+		}
+	}
+
 	// Turn on delay AFTER we're done building the service content
 	m.Service.delay = &m.DelayConfig
+	m.Service.progress = m.TaskProgress
 
 	return nil
 }
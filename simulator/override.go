@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"sync"
+
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// OverrideFunc is called in place of a method handler, with the method request body, such as *types.ReconfigVM_Task.
+// If the returned bool is false, the method is dispatched to its handler as normal.
+// Otherwise, the returned body is sent as the method response, see MethodFault.
+type OverrideFunc func(ctx *Context, req types.AnyType) (soap.HasFault, bool)
+
+var overrides = struct {
+	sync.Mutex
+	m map[string]OverrideFunc
+}{
+	m: make(map[string]OverrideFunc),
+}
+
+// Override registers fn to be called for all invocations of the given method name, such as "ReconfigVM_Task".
+// Overrides apply to all simulator instances and are called after authentication and object lookup,
+// allowing tests to inject faults into both synchronous and *_Task methods.
+// A nil fn removes the override for the given method name.
+func Override(method string, fn OverrideFunc) {
+	overrides.Lock()
+	defer overrides.Unlock()
+
+	if fn == nil {
+		delete(overrides.m, method)
+	} else {
+		overrides.m[method] = fn
+	}
+}
+
+// ClearOverrides removes all method overrides registered via Override.
+func ClearOverrides() {
+	overrides.Lock()
+	defer overrides.Unlock()
+
+	overrides.m = make(map[string]OverrideFunc)
+}
+
+// MethodFault returns a method response body for the given fault, for use as an OverrideFunc result.
+func MethodFault(fault types.BaseMethodFault) soap.HasFault {
+	return &serverFaultBody{Reason: Fault("", fault)}
+}
+
+func override(ctx *Context, method *Method) (soap.HasFault, bool) {
+	overrides.Lock()
+	fn, ok := overrides.m[method.Name]
+	overrides.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+
+	return fn(ctx, method.Body)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestOverride(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		defer ClearOverrides()
+
+		vm := object.NewVirtualMachine(c, Map.Any("VirtualMachine").Reference())
+
+		calls := 0
+		Override("ReconfigVM_Task", func(_ *Context, req types.AnyType) (soap.HasFault, bool) {
+			if _, ok := req.(*types.ReconfigVM_Task); !ok {
+				t.Errorf("req=%T", req)
+			}
+			calls++
+			if calls == 3 {
+				return MethodFault(new(types.InvalidState)), true
+			}
+			return nil, false
+		})
+
+		reconfigure := func() error {
+			task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{Annotation: "override"})
+			if err != nil {
+				return err
+			}
+			return task.Wait(ctx)
+		}
+
+		for i := 1; i <= 4; i++ {
+			err := reconfigure()
+			if i == 3 {
+				if err == nil {
+					t.Fatal("expected fault")
+				}
+				if _, ok := soap.ToSoapFault(err).VimFault().(types.InvalidState); !ok {
+					t.Errorf("fault=%#v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("call %d: %s", i, err)
+			}
+		}
+
+		Override("PowerOffVM_Task", func(*Context, types.AnyType) (soap.HasFault, bool) {
+			return MethodFault(new(types.TaskInProgress)), true
+		})
+
+		if _, err := vm.PowerOff(ctx); err == nil {
+			t.Error("expected fault")
+		}
+
+		ClearOverrides()
+
+		if err := reconfigure(); err != nil {
+			t.Fatal(err)
+		}
+		if calls != 4 {
+			t.Errorf("calls=%d", calls)
+		}
+
+		task, err := vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = task.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
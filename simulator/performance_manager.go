@@ -17,8 +17,10 @@ limitations under the License.
 package simulator
 
 import (
+	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/vmware/govmomi/simulator/esx"
@@ -51,6 +53,7 @@ type PerformanceManager struct {
 	datacenterMetrics []types.PerfMetricId
 	perfCounterIndex  map[int32]types.PerfCounterInfo
 	metricData        map[string]map[int32][]int64
+	seedData          map[int32][]int64
 }
 
 func (m *PerformanceManager) init(r *Registry) {
@@ -76,6 +79,34 @@ func (m *PerformanceManager) init(r *Registry) {
 	}
 }
 
+// seed sets the values of the given counters, keyed by counter name.
+// A name such as "cpu.usage.average" matches a single counter, and a name such as "cpu.usage" matches all rollup types.
+func (p *PerformanceManager) seed(data map[string][]int64) error {
+	p.seedData = make(map[int32][]int64, len(data))
+
+	for name, values := range data {
+		if len(values) == 0 {
+			return fmt.Errorf("no values for perf counter %q", name)
+		}
+
+		found := false
+		for i := range p.PerfCounter {
+			info := &p.PerfCounter[i]
+			full := info.Name()
+			if name == full || name == strings.TrimSuffix(full, "."+string(info.RollupType)) {
+				p.seedData[info.Key] = values
+				found = true
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("unknown perf counter %q", name)
+		}
+	}
+
+	return nil
+}
+
 func (p *PerformanceManager) QueryPerfCounter(ctx *Context, req *types.QueryPerfCounter) soap.HasFault {
 	body := new(methods.QueryPerfCounterBody)
 	body.Res = new(types.QueryPerfCounterResponse)
@@ -222,6 +253,16 @@ func (p *PerformanceManager) QueryPerf(ctx *Context, req *types.QueryPerf) soap.
 			// Create list of metrics for this tick
 			series := &types.PerfMetricIntSeries{Value: make([]int64, n)}
 			series.Id = mid
+			metrics.Value[j] = series
+
+			// Seeded values are derived from the sample time, without noise
+			if values, ok := p.seedData[mid.CounterId]; ok {
+				for tick, sample := range metrics.SampleInfo {
+					series.Value[tick] = values[(sample.Timestamp.Unix()/int64(interval))%int64(len(values))]
+				}
+				continue
+			}
+
 			points := metricData[mid.CounterId]
 			offset := int64(start.Unix()) / int64(interval)
 
@@ -244,7 +285,6 @@ func (p *PerformanceManager) QueryPerf(ctx *Context, req *types.QueryPerf) soap.
 				}
 				series.Value[tick] = p
 			}
-			metrics.Value[j] = series
 		}
 		body.Res.Returnval[i] = metrics
 	}
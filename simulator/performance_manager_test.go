@@ -19,7 +19,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/vmware/govmomi/performance"
 	"github.com/vmware/govmomi/simulator/esx"
@@ -331,3 +333,77 @@ func TestQueryPerf(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestQueryPerfSeed(t *testing.T) {
+	ctx := context.Background()
+
+	m := VPX()
+	m.PerfData = map[string][]int64{
+		"cpu.usage":          {10, 20, 30, 40},
+		"mem.active.average": {1024},
+	}
+
+	err := m.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer m.Remove()
+
+	p := performance.NewManager(m.Service.client)
+
+	counters, err := p.CounterInfoByName(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cpu := counters["cpu.usage.average"].Key
+	mem := counters["mem.active.average"].Key
+
+	end := time.Unix(1600000000, 0)
+	start := end.Add(-time.Hour)
+	interval := int32(20)
+
+	qs := []types.PerfQuerySpec{
+		{
+			Entity:     Map.Any("VirtualMachine").Reference(),
+			StartTime:  &start,
+			EndTime:    &end,
+			MaxSample:  4,
+			IntervalId: interval,
+			MetricId:   []types.PerfMetricId{{CounterId: cpu}, {CounterId: mem}},
+		},
+	}
+
+	query := func() *types.PerfEntityMetric {
+		res, err := p.Query(ctx, qs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res[0].(*types.PerfEntityMetric)
+	}
+
+	metric := query()
+
+	for i, sample := range metric.SampleInfo {
+		expect := m.PerfData["cpu.usage"][(sample.Timestamp.Unix()/int64(interval))%4]
+		if val := metric.Value[0].(*types.PerfMetricIntSeries).Value[i]; val != expect {
+			t.Errorf("cpu.usage[%d]=%d, expected %d", i, val, expect)
+		}
+		if val := metric.Value[1].(*types.PerfMetricIntSeries).Value[i]; val != 1024 {
+			t.Errorf("mem.active[%d]=%d", i, val)
+		}
+	}
+
+	if !reflect.DeepEqual(metric.Value, query().Value) {
+		t.Error("expected the same values")
+	}
+
+	m = VPX()
+	m.PerfData = map[string][]int64{"cpu.invalid": {1}}
+	defer m.Remove()
+
+	if err = m.Create(); err == nil {
+		t.Error("expected error")
+	}
+}
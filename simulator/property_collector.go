@@ -26,6 +26,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/internal"
 	"github.com/vmware/govmomi/vim25"
@@ -42,8 +44,21 @@ type PropertyCollector struct {
 	updates []types.ObjectUpdate
 	mu      sync.Mutex
 	cancel  context.CancelFunc
+	pages   map[string]*retrievePage
+}
+
+// retrievePage holds the remaining objects of a RetrievePropertiesEx call,
+// as returned by ContinueRetrievePropertiesEx.
+type retrievePage struct {
+	objects []types.ObjectContent
+	max     int32
+	expires time.Time
 }
 
+// retrievePageTimeout is the duration a RetrievePropertiesEx token remains valid,
+// after which the remaining objects are discarded.
+var retrievePageTimeout = 10 * time.Minute
+
 func NewPropertyCollector(ref types.ManagedObjectReference) object.Reference {
 	s := &PropertyCollector{}
 	s.Self = ref
@@ -519,6 +534,11 @@ func (pc *PropertyCollector) RetrievePropertiesEx(ctx *Context, r *types.Retriev
 			objects = append(objects, o)
 		}
 		res.Objects = objects
+
+		if r.Options.MaxObjects > 0 {
+			res.Objects, res.Token = pc.page(objects, r.Options.MaxObjects)
+		}
+
 		body.Res = &types.RetrievePropertiesExResponse{
 			Returnval: res,
 		}
@@ -527,6 +547,80 @@ func (pc *PropertyCollector) RetrievePropertiesEx(ctx *Context, r *types.Retriev
 	return body
 }
 
+// page returns at most max objects, saving any remainder for use by ContinueRetrievePropertiesEx.
+func (pc *PropertyCollector) page(objects []types.ObjectContent, max int32) ([]types.ObjectContent, string) {
+	if len(objects) <= int(max) {
+		return objects, ""
+	}
+
+	token := uuid.New().String()
+	now := time.Now()
+
+	pc.mu.Lock()
+	if pc.pages == nil {
+		pc.pages = make(map[string]*retrievePage)
+	}
+	// discard pages abandoned by callers that did not continue or cancel the retrieval
+	for id, page := range pc.pages {
+		if now.After(page.expires) {
+			delete(pc.pages, id)
+		}
+	}
+	pc.pages[token] = &retrievePage{objects: objects[max:], max: max, expires: now.Add(retrievePageTimeout)}
+	pc.mu.Unlock()
+
+	return objects[:max], token
+}
+
+func (pc *PropertyCollector) removePage(token string) *retrievePage {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	page, ok := pc.pages[token]
+	if !ok {
+		return nil
+	}
+	delete(pc.pages, token)
+
+	if time.Now().After(page.expires) {
+		return nil
+	}
+
+	return page
+}
+
+func (pc *PropertyCollector) ContinueRetrievePropertiesEx(ctx *Context, r *types.ContinueRetrievePropertiesEx) soap.HasFault {
+	body := &methods.ContinueRetrievePropertiesExBody{}
+
+	page := pc.removePage(r.Token)
+	if page == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "token"})
+		return body
+	}
+
+	var res types.RetrieveResult
+	res.Objects, res.Token = pc.page(page.objects, page.max)
+
+	body.Res = &types.ContinueRetrievePropertiesExResponse{
+		Returnval: res,
+	}
+
+	return body
+}
+
+func (pc *PropertyCollector) CancelRetrievePropertiesEx(ctx *Context, r *types.CancelRetrievePropertiesEx) soap.HasFault {
+	body := &methods.CancelRetrievePropertiesExBody{}
+
+	if pc.removePage(r.Token) == nil {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "token"})
+		return body
+	}
+
+	body.Res = new(types.CancelRetrievePropertiesExResponse)
+
+	return body
+}
+
 // RetrieveProperties is deprecated, but govmomi is still using it at the moment.
 func (pc *PropertyCollector) RetrieveProperties(ctx *Context, r *types.RetrieveProperties) soap.HasFault {
 	body := &methods.RetrievePropertiesBody{}
@@ -31,6 +31,7 @@ import (
 	"github.com/vmware/govmomi/simulator/esx"
 	"github.com/vmware/govmomi/simulator/vpx"
 	"github.com/vmware/govmomi/view"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -1661,3 +1662,99 @@ func TestPropertyCollectorNoPathSet(t *testing.T) {
 		t.Fatalf("len(content)=%d", len(content))
 	}
 }
+
+func TestPropertyCollectorPaging(t *testing.T) {
+	Test(func(ctx context.Context, c *vim25.Client) {
+		pc := c.ServiceContent.PropertyCollector
+
+		spec := types.PropertyFilterSpec{
+			PropSet: []types.PropertySpec{{Type: "VirtualMachine", PathSet: []string{"name"}}},
+		}
+		for _, vm := range Map.All("VirtualMachine") {
+			spec.ObjectSet = append(spec.ObjectSet, types.ObjectSpec{Obj: vm.Reference()})
+		}
+
+		req := types.RetrievePropertiesEx{
+			This:    pc,
+			SpecSet: []types.PropertyFilterSpec{spec},
+			Options: types.RetrieveOptions{MaxObjects: 3},
+		}
+
+		res, err := methods.RetrievePropertiesEx(ctx, c, &req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		objects := res.Returnval.Objects
+		token := res.Returnval.Token
+		if len(objects) != 3 || token == "" {
+			t.Fatalf("objects=%d, token=%q", len(objects), token)
+		}
+
+		for token != "" {
+			res, err := methods.ContinueRetrievePropertiesEx(ctx, c, &types.ContinueRetrievePropertiesEx{This: pc, Token: token})
+			if err != nil {
+				t.Fatal(err)
+			}
+			objects = append(objects, res.Returnval.Objects...)
+			token = res.Returnval.Token
+		}
+
+		if len(objects) != len(spec.ObjectSet) {
+			t.Errorf("objects=%d", len(objects))
+		}
+
+		// token is no longer valid
+		_, err = methods.ContinueRetrievePropertiesEx(ctx, c, &types.ContinueRetrievePropertiesEx{This: pc, Token: res.Returnval.Token})
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		res, err = methods.RetrievePropertiesEx(ctx, c, &req)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = methods.CancelRetrievePropertiesEx(ctx, c, &types.CancelRetrievePropertiesEx{This: pc, Token: res.Returnval.Token})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = methods.ContinueRetrievePropertiesEx(ctx, c, &types.ContinueRetrievePropertiesEx{This: pc, Token: res.Returnval.Token})
+		if err == nil {
+			t.Error("expected error")
+		}
+	})
+}
+
+func TestPropertyCollectorPagingExpired(t *testing.T) {
+	pc := &PropertyCollector{}
+	objects := make([]types.ObjectContent, 3)
+
+	timeout := retrievePageTimeout
+	defer func() { retrievePageTimeout = timeout }()
+	retrievePageTimeout = -time.Second
+
+	_, abandoned := pc.page(objects, 1)
+	if abandoned == "" {
+		t.Fatal("expected token")
+	}
+
+	retrievePageTimeout = time.Minute
+
+	_, token := pc.page(objects, 1)
+
+	if _, ok := pc.pages[abandoned]; ok || len(pc.pages) != 1 {
+		t.Errorf("expired page not discarded, pages=%d", len(pc.pages))
+	}
+
+	retrievePageTimeout = -time.Second
+	_, expired := pc.page(objects, 1)
+	if pc.removePage(expired) != nil {
+		t.Error("expired token is valid")
+	}
+
+	if pc.removePage(token) == nil {
+		t.Error("token is not valid")
+	}
+}
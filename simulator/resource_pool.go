@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/vmware/govmomi/object"
@@ -426,6 +427,200 @@ func (a *VirtualApp) CreateVApp(req *types.CreateVApp) soap.HasFault {
 	return (&ResourcePool{ResourcePool: a.ResourcePool}).CreateVApp(req)
 }
 
+func (a *VirtualApp) MoveIntoResourcePool(ctx *Context, req *types.MoveIntoResourcePool) soap.HasFault {
+	return moveIntoResourcePool(ctx, a, &a.ResourcePool, req)
+}
+
+func (p *ResourcePool) MoveIntoResourcePool(ctx *Context, req *types.MoveIntoResourcePool) soap.HasFault {
+	return moveIntoResourcePool(ctx, p, &p.ResourcePool, req)
+}
+
+func moveIntoResourcePool(ctx *Context, self mo.Reference, p *mo.ResourcePool, req *types.MoveIntoResourcePool) soap.HasFault {
+	body := new(methods.MoveIntoResourcePoolBody)
+
+	for _, ref := range req.List {
+		obj := ctx.Map.Get(ref)
+		if obj == nil {
+			body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: ref})
+			return body
+		}
+
+		switch obj := obj.(type) {
+		case *VirtualMachine:
+			pobj := ctx.Map.Get(*obj.ResourcePool)
+			parent, _ := asResourcePoolMO(pobj)
+			if parent.Owner != p.Owner {
+				body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "list"})
+				return body
+			}
+
+			ctx.Map.RemoveReference(ctx, pobj, &parent.Vm, ref)
+			ctx.Map.Update(pobj, []types.PropertyChange{{Name: "vm", Val: parent.Vm}})
+			ctx.Map.Update(obj, []types.PropertyChange{{Name: "resourcePool", Val: p.Self}})
+			ctx.Map.AddReference(ctx, self, &p.Vm, ref)
+			ctx.Map.Update(self, []types.PropertyChange{{Name: "vm", Val: p.Vm}})
+		default:
+			child, ok := asResourcePoolMO(obj)
+			if !ok {
+				body.Fault_ = Fault("", &types.NotSupported{})
+				return body
+			}
+
+			// A pool cannot be moved into itself or any of its descendants
+			for e := p.Self; e.Type != "ComputeResource" && e.Type != "ClusterComputeResource"; {
+				if e == ref {
+					body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "list"})
+					return body
+				}
+				e = *ctx.Map.Get(e).(mo.Entity).Entity().Parent
+			}
+
+			if child.Owner != p.Owner || child.Parent.Type != "ResourcePool" {
+				body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "list"})
+				return body
+			}
+
+			pobj := ctx.Map.Get(*child.Parent)
+			parent, _ := asResourcePoolMO(pobj)
+			ctx.Map.RemoveReference(ctx, pobj, &parent.ResourcePool, ref)
+			ctx.Map.Update(pobj, []types.PropertyChange{{Name: "resourcePool", Val: parent.ResourcePool}})
+			ctx.Map.Update(obj, []types.PropertyChange{{Name: "parent", Val: p.Self}})
+			ctx.Map.AddReference(ctx, self, &p.ResourcePool, ref)
+			ctx.Map.Update(self, []types.PropertyChange{{Name: "resourcePool", Val: p.ResourcePool}})
+		}
+	}
+
+	body.Res = new(types.MoveIntoResourcePoolResponse)
+
+	return body
+}
+
+func (a *VirtualApp) UpdateVAppConfig(ctx *Context, req *types.UpdateVAppConfig) soap.HasFault {
+	body := &methods.UpdateVAppConfigBody{}
+
+	for _, spec := range req.Spec.EntityConfig {
+		if spec.Key == nil || FindReference(a.Vm, *spec.Key) == nil {
+			body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "spec.entityConfig.key"})
+			return body
+		}
+	}
+
+	config := new(types.VAppConfigInfo)
+	if a.VAppConfig != nil {
+		*config = *a.VAppConfig
+	}
+	config.EntityConfig = append([]types.VAppEntityConfigInfo(nil), config.EntityConfig...)
+
+	for _, spec := range req.Spec.EntityConfig {
+		found := false
+		for i, info := range config.EntityConfig {
+			if *info.Key == *spec.Key {
+				config.EntityConfig[i] = spec
+				found = true
+				break
+			}
+		}
+		if !found {
+			config.EntityConfig = append(config.EntityConfig, spec)
+		}
+	}
+
+	if req.Spec.Annotation != "" {
+		config.Annotation = req.Spec.Annotation
+	}
+
+	ctx.Map.Update(a, []types.PropertyChange{{Name: "vAppConfig", Val: config}})
+
+	body.Res = new(types.UpdateVAppConfigResponse)
+
+	return body
+}
+
+// powerOrder returns the vApp's child VMs sorted by start order, along with the start or stop action of each VM.
+func (a *VirtualApp) powerOrder(start bool) ([]types.ManagedObjectReference, map[types.ManagedObjectReference]string) {
+	vms := append([]types.ManagedObjectReference(nil), a.Vm...)
+	order := make(map[types.ManagedObjectReference]int32)
+	action := make(map[types.ManagedObjectReference]string)
+
+	if a.VAppConfig != nil {
+		for _, info := range a.VAppConfig.EntityConfig {
+			order[*info.Key] = info.StartOrder
+			if start {
+				action[*info.Key] = info.StartAction
+			} else {
+				action[*info.Key] = info.StopAction
+			}
+		}
+	}
+
+	sort.SliceStable(vms, func(i, j int) bool {
+		if start {
+			return order[vms[i]] < order[vms[j]]
+		}
+		return order[vms[i]] > order[vms[j]]
+	})
+
+	return vms, action
+}
+
+// power changes the power state of the vApp's child VMs, in start order when powering on and reverse order otherwise.
+// VMs already in the requested state are skipped, as are VMs with a start or stop action of "none" unless force is true.
+func (a *VirtualApp) power(ctx *Context, state types.VirtualMachinePowerState, force bool) types.BaseMethodFault {
+	vms, action := a.powerOrder(state == types.VirtualMachinePowerStatePoweredOn)
+
+	for _, ref := range vms {
+		vm := ctx.Map.Get(ref).(*VirtualMachine)
+		if (!force && action[ref] == string(types.VAppAutoStartActionNone)) || vm.Runtime.PowerState == state {
+			continue
+		}
+
+		var task types.ManagedObjectReference
+		switch state {
+		case types.VirtualMachinePowerStatePoweredOn:
+			res := vm.PowerOnVMTask(ctx, &types.PowerOnVM_Task{This: ref})
+			if res.Fault() != nil {
+				return res.Fault().VimFault().(types.BaseMethodFault)
+			}
+			task = res.(*methods.PowerOnVM_TaskBody).Res.Returnval
+		default:
+			res := vm.PowerOffVMTask(ctx, &types.PowerOffVM_Task{This: ref})
+			task = res.(*methods.PowerOffVM_TaskBody).Res.Returnval
+		}
+
+		ctask := Map.Get(task).(*Task)
+		ctask.Wait()
+		if ctask.Info.Error != nil {
+			return ctask.Info.Error.Fault
+		}
+	}
+
+	return nil
+}
+
+func (a *VirtualApp) PowerOnVAppTask(ctx *Context, req *types.PowerOnVApp_Task) soap.HasFault {
+	task := CreateTask(a, "powerOnVApp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		return nil, a.power(ctx, types.VirtualMachinePowerStatePoweredOn, false)
+	})
+
+	return &methods.PowerOnVApp_TaskBody{
+		Res: &types.PowerOnVApp_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (a *VirtualApp) PowerOffVAppTask(ctx *Context, req *types.PowerOffVApp_Task) soap.HasFault {
+	task := CreateTask(a, "powerOffVApp", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		return nil, a.power(ctx, types.VirtualMachinePowerStatePoweredOff, req.Force)
+	})
+
+	return &methods.PowerOffVApp_TaskBody{
+		Res: &types.PowerOffVApp_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (a *VirtualApp) DestroyTask(ctx *Context, req *types.Destroy_Task) soap.HasFault {
 	return (&ResourcePool{ResourcePool: a.ResourcePool}).DestroyTask(ctx, req)
 }
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"time"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// ScheduledTaskManager stores scheduled tasks, but does not run them.
+type ScheduledTaskManager struct {
+	mo.ScheduledTaskManager
+}
+
+type ScheduledTask struct {
+	mo.ScheduledTask
+
+	m *ScheduledTaskManager
+}
+
+func (m *ScheduledTaskManager) CreateScheduledTask(ctx *Context, req *types.CreateScheduledTask) soap.HasFault {
+	body := new(methods.CreateScheduledTaskBody)
+
+	if ctx.Map.Get(req.Entity) == nil {
+		body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: req.Entity})
+		return body
+	}
+
+	spec := req.Spec.GetScheduledTaskSpec()
+
+	for _, ref := range m.ScheduledTask {
+		task := ctx.Map.Get(ref).(*ScheduledTask)
+		if task.Info.Name == spec.Name {
+			body.Fault_ = Fault("", &types.DuplicateName{Name: spec.Name, Object: ref})
+			return body
+		}
+	}
+
+	task := &ScheduledTask{m: m}
+	task.Info.ScheduledTaskSpec = *spec
+	task.Info.Entity = req.Entity
+	task.Info.LastModifiedTime = time.Now()
+	task.Info.LastModifiedUser = ctx.Session.UserName
+	task.Info.State = types.TaskInfoStateQueued
+
+	ctx.Map.Put(task)
+	task.Info.ScheduledTask = task.Self
+
+	m.ScheduledTask = append(m.ScheduledTask, task.Self)
+
+	body.Res = &types.CreateScheduledTaskResponse{
+		Returnval: task.Self,
+	}
+
+	return body
+}
+
+func (m *ScheduledTaskManager) RetrieveEntityScheduledTask(ctx *Context, req *types.RetrieveEntityScheduledTask) soap.HasFault {
+	var refs []types.ManagedObjectReference
+
+	for _, ref := range m.ScheduledTask {
+		task := ctx.Map.Get(ref).(*ScheduledTask)
+		if req.Entity == nil || *req.Entity == task.Info.Entity {
+			refs = append(refs, ref)
+		}
+	}
+
+	return &methods.RetrieveEntityScheduledTaskBody{
+		Res: &types.RetrieveEntityScheduledTaskResponse{
+			Returnval: refs,
+		},
+	}
+}
+
+func (t *ScheduledTask) RemoveScheduledTask(ctx *Context, req *types.RemoveScheduledTask) soap.HasFault {
+	ctx.Map.RemoveReference(ctx, t.m, &t.m.ScheduledTask, t.Self)
+	ctx.Map.Remove(ctx, t.Self)
+
+	return &methods.RemoveScheduledTaskBody{
+		Res: new(types.RemoveScheduledTaskResponse),
+	}
+}
@@ -97,7 +97,7 @@ func (s *SearchIndex) FindChild(req *types.FindChild) soap.HasFault {
 		children = []types.ManagedObjectReference{e.VmFolder, e.HostFolder, e.DatastoreFolder, e.NetworkFolder}
 	case *Folder:
 		children = e.ChildEntity
-	case *mo.ComputeResource:
+	case *ComputeResource:
 		children = e.Host
 		children = append(children, *e.ResourcePool)
 	case *ClusterComputeResource:
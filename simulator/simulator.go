@@ -77,6 +77,8 @@ type Service struct {
 	funcs  []handleFunc
 	delay  *DelayConfig
 
+	progress map[string]int
+
 	readAll func(io.Reader) ([]byte, error)
 
 	Listen   *url.URL
@@ -204,6 +206,10 @@ func (s *Service) call(ctx *Context, method *Method) soap.HasFault {
 		}
 	}
 
+	if res, ok := override(ctx, method); ok {
+		return res
+	}
+
 	// We have a valid call. Introduce a delay if requested
 	if s.delay != nil {
 		s.delay.delay(method.Name)
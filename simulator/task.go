@@ -109,6 +109,7 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 
 	go func() {
 		TaskDelay.delay(t.Info.Name)
+		t.progress()
 		res, err := t.Execute(t)
 		unlock()
 
@@ -133,6 +134,32 @@ func (t *Task) Run(ctx *Context) types.ManagedObjectReference {
 	return t.Self
 }
 
+// taskProgressSteps is the number of info.progress updates made by Task.progress
+const taskProgressSteps = 10
+
+// progress updates info.progress from 0 to 100 over the duration configured via Model.TaskProgress, if any.
+func (t *Task) progress() {
+	if t.ctx == nil || t.ctx.svc == nil {
+		return
+	}
+
+	ms := t.ctx.svc.progress[t.Info.Name]
+	if ms <= 0 {
+		return
+	}
+
+	interval := time.Duration(ms) * time.Millisecond / taskProgressSteps
+
+	for i := 0; i <= taskProgressSteps; i++ {
+		if i != 0 {
+			time.Sleep(interval)
+		}
+		Map.AtomicUpdate(t.ctx, t, []types.PropertyChange{
+			{Name: "info.progress", Val: int32(i * 100 / taskProgressSteps)},
+		})
+	}
+}
+
 // RunBlocking() should only be used when an async simulator task needs to wait
 // on another async simulator task.
 // It polls for task completion to avoid the need to set up a PropertyCollector.
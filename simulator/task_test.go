@@ -17,9 +17,13 @@ limitations under the License.
 package simulator
 
 import (
+	"context"
 	"testing"
 
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/progress"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
@@ -64,3 +68,63 @@ func TestNewTask(t *testing.T) {
 		t.Fail()
 	}
 }
+
+type progressRecorder struct {
+	values []float32
+	done   chan struct{}
+}
+
+func (r *progressRecorder) Sink() chan<- progress.Report {
+	ch := make(chan progress.Report)
+	r.done = make(chan struct{})
+	go func() {
+		for report := range ch {
+			r.values = append(r.values, report.Percentage())
+		}
+		close(r.done)
+	}()
+	return ch
+}
+
+func TestTaskProgress(t *testing.T) {
+	m := VPX()
+	m.TaskProgress = map[string]int{"ReconfigVm": 500}
+
+	Test(func(ctx context.Context, c *vim25.Client) {
+		vm, err := find.NewFinder(c).VirtualMachine(ctx, "DC0_H0_VM0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		spec := types.VirtualMachineConfigSpec{Annotation: "progress"}
+
+		task, err := vm.Reconfigure(ctx, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var r progressRecorder
+		if _, err = task.WaitForResult(ctx, &r); err != nil {
+			t.Fatal(err)
+		}
+
+		<-r.done
+		if len(r.values) < 3 {
+			t.Errorf("progress=%v", r.values)
+		}
+
+		// tasks not configured via Model.TaskProgress complete without progress updates
+		task, err = vm.PowerOff(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var info mo.Task
+		if err = task.Properties(ctx, task.Reference(), []string{"info"}, &info); err != nil {
+			t.Fatal(err)
+		}
+		if info.Info.Progress != 0 {
+			t.Errorf("progress=%d", info.Info.Progress)
+		}
+	}, m)
+}
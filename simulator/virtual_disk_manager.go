@@ -23,6 +23,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/vmware/govmomi/simulator/internal"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -220,6 +221,35 @@ func (m *VirtualDiskManager) QueryVirtualDiskUuid(_ *Context, req *types.QueryVi
 	return body
 }
 
+func (m *VirtualDiskManager) QueryVirtualDiskInfoTask(ctx *Context, req *internal.QueryVirtualDiskInfo_Task) soap.HasFault {
+	task := CreateTask(m, "queryVirtualDiskInfo", func(*Task) (types.AnyType, types.BaseMethodFault) {
+		fm := Map.FileManager()
+
+		file, fault := fm.resolve(req.Datacenter, req.Name)
+		if fault != nil {
+			return nil, fault
+		}
+
+		if _, err := os.Stat(file); err != nil {
+			return nil, fm.fault(req.Name, err, new(types.CannotAccessFile))
+		}
+
+		// Disks created by the simulator have no parents and are always thin provisioned
+		return internal.ArrayOfVirtualDiskInfo{
+			VirtualDiskInfo: []internal.VirtualDiskInfo{{
+				Name:     req.Name,
+				DiskType: string(types.VirtualDiskTypeThin),
+			}},
+		}, nil
+	})
+
+	return &internal.QueryVirtualDiskInfo_TaskBody{
+		Res: &internal.QueryVirtualDiskInfo_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (m *VirtualDiskManager) SetVirtualDiskUuid(_ *Context, req *types.SetVirtualDiskUuid) soap.HasFault {
 	body := new(methods.SetVirtualDiskUuidBody)
 	// TODO: validate uuid format and persist
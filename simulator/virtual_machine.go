@@ -35,6 +35,7 @@ import (
 
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/simulator/esx"
+	"github.com/vmware/govmomi/units"
 	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/soap"
@@ -48,7 +49,9 @@ type VirtualMachine struct {
 	sid int32
 	run container
 	uid uuid.UUID
+	fs  *guestFS
 	imc *types.CustomizationSpec
+	cid int32
 }
 
 func asVirtualMachineMO(obj mo.Reference) (*mo.VirtualMachine, bool) {
@@ -263,6 +266,10 @@ func (vm *VirtualMachine) apply(spec *types.VirtualMachineConfigSpec) {
 		}
 	}
 
+	if spec.ChangeTrackingEnabled != nil {
+		vm.updateDiskChangeIDs()
+	}
+
 	if spec.Flags != nil {
 		vm.Config.Flags = *spec.Flags
 	}
@@ -412,9 +419,69 @@ func (vm *VirtualMachine) configure(ctx *Context, spec *types.VirtualMachineConf
 		}
 	}
 
+	if err := vm.configureVApp(spec); err != nil {
+		return err
+	}
+
 	return vm.configureDevices(ctx, spec)
 }
 
+func (vm *VirtualMachine) configureVApp(spec *types.VirtualMachineConfigSpec) types.BaseMethodFault {
+	if isTrue(spec.VAppConfigRemoved) {
+		vm.Config.VAppConfig = nil
+		return nil
+	}
+
+	if spec.VAppConfig == nil {
+		return nil
+	}
+
+	if vm.Config.VAppConfig == nil {
+		vm.Config.VAppConfig = new(types.VmConfigInfo)
+	}
+
+	vspec := spec.VAppConfig.GetVmConfigSpec()
+	info := vm.Config.VAppConfig.GetVmConfigInfo()
+
+	if len(vspec.OvfEnvironmentTransport) != 0 {
+		info.OvfEnvironmentTransport = vspec.OvfEnvironmentTransport
+	}
+
+	for _, p := range vspec.Property {
+		switch p.Operation {
+		case types.ArrayUpdateOperationAdd:
+			if p.Info == nil {
+				return &types.InvalidArgument{InvalidProperty: "vAppConfig.property.info"}
+			}
+			info.Property = append(info.Property, *p.Info)
+		case types.ArrayUpdateOperationEdit:
+			if p.Info == nil {
+				return &types.InvalidArgument{InvalidProperty: "vAppConfig.property.info"}
+			}
+			found := false
+			for i := range info.Property {
+				if info.Property[i].Key == p.Info.Key {
+					info.Property[i] = *p.Info
+					found = true
+				}
+			}
+			if !found {
+				return &types.InvalidArgument{InvalidProperty: "vAppConfig.property.info.key"}
+			}
+		case types.ArrayUpdateOperationRemove:
+			key, _ := p.RemoveKey.(int32)
+			for i := range info.Property {
+				if info.Property[i].Key == key {
+					info.Property = append(info.Property[:i], info.Property[i+1:]...)
+					break
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func getVMFileType(fileName string) types.VirtualMachineFileLayoutExFileType {
 	var fileType types.VirtualMachineFileLayoutExFileType
 
@@ -1575,6 +1642,63 @@ func (vm *VirtualMachine) RebootGuest(ctx *Context, req *types.RebootGuest) soap
 	return body
 }
 
+func (vm *VirtualMachine) MountToolsInstaller(ctx *Context, req *types.MountToolsInstaller) soap.HasFault {
+	body := new(methods.MountToolsInstallerBody)
+
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		body.Fault_ = Fault("", &types.InvalidPowerState{
+			RequestedState: types.VirtualMachinePowerStatePoweredOn,
+			ExistingState:  vm.Runtime.PowerState,
+		})
+		return body
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{{Name: "runtime.toolsInstallerMounted", Val: true}})
+	body.Res = new(types.MountToolsInstallerResponse)
+
+	return body
+}
+
+func (vm *VirtualMachine) UnmountToolsInstaller(ctx *Context, req *types.UnmountToolsInstaller) soap.HasFault {
+	body := new(methods.UnmountToolsInstallerBody)
+
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		body.Fault_ = Fault("", &types.InvalidPowerState{
+			RequestedState: types.VirtualMachinePowerStatePoweredOn,
+			ExistingState:  vm.Runtime.PowerState,
+		})
+		return body
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{{Name: "runtime.toolsInstallerMounted", Val: false}})
+	body.Res = new(types.UnmountToolsInstallerResponse)
+
+	return body
+}
+
+func (vm *VirtualMachine) UpgradeToolsTask(ctx *Context, req *types.UpgradeTools_Task) soap.HasFault {
+	task := CreateTask(vm, "upgradeTools", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+			return nil, &types.InvalidPowerState{
+				RequestedState: types.VirtualMachinePowerStatePoweredOn,
+				ExistingState:  vm.Runtime.PowerState,
+			}
+		}
+
+		if vm.Guest.ToolsStatus == types.VirtualMachineToolsStatusToolsNotInstalled {
+			return nil, new(types.ToolsUnavailable)
+		}
+
+		return nil, nil
+	})
+
+	return &methods.UpgradeTools_TaskBody{
+		Res: &types.UpgradeTools_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
 func (vm *VirtualMachine) ReconfigVMTask(ctx *Context, req *types.ReconfigVM_Task) soap.HasFault {
 	task := CreateTask(vm, "reconfigVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		ctx.postEvent(&types.VmReconfiguredEvent{
@@ -1609,11 +1733,30 @@ func (vm *VirtualMachine) UpgradeVMTask(ctx *Context, req *types.UpgradeVM_Task)
 	body := &methods.UpgradeVM_TaskBody{}
 
 	task := CreateTask(vm, "upgradeVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
-		if vm.Config.Version != esx.HardwareVersion {
-			Map.Update(vm, []types.PropertyChange{{
-				Name: "config.version", Val: esx.HardwareVersion,
-			}})
+		version := req.Version
+		if version == "" {
+			version = esx.HardwareVersion
+		}
+
+		target, err := strconv.Atoi(strings.TrimPrefix(version, "vmx-"))
+		if err != nil || !strings.HasPrefix(version, "vmx-") {
+			return nil, &types.InvalidArgument{InvalidProperty: "version"}
 		}
+
+		current, err := strconv.Atoi(strings.TrimPrefix(vm.Config.Version, "vmx-"))
+		if err == nil && target <= current {
+			return nil, new(types.AlreadyUpgraded)
+		}
+
+		// esx.HardwareVersion is the only version reported by EnvironmentBrowser.QueryConfigOptionDescriptor
+		if version != esx.HardwareVersion {
+			return nil, new(types.NotSupported)
+		}
+
+		Map.Update(vm, []types.PropertyChange{{
+			Name: "config.version", Val: version,
+		}})
+
 		return nil, nil
 	})
 
@@ -1761,6 +1904,7 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 		if req.Spec.Config != nil {
 			config.ExtraConfig = req.Spec.Config.ExtraConfig
 			config.InstanceUuid = req.Spec.Config.InstanceUuid
+			config.Uuid = req.Spec.Config.Uuid
 		}
 
 		// Copying hardware properties
@@ -1821,6 +1965,26 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 			_ = clone.MarkAsTemplate(&types.MarkAsTemplate{This: clone.Self})
 		}
 
+		if spec := req.Spec.Customization; spec != nil {
+			if len(clone.Guest.Net) != len(spec.NicSettingMap) {
+				return nil, &types.NicSettingMismatch{
+					NumberOfNicsInSpec: int32(len(spec.NicSettingMap)),
+					NumberOfNicsInVM:   int32(len(clone.Guest.Net)),
+				}
+			}
+			clone.imc = spec
+			clone.Config.Tools.PendingCustomization = uuid.New().String()
+		}
+
+		if req.Spec.PowerOn {
+			res := clone.PowerOnVMTask(ctx, &types.PowerOnVM_Task{This: clone.Self})
+			ptask := Map.Get(res.(*methods.PowerOnVM_TaskBody).Res.Returnval).(*Task)
+			ptask.Wait()
+			if ptask.Info.Error != nil {
+				return nil, ptask.Info.Error.Fault
+			}
+		}
+
 		ctx.postEvent(&types.VmClonedEvent{
 			VmCloneEvent: types.VmCloneEvent{VmEvent: clone.event()},
 			SourceVm:     *event.Vm,
@@ -1836,6 +2000,47 @@ func (vm *VirtualMachine) CloneVMTask(ctx *Context, req *types.CloneVM_Task) soa
 	}
 }
 
+func (vm *VirtualMachine) InstantCloneTask(ctx *Context, req *types.InstantClone_Task) soap.HasFault {
+	if vm.Runtime.PowerState != types.VirtualMachinePowerStatePoweredOn {
+		return &methods.InstantClone_TaskBody{
+			Fault_: Fault("", &types.InvalidPowerState{
+				RequestedState: types.VirtualMachinePowerStatePoweredOn,
+				ExistingState:  vm.Runtime.PowerState,
+			}),
+		}
+	}
+
+	folder := *vm.Parent
+	if ref := req.Spec.Location.Folder; ref != nil {
+		if _, ok := asFolderMO(ctx.Map.Get(*ref)); !ok {
+			return &methods.InstantClone_TaskBody{
+				Fault_: Fault("", &types.ManagedObjectNotFound{Obj: *ref}),
+			}
+		}
+		folder = *ref
+	}
+
+	res := vm.CloneVMTask(ctx, &types.CloneVM_Task{
+		This:   vm.Self,
+		Folder: folder,
+		Name:   req.Spec.Name,
+		Spec: types.VirtualMachineCloneSpec{
+			Location: req.Spec.Location,
+			Config: &types.VirtualMachineConfigSpec{
+				ExtraConfig: req.Spec.Config,
+				Uuid:        req.Spec.BiosUuid,
+			},
+			PowerOn: true,
+		},
+	})
+
+	return &methods.InstantClone_TaskBody{
+		Res: &types.InstantClone_TaskResponse{
+			Returnval: res.(*methods.CloneVM_TaskBody).Res.Returnval,
+		},
+	}
+}
+
 func (vm *VirtualMachine) RelocateVMTask(ctx *Context, req *types.RelocateVM_Task) soap.HasFault {
 	task := CreateTask(vm, "relocateVm", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
@@ -2001,6 +2206,30 @@ func (vm *VirtualMachine) CustomizeVMTask(ctx *Context, req *types.CustomizeVM_T
 	}
 }
 
+// updateDiskChangeIDs sets a new change ID on disk backings that support changed block tracking,
+// or clears the change IDs if tracking is disabled.
+func (vm *VirtualMachine) updateDiskChangeIDs() {
+	enabled := vm.Config.ChangeTrackingEnabled != nil && *vm.Config.ChangeTrackingEnabled
+
+	for _, device := range object.VirtualDeviceList(vm.Config.Hardware.Device).SelectByType((*types.VirtualDisk)(nil)) {
+		id := ""
+		if enabled {
+			id = fmt.Sprintf("52 %s/%d", vm.uid, atomic.AddInt32(&vm.cid, 1))
+		}
+
+		switch b := device.GetVirtualDevice().Backing.(type) {
+		case *types.VirtualDiskFlatVer2BackingInfo:
+			b.ChangeId = id
+		case *types.VirtualDiskSparseVer2BackingInfo:
+			b.ChangeId = id
+		case *types.VirtualDiskRawDiskMappingVer1BackingInfo:
+			b.ChangeId = id
+		case *types.VirtualDiskRawDiskVer2BackingInfo:
+			b.ChangeId = id
+		}
+	}
+}
+
 func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnapshot_Task) soap.HasFault {
 	task := CreateTask(vm, "createSnapshot", func(t *Task) (types.AnyType, types.BaseMethodFault) {
 		var changes []types.PropertyChange
@@ -2012,6 +2241,10 @@ func (vm *VirtualMachine) CreateSnapshotTask(ctx *Context, req *types.CreateSnap
 		snapshot := &VirtualMachineSnapshot{}
 		snapshot.Vm = vm.Reference()
 		snapshot.Config = *vm.Config
+		// The snapshot retains the current disk change IDs, while the VM's disks start a new sequence
+		snapshot.Config.Hardware = types.VirtualHardware{}
+		deepCopy(&vm.Config.Hardware, &snapshot.Config.Hardware)
+		vm.updateDiskChangeIDs()
 
 		Map.Put(snapshot)
 
@@ -2104,6 +2337,140 @@ func (vm *VirtualMachine) RemoveAllSnapshotsTask(ctx *Context, req *types.Remove
 	}
 }
 
+func (vm *VirtualMachine) ConsolidateVMDisksTask(ctx *Context, req *types.ConsolidateVMDisks_Task) soap.HasFault {
+	task := CreateTask(vm, "consolidateDisks", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		Map.Update(vm, []types.PropertyChange{
+			{Name: "runtime.consolidationNeeded", Val: false},
+		})
+
+		return nil, nil
+	})
+
+	return &methods.ConsolidateVMDisks_TaskBody{
+		Res: &types.ConsolidateVMDisks_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+func (vm *VirtualMachine) PromoteDisksTask(ctx *Context, req *types.PromoteDisks_Task) soap.HasFault {
+	task := CreateTask(vm, "promoteDisks", func(t *Task) (types.AnyType, types.BaseMethodFault) {
+		devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+		keys := make(map[int32]bool)
+
+		for _, disk := range req.Disks {
+			if _, ok := devices.FindByKey(disk.Key).(*types.VirtualDisk); !ok {
+				return nil, &types.InvalidArgument{InvalidProperty: "disks"}
+			}
+			keys[disk.Key] = true
+		}
+
+		if !req.Unlink {
+			return nil, nil
+		}
+
+		promoted := make(object.VirtualDeviceList, len(devices))
+
+		for i, device := range devices {
+			promoted[i] = device
+
+			disk, ok := device.(*types.VirtualDisk)
+			if !ok || (len(keys) != 0 && !keys[disk.Key]) {
+				continue
+			}
+
+			if b, ok := disk.Backing.(*types.VirtualDiskFlatVer2BackingInfo); ok && b.Parent != nil {
+				backing := *b
+				backing.Parent = nil
+				d := *disk
+				d.Backing = &backing
+				promoted[i] = &d
+			}
+		}
+
+		ctx.Map.Update(vm, []types.PropertyChange{
+			{Name: "config.hardware.device", Val: []types.BaseVirtualDevice(promoted)},
+		})
+
+		return nil, nil
+	})
+
+	return &methods.PromoteDisks_TaskBody{
+		Res: &types.PromoteDisks_TaskResponse{
+			Returnval: task.Run(ctx),
+		},
+	}
+}
+
+// changedDiskAreaLength is the maximum Length of a QueryChangedDiskAreas response,
+// such that callers must page through larger disks.
+const changedDiskAreaLength = int64(units.GB)
+
+func (vm *VirtualMachine) QueryChangedDiskAreas(ctx *Context, req *types.QueryChangedDiskAreas) soap.HasFault {
+	body := new(methods.QueryChangedDiskAreasBody)
+
+	devices := object.VirtualDeviceList(vm.Config.Hardware.Device)
+	if req.Snapshot != nil {
+		snapshot, ok := ctx.Map.Get(*req.Snapshot).(*VirtualMachineSnapshot)
+		if !ok {
+			body.Fault_ = Fault("", &types.ManagedObjectNotFound{Obj: *req.Snapshot})
+			return body
+		}
+		devices = snapshot.Config.Hardware.Device
+	}
+
+	disk, ok := devices.FindByKey(req.DeviceKey).(*types.VirtualDisk)
+	if !ok {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "deviceKey"})
+		return body
+	}
+
+	if id, _ := object.DiskChangeID(disk.Backing); id == "" || req.ChangeId == "" {
+		// real vCenter faults with FileFault when changed block tracking is not active
+		body.Fault_ = Fault("", &types.FileFault{File: devices.Name(disk)})
+		return body
+	}
+
+	size := getDiskSize(disk)
+	if req.StartOffset < 0 || req.StartOffset >= size {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "startOffset"})
+		return body
+	}
+
+	length := size - req.StartOffset
+	if length > changedDiskAreaLength {
+		length = changedDiskAreaLength
+	}
+
+	// All areas of the simulated disk are considered allocated and changed
+	body.Res = &types.QueryChangedDiskAreasResponse{
+		Returnval: types.DiskChangeInfo{
+			StartOffset: req.StartOffset,
+			Length:      length,
+			ChangedArea: []types.DiskChangeExtent{{Start: req.StartOffset, Length: length}},
+		},
+	}
+
+	return body
+}
+
+func (vm *VirtualMachine) AnswerVM(ctx *Context, req *types.AnswerVM) soap.HasFault {
+	body := new(methods.AnswerVMBody)
+
+	if vm.Runtime.Question == nil || vm.Runtime.Question.Id != req.QuestionId {
+		body.Fault_ = Fault("", &types.InvalidArgument{InvalidProperty: "questionId"})
+		return body
+	}
+
+	ctx.Map.Update(vm, []types.PropertyChange{
+		{Name: "runtime.question", Val: nil},
+	})
+
+	body.Res = new(types.AnswerVMResponse)
+
+	return body
+}
+
 func (vm *VirtualMachine) ShutdownGuest(ctx *Context, c *types.ShutdownGuest) soap.HasFault {
 	r := &methods.ShutdownGuestBody{}
 	// should be poweron
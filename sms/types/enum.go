@@ -17,7 +17,9 @@ limitations under the License.
 package types
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -34,6 +36,27 @@ const (
 	AlarmTypeReplicationAlarm   = AlarmType("ReplicationAlarm")
 )
 
+func (e AlarmType) String() string {
+	return string(e)
+}
+
+func ParseAlarmType(s string) (AlarmType, error) {
+	for _, e := range []AlarmType{
+		AlarmTypeSpaceCapacityAlarm,
+		AlarmTypeCapabilityAlarm,
+		AlarmTypeStorageObjectAlarm,
+		AlarmTypeObjectAlarm,
+		AlarmTypeComplianceAlarm,
+		AlarmTypeManageabilityAlarm,
+		AlarmTypeReplicationAlarm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AlarmType: %q", s)
+}
+
 func init() {
 	types.Add("sms:AlarmType", reflect.TypeOf((*AlarmType)(nil)).Elem())
 }
@@ -46,6 +69,23 @@ const (
 	BackingStoragePoolTypeThinAndDeduplicationCombinedPool = BackingStoragePoolType("thinAndDeduplicationCombinedPool")
 )
 
+func (e BackingStoragePoolType) String() string {
+	return string(e)
+}
+
+func ParseBackingStoragePoolType(s string) (BackingStoragePoolType, error) {
+	for _, e := range []BackingStoragePoolType{
+		BackingStoragePoolTypeThinProvisioningPool,
+		BackingStoragePoolTypeDeduplicationPool,
+		BackingStoragePoolTypeThinAndDeduplicationCombinedPool,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BackingStoragePoolType: %q", s)
+}
+
 func init() {
 	types.Add("sms:BackingStoragePoolType", reflect.TypeOf((*BackingStoragePoolType)(nil)).Elem())
 }
@@ -59,6 +99,24 @@ const (
 	BlockDeviceInterfaceOtherBlock = BlockDeviceInterface("otherBlock")
 )
 
+func (e BlockDeviceInterface) String() string {
+	return string(e)
+}
+
+func ParseBlockDeviceInterface(s string) (BlockDeviceInterface, error) {
+	for _, e := range []BlockDeviceInterface{
+		BlockDeviceInterfaceFc,
+		BlockDeviceInterfaceIscsi,
+		BlockDeviceInterfaceFcoe,
+		BlockDeviceInterfaceOtherBlock,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BlockDeviceInterface: %q", s)
+}
+
 func init() {
 	types.Add("sms:BlockDeviceInterface", reflect.TypeOf((*BlockDeviceInterface)(nil)).Elem())
 }
@@ -81,6 +139,33 @@ const (
 	EntityReferenceEntityTypeNasMount     = EntityReferenceEntityType("nasMount")
 )
 
+func (e EntityReferenceEntityType) String() string {
+	return string(e)
+}
+
+func ParseEntityReferenceEntityType(s string) (EntityReferenceEntityType, error) {
+	for _, e := range []EntityReferenceEntityType{
+		EntityReferenceEntityTypeDatacenter,
+		EntityReferenceEntityTypeResourcePool,
+		EntityReferenceEntityTypeStoragePod,
+		EntityReferenceEntityTypeCluster,
+		EntityReferenceEntityTypeVm,
+		EntityReferenceEntityTypeDatastore,
+		EntityReferenceEntityTypeHost,
+		EntityReferenceEntityTypeVmFile,
+		EntityReferenceEntityTypeScsiPath,
+		EntityReferenceEntityTypeScsiTarget,
+		EntityReferenceEntityTypeScsiVolume,
+		EntityReferenceEntityTypeScsiAdapter,
+		EntityReferenceEntityTypeNasMount,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EntityReferenceEntityType: %q", s)
+}
+
 func init() {
 	types.Add("sms:EntityReferenceEntityType", reflect.TypeOf((*EntityReferenceEntityType)(nil)).Elem())
 }
@@ -92,6 +177,22 @@ const (
 	FileSystemInterfaceOtherFileSystem = FileSystemInterface("otherFileSystem")
 )
 
+func (e FileSystemInterface) String() string {
+	return string(e)
+}
+
+func ParseFileSystemInterface(s string) (FileSystemInterface, error) {
+	for _, e := range []FileSystemInterface{
+		FileSystemInterfaceNfs,
+		FileSystemInterfaceOtherFileSystem,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FileSystemInterface: %q", s)
+}
+
 func init() {
 	types.Add("sms:FileSystemInterface", reflect.TypeOf((*FileSystemInterface)(nil)).Elem())
 }
@@ -102,6 +203,21 @@ const (
 	FileSystemInterfaceVersionNFSV3_0 = FileSystemInterfaceVersion("NFSV3_0")
 )
 
+func (e FileSystemInterfaceVersion) String() string {
+	return string(e)
+}
+
+func ParseFileSystemInterfaceVersion(s string) (FileSystemInterfaceVersion, error) {
+	for _, e := range []FileSystemInterfaceVersion{
+		FileSystemInterfaceVersionNFSV3_0,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FileSystemInterfaceVersion: %q", s)
+}
+
 func init() {
 	types.Add("sms:FileSystemInterfaceVersion", reflect.TypeOf((*FileSystemInterfaceVersion)(nil)).Elem())
 }
@@ -113,6 +229,22 @@ const (
 	ProviderProfileReplication            = ProviderProfile("Replication")
 )
 
+func (e ProviderProfile) String() string {
+	return string(e)
+}
+
+func ParseProviderProfile(s string) (ProviderProfile, error) {
+	for _, e := range []ProviderProfile{
+		ProviderProfileProfileBasedManagement,
+		ProviderProfileReplication,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProviderProfile: %q", s)
+}
+
 func init() {
 	types.Add("sms:ProviderProfile", reflect.TypeOf((*ProviderProfile)(nil)).Elem())
 }
@@ -127,6 +259,25 @@ const (
 	ReplicationReplicationStateREMOTE_FAILEDOVER = ReplicationReplicationState("REMOTE_FAILEDOVER")
 )
 
+func (e ReplicationReplicationState) String() string {
+	return string(e)
+}
+
+func ParseReplicationReplicationState(s string) (ReplicationReplicationState, error) {
+	for _, e := range []ReplicationReplicationState{
+		ReplicationReplicationStateSOURCE,
+		ReplicationReplicationStateTARGET,
+		ReplicationReplicationStateFAILEDOVER,
+		ReplicationReplicationStateINTEST,
+		ReplicationReplicationStateREMOTE_FAILEDOVER,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationReplicationState: %q", s)
+}
+
 func init() {
 	types.Add("sms:ReplicationReplicationState", reflect.TypeOf((*ReplicationReplicationState)(nil)).Elem())
 }
@@ -139,6 +290,23 @@ const (
 	SmsAlarmStatusYellow = SmsAlarmStatus("Yellow")
 )
 
+func (e SmsAlarmStatus) String() string {
+	return string(e)
+}
+
+func ParseSmsAlarmStatus(s string) (SmsAlarmStatus, error) {
+	for _, e := range []SmsAlarmStatus{
+		SmsAlarmStatusRed,
+		SmsAlarmStatusGreen,
+		SmsAlarmStatusYellow,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SmsAlarmStatus: %q", s)
+}
+
 func init() {
 	types.Add("sms:SmsAlarmStatus", reflect.TypeOf((*SmsAlarmStatus)(nil)).Elem())
 }
@@ -166,6 +334,38 @@ const (
 	SmsEntityTypeReplicationGroupEntity    = SmsEntityType("ReplicationGroupEntity")
 )
 
+func (e SmsEntityType) String() string {
+	return string(e)
+}
+
+func ParseSmsEntityType(s string) (SmsEntityType, error) {
+	for _, e := range []SmsEntityType{
+		SmsEntityTypeStorageArrayEntity,
+		SmsEntityTypeStorageProcessorEntity,
+		SmsEntityTypeStoragePortEntity,
+		SmsEntityTypeStorageLunEntity,
+		SmsEntityTypeStorageFileSystemEntity,
+		SmsEntityTypeStorageCapabilityEntity,
+		SmsEntityTypeCapabilitySchemaEntity,
+		SmsEntityTypeCapabilityProfileEntity,
+		SmsEntityTypeDefaultProfileEntity,
+		SmsEntityTypeResourceAssociationEntity,
+		SmsEntityTypeStorageContainerEntity,
+		SmsEntityTypeStorageObjectEntity,
+		SmsEntityTypeMessageCatalogEntity,
+		SmsEntityTypeProtocolEndpointEntity,
+		SmsEntityTypeVirtualVolumeInfoEntity,
+		SmsEntityTypeBackingStoragePoolEntity,
+		SmsEntityTypeFaultDomainEntity,
+		SmsEntityTypeReplicationGroupEntity,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SmsEntityType: %q", s)
+}
+
 func init() {
 	types.Add("sms:SmsEntityType", reflect.TypeOf((*SmsEntityType)(nil)).Elem())
 }
@@ -179,6 +379,24 @@ const (
 	SmsTaskStateError   = SmsTaskState("error")
 )
 
+func (e SmsTaskState) String() string {
+	return string(e)
+}
+
+func ParseSmsTaskState(s string) (SmsTaskState, error) {
+	for _, e := range []SmsTaskState{
+		SmsTaskStateQueued,
+		SmsTaskStateRunning,
+		SmsTaskStateSuccess,
+		SmsTaskStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SmsTaskState: %q", s)
+}
+
 func init() {
 	types.Add("sms:SmsTaskState", reflect.TypeOf((*SmsTaskState)(nil)).Elem())
 }
@@ -191,6 +409,23 @@ const (
 	ThinProvisioningStatusGREEN  = ThinProvisioningStatus("GREEN")
 )
 
+func (e ThinProvisioningStatus) String() string {
+	return string(e)
+}
+
+func ParseThinProvisioningStatus(s string) (ThinProvisioningStatus, error) {
+	for _, e := range []ThinProvisioningStatus{
+		ThinProvisioningStatusRED,
+		ThinProvisioningStatusYELLOW,
+		ThinProvisioningStatusGREEN,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ThinProvisioningStatus: %q", s)
+}
+
 func init() {
 	types.Add("sms:ThinProvisioningStatus", reflect.TypeOf((*ThinProvisioningStatus)(nil)).Elem())
 }
@@ -202,6 +437,22 @@ const (
 	VasaAuthenticationTypeUseSessionId = VasaAuthenticationType("UseSessionId")
 )
 
+func (e VasaAuthenticationType) String() string {
+	return string(e)
+}
+
+func ParseVasaAuthenticationType(s string) (VasaAuthenticationType, error) {
+	for _, e := range []VasaAuthenticationType{
+		VasaAuthenticationTypeLoginByToken,
+		VasaAuthenticationTypeUseSessionId,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VasaAuthenticationType: %q", s)
+}
+
 func init() {
 	types.Add("sms:VasaAuthenticationType", reflect.TypeOf((*VasaAuthenticationType)(nil)).Elem())
 }
@@ -219,6 +470,28 @@ const (
 	VasaProfileStorageDrsFileSystem  = VasaProfile("storageDrsFileSystem")
 )
 
+func (e VasaProfile) String() string {
+	return string(e)
+}
+
+func ParseVasaProfile(s string) (VasaProfile, error) {
+	for _, e := range []VasaProfile{
+		VasaProfileBlockDevice,
+		VasaProfileFileSystem,
+		VasaProfileCapability,
+		VasaProfilePolicy,
+		VasaProfileObject,
+		VasaProfileStatistics,
+		VasaProfileStorageDrsBlockDevice,
+		VasaProfileStorageDrsFileSystem,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VasaProfile: %q", s)
+}
+
 func init() {
 	types.Add("sms:VasaProfile", reflect.TypeOf((*VasaProfile)(nil)).Elem())
 }
@@ -233,6 +506,25 @@ const (
 	VasaProviderCertificateStatusInvalid                = VasaProviderCertificateStatus("invalid")
 )
 
+func (e VasaProviderCertificateStatus) String() string {
+	return string(e)
+}
+
+func ParseVasaProviderCertificateStatus(s string) (VasaProviderCertificateStatus, error) {
+	for _, e := range []VasaProviderCertificateStatus{
+		VasaProviderCertificateStatusValid,
+		VasaProviderCertificateStatusExpirySoftLimitReached,
+		VasaProviderCertificateStatusExpiryHardLimitReached,
+		VasaProviderCertificateStatusExpired,
+		VasaProviderCertificateStatusInvalid,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VasaProviderCertificateStatus: %q", s)
+}
+
 func init() {
 	types.Add("sms:VasaProviderCertificateStatus", reflect.TypeOf((*VasaProviderCertificateStatus)(nil)).Elem())
 }
@@ -245,6 +537,23 @@ const (
 	VasaProviderProfileCapability  = VasaProviderProfile("capability")
 )
 
+func (e VasaProviderProfile) String() string {
+	return string(e)
+}
+
+func ParseVasaProviderProfile(s string) (VasaProviderProfile, error) {
+	for _, e := range []VasaProviderProfile{
+		VasaProviderProfileBlockDevice,
+		VasaProviderProfileFileSystem,
+		VasaProviderProfileCapability,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VasaProviderProfile: %q", s)
+}
+
 func init() {
 	types.Add("sms:VasaProviderProfile", reflect.TypeOf((*VasaProviderProfile)(nil)).Elem())
 }
@@ -260,6 +569,26 @@ const (
 	VasaProviderStatusDisconnected = VasaProviderStatus("disconnected")
 )
 
+func (e VasaProviderStatus) String() string {
+	return string(e)
+}
+
+func ParseVasaProviderStatus(s string) (VasaProviderStatus, error) {
+	for _, e := range []VasaProviderStatus{
+		VasaProviderStatusOnline,
+		VasaProviderStatusOffline,
+		VasaProviderStatusSyncError,
+		VasaProviderStatusUnknown,
+		VasaProviderStatusConnected,
+		VasaProviderStatusDisconnected,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VasaProviderStatus: %q", s)
+}
+
 func init() {
 	types.Add("sms:VasaProviderStatus", reflect.TypeOf((*VasaProviderStatus)(nil)).Elem())
 }
@@ -271,6 +600,22 @@ const (
 	VpCategoryExternal = VpCategory("external")
 )
 
+func (e VpCategory) String() string {
+	return string(e)
+}
+
+func ParseVpCategory(s string) (VpCategory, error) {
+	for _, e := range []VpCategory{
+		VpCategoryInternal,
+		VpCategoryExternal,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpCategory: %q", s)
+}
+
 func init() {
 	types.Add("sms:VpCategory", reflect.TypeOf((*VpCategory)(nil)).Elem())
 }
@@ -283,6 +628,23 @@ const (
 	VpTypeUNKNOWN     = VpType("UNKNOWN")
 )
 
+func (e VpType) String() string {
+	return string(e)
+}
+
+func ParseVpType(s string) (VpType, error) {
+	for _, e := range []VpType{
+		VpTypePERSISTENCE,
+		VpTypeDATASERVICE,
+		VpTypeUNKNOWN,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VpType: %q", s)
+}
+
 func init() {
 	types.Add("sms:VpType", reflect.TypeOf((*VpType)(nil)).Elem())
 }
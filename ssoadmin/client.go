@@ -18,6 +18,8 @@ package ssoadmin
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/pem"
 	"path"
 	"reflect"
 	"strings"
@@ -157,6 +159,47 @@ func (c *Client) UpdateSolutionUser(ctx context.Context, name string, details ty
 	return err
 }
 
+// solutionCertificate returns the base64 encoded DER form of the given PEM or DER encoded certificate.
+func solutionCertificate(cert []byte) string {
+	if block, _ := pem.Decode(cert); block != nil {
+		cert = block.Bytes
+	}
+	return base64.StdEncoding.EncodeToString(cert)
+}
+
+// CreateSolutionUserWithRole creates a solution user with the given PEM or DER encoded certificate
+// and sets the user's role, such as types.RoleAdministrator.
+// If the solution user already exists, its certificate is updated instead.
+// The returned principal name is in the form name@domain.
+func (c *Client) CreateSolutionUserWithRole(ctx context.Context, name string, cert []byte, role string) (string, error) {
+	id := c.parseID(name)
+
+	user, err := c.FindSolutionUser(ctx, id.Name)
+	if err != nil {
+		return "", err
+	}
+
+	details := types.AdminSolutionDetails{
+		Certificate: solutionCertificate(cert),
+	}
+
+	if user == nil {
+		err = c.CreateSolutionUser(ctx, id.Name, details)
+	} else {
+		details.Description = user.Details.Description
+		err = c.UpdateSolutionUser(ctx, id.Name, details)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = c.SetRole(ctx, id, role); err != nil {
+		return "", err
+	}
+
+	return id.Name + "@" + id.Domain, nil
+}
+
 func (c *Client) DeletePrincipal(ctx context.Context, name string) error {
 	req := types.DeleteLocalPrincipal{
 		This:          c.ServiceContent.PrincipalManagementService,
@@ -18,6 +18,7 @@ package ssoadmin
 
 import (
 	"context"
+	"encoding/pem"
 	"log"
 	"os"
 	"testing"
@@ -58,3 +59,17 @@ func TestClient(t *testing.T) {
 
 	// sts/client_test.go tests the success paths
 }
+
+func TestSolutionCertificate(t *testing.T) {
+	der := []byte("not really DER")
+	expect := "bm90IHJlYWxseSBERVI="
+
+	if c := solutionCertificate(der); c != expect {
+		t.Errorf("der=%s", c)
+	}
+
+	block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	if c := solutionCertificate(block); c != expect {
+		t.Errorf("pem=%s", c)
+	}
+}
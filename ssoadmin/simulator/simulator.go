@@ -0,0 +1,205 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"sync"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/ssoadmin"
+	"github.com/vmware/govmomi/ssoadmin/methods"
+	"github.com/vmware/govmomi/ssoadmin/types"
+	"github.com/vmware/govmomi/vim25/soap"
+	vim "github.com/vmware/govmomi/vim25/types"
+)
+
+// Domain is the local SSO domain of the simulator.
+const Domain = "vsphere.local"
+
+var content = types.AdminServiceContent{
+	PrincipalDiscoveryService:  vim.ManagedObjectReference{Type: "SsoAdminPrincipalDiscoveryService", Value: "principalDiscoveryService"},
+	PrincipalManagementService: vim.ManagedObjectReference{Type: "SsoAdminPrincipalManagementService", Value: "principalManagementService"},
+	RoleManagementService:      vim.ManagedObjectReference{Type: "SsoAdminRoleManagementService", Value: "roleManagementService"},
+}
+
+var groupcheck = types.GroupcheckServiceContent{
+	GroupCheckService: vim.ManagedObjectReference{Type: "SsoGroupcheckGroupCheckService", Value: "groupCheckService"},
+}
+
+func init() {
+	simulator.RegisterEndpoint(func(s *simulator.Service, r *simulator.Registry) {
+		if r.IsVPX() {
+			s.RegisterSDK(New())
+		}
+	})
+}
+
+// New creates an ssoadmin simulator Registry, currently limited to solution user and role management.
+func New() *simulator.Registry {
+	r := simulator.NewRegistry()
+	r.Namespace = ssoadmin.Namespace
+	r.Path = ssoadmin.Path
+
+	dir := &directory{
+		users: make(map[string]*types.AdminSolutionUser),
+		roles: make(map[types.PrincipalId]string),
+	}
+
+	r.Put(&ServiceInstance{
+		ManagedObjectReference: ssoadmin.ServiceInstance,
+		Content:                content,
+	})
+	r.Put(&GroupcheckServiceInstance{
+		ManagedObjectReference: vim.ManagedObjectReference{Type: "SsoGroupcheckServiceInstance", Value: "ServiceInstance"},
+		Content:                groupcheck,
+	})
+	r.Put(&PrincipalDiscoveryService{
+		ManagedObjectReference: content.PrincipalDiscoveryService,
+		directory:              dir,
+	})
+	r.Put(&PrincipalManagementService{
+		ManagedObjectReference: content.PrincipalManagementService,
+		directory:              dir,
+	})
+	r.Put(&RoleManagementService{
+		ManagedObjectReference: content.RoleManagementService,
+		directory:              dir,
+	})
+
+	return r
+}
+
+// directory holds the principals shared by the service objects.
+// Its embedded sync.Mutex makes those objects a sync.Locker, such that the simulator locks the directory for each call.
+type directory struct {
+	sync.Mutex
+
+	users map[string]*types.AdminSolutionUser
+	roles map[types.PrincipalId]string
+}
+
+type ServiceInstance struct {
+	vim.ManagedObjectReference
+
+	Content types.AdminServiceContent
+}
+
+func (s *ServiceInstance) SsoAdminServiceInstance(_ *types.SsoAdminServiceInstance) soap.HasFault {
+	return &methods.SsoAdminServiceInstanceBody{
+		Res: &types.SsoAdminServiceInstanceResponse{
+			Returnval: s.Content,
+		},
+	}
+}
+
+type GroupcheckServiceInstance struct {
+	vim.ManagedObjectReference
+
+	Content types.GroupcheckServiceContent
+}
+
+func (s *GroupcheckServiceInstance) SsoGroupcheckServiceInstance(_ *types.SsoGroupcheckServiceInstance) soap.HasFault {
+	return &methods.SsoGroupcheckServiceInstanceBody{
+		Res: &types.SsoGroupcheckServiceInstanceResponse{
+			Returnval: s.Content,
+		},
+	}
+}
+
+type PrincipalDiscoveryService struct {
+	vim.ManagedObjectReference
+
+	*directory
+}
+
+func (s *PrincipalDiscoveryService) FindSolutionUser(req *types.FindSolutionUser) soap.HasFault {
+	body := &methods.FindSolutionUserBody{
+		Res: new(types.FindSolutionUserResponse),
+	}
+
+	if user, ok := s.users[req.UserName]; ok {
+		u := *user
+		body.Res.Returnval = &u
+	}
+
+	return body
+}
+
+type PrincipalManagementService struct {
+	vim.ManagedObjectReference
+
+	*directory
+}
+
+func (s *PrincipalManagementService) CreateLocalSolutionUser(req *types.CreateLocalSolutionUser) soap.HasFault {
+	body := new(methods.CreateLocalSolutionUserBody)
+
+	if _, ok := s.users[req.UserName]; ok {
+		body.Fault_ = simulator.Fault("", &vim.AlreadyExists{Name: req.UserName})
+		return body
+	}
+
+	s.users[req.UserName] = &types.AdminSolutionUser{
+		Id:      types.PrincipalId{Name: req.UserName, Domain: Domain},
+		Details: req.UserDetails,
+	}
+
+	body.Res = new(types.CreateLocalSolutionUserResponse)
+
+	return body
+}
+
+func (s *PrincipalManagementService) UpdateLocalSolutionUserDetails(req *types.UpdateLocalSolutionUserDetails) soap.HasFault {
+	body := new(methods.UpdateLocalSolutionUserDetailsBody)
+
+	user, ok := s.users[req.UserName]
+	if !ok {
+		body.Fault_ = simulator.Fault("", &vim.NotFound{})
+		return body
+	}
+
+	user.Details = req.UserDetails
+
+	body.Res = new(types.UpdateLocalSolutionUserDetailsResponse)
+
+	return body
+}
+
+type RoleManagementService struct {
+	vim.ManagedObjectReference
+
+	*directory
+}
+
+// SetRole returns true if the role of the given user was changed.
+func (s *RoleManagementService) SetRole(req *types.SetRole) soap.HasFault {
+	body := new(methods.SetRoleBody)
+
+	id := types.PrincipalId{Name: req.UserId.Name, Domain: req.UserId.Domain}
+
+	if _, ok := s.users[id.Name]; !ok || id.Domain != Domain {
+		body.Fault_ = simulator.Fault("", &vim.NotFound{})
+		return body
+	}
+
+	changed := s.roles[id] != req.Role
+	s.roles[id] = req.Role
+
+	body.Res = &types.SetRoleResponse{Returnval: changed}
+
+	return body
+}
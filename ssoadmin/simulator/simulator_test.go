@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"testing"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/ssoadmin"
+	"github.com/vmware/govmomi/ssoadmin/types"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestCreateSolutionUserWithRole(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c, err := ssoadmin.NewClient(ctx, vc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		certs := [][]byte{[]byte("cert-1"), []byte("cert-2")}
+
+		for _, cert := range certs {
+			// PEM and DER are both accepted, the user is created and then updated
+			block := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert})
+
+			name, err := c.CreateSolutionUserWithRole(ctx, "backup", block, types.RoleAdministrator)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if name != "backup@"+Domain {
+				t.Errorf("name=%s", name)
+			}
+
+			user, err := c.FindSolutionUser(ctx, "backup")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user == nil {
+				t.Fatal("user not found")
+			}
+			if user.Details.Certificate != base64.StdEncoding.EncodeToString(cert) {
+				t.Errorf("certificate=%s", user.Details.Certificate)
+			}
+		}
+
+		// the role is already set
+		changed, err := c.SetRole(ctx, types.PrincipalId{Name: "backup", Domain: Domain}, types.RoleAdministrator)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed {
+			t.Error("role was not set")
+		}
+
+		if _, err = c.SetRole(ctx, types.PrincipalId{Name: "enoent", Domain: Domain}, types.RoleAdministrator); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
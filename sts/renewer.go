@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// RenewAttempts is the number of consecutive failed renewals after which a Renewer stops.
+var RenewAttempts = 5
+
+// Renewer keeps a token issued by IssueRenewable valid, re-issuing it shortly before it expires.
+type Renewer struct {
+	c        *Client
+	req      TokenRequest
+	fn       func(*Signer) error
+	attempts int
+	errs     chan error
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	mu     sync.Mutex
+	signer *Signer
+}
+
+// IssueRenewable issues a renewable token as per Issue and starts a background Renewer for the token.
+// Before the token expires, the Renewer requests a new token using Renew if the token is a Holder-of-Key token,
+// falling back to Issue with the original TokenRequest, as Bearer tokens cannot be renewed.
+// The optional fn is called with each new Signer, for example to login with the new token via session.Manager.LoginByToken,
+// such that the bound session remains valid. fn is not called for the initial token, which is returned via Renewer.Signer.
+// The background Renewer runs until ctx is done, Renewer.Stop is called or RenewAttempts consecutive renewals have failed.
+func (c *Client) IssueRenewable(ctx context.Context, req TokenRequest, fn func(*Signer) error) (*Renewer, error) {
+	req.Renewable = true
+
+	s, err := c.Issue(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	r := &Renewer{
+		c:        c,
+		req:      req,
+		fn:       fn,
+		attempts: RenewAttempts,
+		errs:     make(chan error, 1),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		signer:   s,
+	}
+
+	go r.run(ctx)
+
+	return r, nil
+}
+
+// Signer returns the current Signer.
+func (r *Renewer) Signer() *Signer {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.signer
+}
+
+// Errors returns a channel of renewal errors, which is closed when the Renewer stops.
+// Failed renewals are retried, only the most recent error is buffered if the channel is not drained.
+// After RenewAttempts consecutive failures, the Renewer sends a final error wrapping the last failure and stops.
+func (r *Renewer) Errors() <-chan error {
+	return r.errs
+}
+
+// Stop stops the background Renewer and waits for it to exit.
+func (r *Renewer) Stop() {
+	r.cancel()
+	<-r.done
+}
+
+// renewIn returns the duration to wait before renewing the given Signer's token,
+// leaving 1/5th of the token's lifetime as a margin.
+func renewIn(s *Signer) time.Duration {
+	lifetime := s.Lifetime.Expires.Sub(s.Lifetime.Created)
+	return time.Until(s.Lifetime.Expires.Add(-lifetime / 5))
+}
+
+func (r *Renewer) renew(ctx context.Context) (*Signer, error) {
+	s := r.Signer()
+
+	if r.req.Certificate != nil {
+		req := s.NewRequest()
+		req.Lifetime = r.req.Lifetime
+		req.Renewable = true
+
+		ns, err := r.c.Renew(ctx, req)
+		if err == nil {
+			return ns, nil
+		}
+	}
+
+	return r.c.Issue(ctx, r.req)
+}
+
+func (r *Renewer) run(ctx context.Context) {
+	defer close(r.done)
+	defer close(r.errs)
+
+	wait := renewIn(r.Signer())
+	failures := 0
+
+	for {
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		s, err := r.renew(ctx)
+		if err == nil && r.fn != nil {
+			err = r.fn(s)
+		}
+
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			failures++
+			if failures >= r.attempts {
+				err = fmt.Errorf("token renewal failed after %d attempts: %w", failures, err)
+			}
+
+			select {
+			case <-r.errs: // discard the previous error
+			default:
+			}
+			r.errs <- err
+
+			if failures >= r.attempts {
+				return
+			}
+
+			// retry at half the remaining lifetime of the current token
+			wait = time.Until(r.Signer().Lifetime.Expires) / 2
+			if wait < time.Second {
+				wait = time.Second
+			}
+			continue
+		}
+
+		r.mu.Lock()
+		r.signer = s
+		r.mu.Unlock()
+
+		failures = 0
+		wait = renewIn(s)
+	}
+}
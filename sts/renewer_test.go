@@ -0,0 +1,163 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sts
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	_ "github.com/vmware/govmomi/lookup/simulator"
+	"github.com/vmware/govmomi/simulator"
+	_ "github.com/vmware/govmomi/sts/simulator"
+	"github.com/vmware/govmomi/vim25"
+)
+
+func TestRenewer(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c, err := NewClient(ctx, vc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := TokenRequest{
+			Userinfo: url.UserPassword("user", "pass"),
+			Lifetime: time.Minute,
+		}
+
+		r, err := c.IssueRenewable(ctx, req, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Signer().Token == "" {
+			t.Fatal("no token")
+		}
+		if d := renewIn(r.Signer()); d <= 0 {
+			t.Errorf("renewIn=%s", d)
+		}
+		r.Stop()
+
+		// The simulator token lifetime is fixed, use a short lived token to trigger renewal
+		s := &Signer{}
+		s.Lifetime.Created = time.Now()
+		s.Lifetime.Expires = s.Lifetime.Created.Add(250 * time.Millisecond)
+
+		renewed := make(chan *Signer, 1)
+		fail := true
+
+		rctx, cancel := context.WithCancel(ctx)
+		r = &Renewer{
+			c:   c,
+			req: req,
+			fn: func(s *Signer) error {
+				if fail {
+					fail = false
+					return errors.New("login failed")
+				}
+				renewed <- s
+				return nil
+			},
+			attempts: RenewAttempts,
+			errs:     make(chan error, 1),
+			cancel:   cancel,
+			done:     make(chan struct{}),
+			signer:   s,
+		}
+
+		go r.run(rctx)
+		defer r.Stop()
+
+		select {
+		case err = <-r.Errors():
+			if err.Error() != "login failed" {
+				t.Errorf("err=%s", err)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for error")
+		}
+
+		select {
+		case ns := <-renewed:
+			if ns.Token == "" {
+				t.Error("no token")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for renewal")
+		}
+	})
+}
+
+func TestRenewerAttempts(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c, err := NewClient(ctx, vc)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := TokenRequest{
+			Userinfo: url.UserPassword("user", "pass"),
+		}
+
+		s := &Signer{}
+		s.Lifetime.Created = time.Now()
+		s.Lifetime.Expires = s.Lifetime.Created.Add(250 * time.Millisecond)
+
+		failed := errors.New("login failed")
+
+		rctx, cancel := context.WithCancel(ctx)
+		r := &Renewer{
+			c:   c,
+			req: req,
+			fn: func(*Signer) error {
+				return failed
+			},
+			attempts: 2,
+			errs:     make(chan error, 1),
+			cancel:   cancel,
+			done:     make(chan struct{}),
+			signer:   s,
+		}
+
+		go r.run(rctx)
+		defer r.Stop()
+
+		var errs []error
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case err, ok := <-r.Errors():
+				if ok {
+					errs = append(errs, err)
+					continue
+				}
+			case <-timeout:
+				t.Fatal("timeout waiting for the Renewer to stop")
+			}
+			break
+		}
+
+		if len(errs) == 0 {
+			t.Fatal("no errors")
+		}
+		err = errs[len(errs)-1]
+		if !errors.Is(err, failed) || err == failed {
+			t.Errorf("err=%v", err)
+		}
+	})
+}
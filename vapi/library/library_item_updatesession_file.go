@@ -102,6 +102,21 @@ func (c *Manager) GetLibraryItemUpdateSessionFile(ctx context.Context, sessionID
 	return &res, c.Do(ctx, url.Request(http.MethodPost, spec), &res)
 }
 
+// UpdateFileValidation is the result of validating the files of an update session.
+type UpdateFileValidation struct {
+	HasErrors    bool         `json:"has_errors"`
+	MissingFiles []string     `json:"missing_files,omitempty"`
+	InvalidFiles []UpdateFile `json:"invalid_files,omitempty"`
+}
+
+// ValidateLibraryItemUpdateSessionFile validates the files of an update session,
+// reporting any files that are missing or were not transferred correctly.
+func (c *Manager) ValidateLibraryItemUpdateSessionFile(ctx context.Context, sessionID string) (*UpdateFileValidation, error) {
+	url := c.Resource(internal.LibraryItemUpdateSessionFile).WithID(sessionID).WithAction("validate")
+	var res UpdateFileValidation
+	return &res, c.Do(ctx, url.Request(http.MethodPost), &res)
+}
+
 // getContentLengthAndFingerprint gets the number of bytes returned
 // by the URI as well as the SHA1 fingerprint of the peer certificate
 // if the URI's scheme is https.
@@ -0,0 +1,237 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package library
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// UploadOVA creates an OVF library item with the given name and uploads the
+// .ovf descriptor and referenced files from the local OVA at localOvaPath.
+// The files are validated before the update session is completed.
+// The new library item ID is returned.
+func (c *Manager) UploadOVA(ctx context.Context, libraryID, name, localOvaPath string) (string, error) {
+	f, err := os.Open(filepath.Clean(localOvaPath))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	item := Item{LibraryID: libraryID, Name: name, Type: ItemTypeOVF}
+
+	return c.upload(ctx, item, func(session string) error {
+		return c.uploadOVA(ctx, session, tar.NewReader(f))
+	})
+}
+
+// UploadISO creates an ISO library item with the given name and uploads the
+// local file at localIsoPath. The new library item ID is returned.
+func (c *Manager) UploadISO(ctx context.Context, libraryID, name, localIsoPath string) (string, error) {
+	f, err := os.Open(filepath.Clean(localIsoPath))
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	s, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	item := Item{LibraryID: libraryID, Name: name, Type: ItemTypeISO}
+	info := UpdateFile{Name: filepath.Base(localIsoPath), Size: s.Size()}
+
+	return c.upload(ctx, item, func(session string) error {
+		return c.uploadFile(ctx, session, info, f)
+	})
+}
+
+// upload creates the library item and an update session, to which files are added by the given func.
+// The library item is deleted if any step of the update fails.
+func (c *Manager) upload(ctx context.Context, item Item, add func(string) error) (string, error) {
+	var err error
+	item.ID, err = c.CreateLibraryItem(ctx, item)
+	if err != nil {
+		return "", err
+	}
+
+	if err = c.update(ctx, item, add); err != nil {
+		_ = c.DeleteLibraryItem(ctx, &item)
+		return "", err
+	}
+
+	return item.ID, nil
+}
+
+// update drives the update session lifecycle for the given item:
+// create, add files, validate (OVF only), complete and wait for the DONE state.
+func (c *Manager) update(ctx context.Context, item Item, add func(string) error) error {
+	session, err := c.CreateLibraryItemUpdateSession(ctx, Session{LibraryItemID: item.ID})
+	if err != nil {
+		return err
+	}
+
+	err = add(session)
+	if err == nil && item.Type == ItemTypeOVF {
+		var v *UpdateFileValidation
+		v, err = c.ValidateLibraryItemUpdateSessionFile(ctx, session)
+		if err == nil {
+			err = v.err()
+		}
+	}
+	if err != nil {
+		_ = c.FailLibraryItemUpdateSession(ctx, session)
+		return err
+	}
+
+	if err = c.CompleteLibraryItemUpdateSession(ctx, session); err != nil {
+		return err
+	}
+
+	if err = c.WaitOnLibraryItemUpdateSession(ctx, session, 3*time.Second, nil); err != nil {
+		return err
+	}
+
+	s, err := c.GetLibraryItemUpdateSession(ctx, session)
+	if err != nil {
+		return err
+	}
+	if s.State != "DONE" {
+		return fmt.Errorf("library item %q update session %s: %s", item.Name, session, s.State)
+	}
+
+	return nil
+}
+
+// uploadOVA uploads the .ovf and the files it references from an OVA tar stream.
+// Per the OVF spec, the .ovf descriptor must be the first entry, followed by the optional manifest.
+func (c *Manager) uploadOVA(ctx context.Context, session string, r *tar.Reader) error {
+	var refs map[string]bool
+	sums := make(map[string]*Checksum)
+
+	for {
+		h, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if !h.FileInfo().Mode().IsRegular() {
+			continue
+		}
+
+		info := UpdateFile{Name: h.Name, Size: h.Size}
+
+		switch {
+		case refs == nil:
+			if path.Ext(h.Name) != ".ovf" {
+				return fmt.Errorf("ova: expected .ovf descriptor as first entry, found %q", h.Name)
+			}
+			b, err := ioutil.ReadAll(r)
+			if err != nil {
+				return err
+			}
+			e, err := ovf.Unmarshal(bytes.NewReader(b))
+			if err != nil {
+				return fmt.Errorf("failed to parse ovf: %s", err)
+			}
+			refs = make(map[string]bool, len(e.References))
+			for _, ref := range e.References {
+				refs[ref.Href] = true
+			}
+			err = c.uploadFile(ctx, session, info, bytes.NewReader(b))
+			if err != nil {
+				return err
+			}
+		case path.Ext(h.Name) == ".mf":
+			sums, err = ReadManifest(r)
+			if err != nil {
+				return err
+			}
+		case refs[h.Name]:
+			delete(refs, h.Name)
+			info.Checksum = sums[h.Name]
+			if err = c.uploadFile(ctx, session, info, r); err != nil {
+				return err
+			}
+		}
+	}
+
+	if refs == nil {
+		return fmt.Errorf("ova: .ovf descriptor not found")
+	}
+
+	if len(refs) != 0 {
+		var missing []string
+		for name := range refs {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return fmt.Errorf("ova: referenced files not found: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// uploadFile adds a PUSH file to the update session and uploads its content.
+func (c *Manager) uploadFile(ctx context.Context, session string, info UpdateFile, r io.Reader) error {
+	info.SourceType = "PUSH"
+
+	update, err := c.AddLibraryItemFile(ctx, session, info)
+	if err != nil {
+		return err
+	}
+
+	u, err := url.Parse(update.UploadEndpoint.URI)
+	if err != nil {
+		return err
+	}
+
+	p := soap.DefaultUpload
+	p.ContentLength = info.Size
+
+	return c.Upload(ctx, r, u, &p)
+}
+
+func (v *UpdateFileValidation) err() error {
+	if !v.HasErrors {
+		return nil
+	}
+
+	invalid := make([]string, len(v.InvalidFiles))
+	for i := range v.InvalidFiles {
+		invalid[i] = v.InvalidFiles[i].Name
+	}
+
+	return fmt.Errorf("update session validation failed: missing=%v invalid=%v", v.MissingFiles, invalid)
+}
@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package library_test
+
+import (
+	"archive/tar"
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vim25"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+const uploadOVF = `<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1">
+  <References>
+    <File ovf:href="disk1.vmdk" ovf:id="file1" xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1"/>
+  </References>
+</Envelope>
+`
+
+func writeOVA(t *testing.T, name string, files ...string) {
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := tar.NewWriter(f)
+	for i := 0; i < len(files); i += 2 {
+		h := &tar.Header{Name: files[i], Mode: 0600, Size: int64(len(files[i+1]))}
+		if err = w.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(files[i+1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		ds, err := find.NewFinder(vc).DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := library.NewManager(c)
+
+		lib, err := m.CreateLibrary(ctx, library.Library{
+			Name: "upload",
+			Type: "LOCAL",
+			Storage: []library.StorageBackings{{
+				DatastoreID: ds.Reference().Value,
+				Type:        "DATASTORE",
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir, err := ioutil.TempDir("", "library-upload")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		files := func(id string) []string {
+			list, err := m.ListLibraryItemFiles(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, f := range list {
+				names = append(names, f.Name)
+			}
+			sort.Strings(names)
+			return names
+		}
+
+		disk := "disk content"
+		mf := fmt.Sprintf("SHA1(disk1.vmdk)= %x\n", sha1.Sum([]byte(disk)))
+
+		ova := filepath.Join(dir, "vm.ova")
+		writeOVA(t, ova, "vm.ovf", uploadOVF, "vm.mf", mf, "disk1.vmdk", disk)
+
+		id, err := m.UploadOVA(ctx, lib, "vm", ova)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item, err := m.GetLibraryItem(ctx, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if item.Type != library.ItemTypeOVF {
+			t.Errorf("type=%s", item.Type)
+		}
+		if names := fmt.Sprint(files(id)); names != "[disk1.vmdk vm.ovf]" {
+			t.Errorf("files=%s", names)
+		}
+
+		// referenced disk is missing from the OVA
+		ova = filepath.Join(dir, "bad.ova")
+		writeOVA(t, ova, "bad.ovf", uploadOVF)
+
+		_, err = m.UploadOVA(ctx, lib, "bad", ova)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		items, err := m.FindLibraryItems(ctx, library.FindItem{LibraryID: lib, Name: "bad"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(items) != 0 {
+			t.Errorf("failed upload left items: %v", items)
+		}
+
+		iso := filepath.Join(dir, "boot.iso")
+		if err = ioutil.WriteFile(iso, []byte("iso content"), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		id, err = m.UploadISO(ctx, lib, "boot", iso)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if names := fmt.Sprint(files(id)); names != "[boot.iso]" {
+			t.Errorf("files=%s", names)
+		}
+	})
+}
@@ -32,6 +32,7 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"github.com/vmware/govmomi/nfc"
 	"github.com/vmware/govmomi/object"
 	"github.com/vmware/govmomi/ovf"
+	"github.com/vmware/govmomi/property"
 	"github.com/vmware/govmomi/simulator"
 	"github.com/vmware/govmomi/vapi/internal"
 	"github.com/vmware/govmomi/vapi/library"
@@ -51,6 +53,7 @@ import (
 	"github.com/vmware/govmomi/view"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 	vim "github.com/vmware/govmomi/vim25/types"
 )
@@ -711,12 +714,17 @@ func (s *handler) associationID(w http.ResponseWriter, r *http.Request) {
 		if !s.decode(r, w, &spec) {
 			return
 		}
-	case "attach-tag-to-multiple-objects":
+	case "attach-tag-to-multiple-objects", "detach-tag-from-multiple-objects":
 		if !s.decode(r, w, &specs) {
 			return
 		}
 	}
 
+	batch := struct {
+		Success bool             `json:"success"`
+		Errors  tags.BatchErrors `json:"error_messages,omitempty"`
+	}{Success: true}
+
 	switch s.action(r) {
 	case "attach":
 		s.Association[id][*spec.ObjectID] = true
@@ -731,13 +739,50 @@ func (s *handler) associationID(w http.ResponseWriter, r *http.Request) {
 		}
 		OK(w, ids)
 	case "attach-tag-to-multiple-objects":
+		missing := s.missingObjects(specs.ObjectIDs)
 		for _, obj := range specs.ObjectIDs {
+			if missing[obj] {
+				batch.Errors = append(batch.Errors, tags.BatchError{
+					Type:    "cis.tagging.objectNotFound.error",
+					Message: fmt.Sprintf("Object %s:%s not found", obj.Type, obj.Value),
+				})
+				continue
+			}
 			s.Association[id][obj] = true
 		}
-		OK(w)
+		batch.Success = len(batch.Errors) == 0
+		OK(w, batch)
+	case "detach-tag-from-multiple-objects":
+		for _, obj := range specs.ObjectIDs {
+			delete(s.Association[id], obj)
+		}
+		OK(w, batch)
 	}
 }
 
+// missingObjects returns the subset of objs that do not exist in the vim25 inventory.
+func (s *handler) missingObjects(objs []internal.AssociatedObject) map[internal.AssociatedObject]bool {
+	missing := make(map[internal.AssociatedObject]bool)
+
+	err := s.withClient(func(ctx context.Context, c *vim25.Client) error {
+		pc := property.DefaultCollector(c)
+
+		for _, obj := range objs {
+			var e mo.ManagedEntity
+			if err := pc.RetrieveOne(ctx, obj.Reference(), []string{"name"}, &e); err != nil {
+				missing[obj] = true
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		log.Printf("association object lookup failed: %s", err)
+	}
+
+	return missing
+}
+
 func (s *handler) library(w http.ResponseWriter, r *http.Request) {
 	switch r.Method {
 	case http.MethodPost:
@@ -1348,7 +1393,15 @@ func (s *handler) libraryItemUpdateSessionFileID(w http.ResponseWriter, r *http.
 		delete(s.Update, id)
 		OK(w)
 	case "validate":
-		// TODO
+		var res library.UpdateFileValidation
+		for _, f := range up.File {
+			if f.Status == "WAITING_FOR_TRANSFER" {
+				res.MissingFiles = append(res.MissingFiles, f.Name)
+			}
+		}
+		sort.Strings(res.MissingFiles)
+		res.HasErrors = len(res.MissingFiles) != 0
+		OK(w, res)
 	}
 }
 
@@ -1618,7 +1671,9 @@ func (s *handler) libraryItemFileData(w http.ResponseWriter, r *http.Request) {
 	err := s.libraryItemFileCreate(up, name, r.Body)
 	if err != nil {
 		s.error(w, err)
+		return
 	}
+	up.File[id].Status = "READY"
 }
 
 func (s *handler) libraryItemFile(w http.ResponseWriter, r *http.Request) {
@@ -1671,6 +1726,65 @@ func (i *item) ovf() string {
 	return ""
 }
 
+// libraryEnvelope returns the parsed .ovf descriptor of the given item, or nil if it cannot be read.
+func (s *handler) libraryEnvelope(lib *library.Library, item *item) *ovf.Envelope {
+	name := item.ovf()
+	if name == "" {
+		return nil
+	}
+	f, err := os.Open(filepath.Join(libraryPath(lib, item.ID), name))
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	e, err := ovf.Unmarshal(f)
+	if err != nil {
+		return nil
+	}
+	return e
+}
+
+// libraryFilter populates the filter response networks and properties from the given descriptor.
+func libraryFilter(e *ovf.Envelope, res *vcenter.FilterResponse) {
+	if e.Network != nil {
+		for _, n := range e.Network.Networks {
+			res.Networks = append(res.Networks, n.Name)
+		}
+	}
+
+	if e.VirtualSystem == nil {
+		return
+	}
+
+	var props []vcenter.Property
+	for _, s := range e.VirtualSystem.Product {
+		for _, p := range s.Property {
+			prop := vcenter.Property{ID: p.Key, Type: p.Type}
+			if s.Class != nil {
+				prop.ClassID = *s.Class
+			}
+			if s.Instance != nil {
+				prop.InstanceID = *s.Instance
+			}
+			if p.Label != nil {
+				prop.Label = *p.Label
+			}
+			if p.Default != nil {
+				prop.Value = *p.Default
+			}
+			props = append(props, prop)
+		}
+	}
+
+	if len(props) != 0 {
+		res.AdditionalParams = append(res.AdditionalParams, vcenter.AdditionalParams{
+			Class:      vcenter.ClassPropertyParams,
+			Type:       vcenter.TypePropertyParams,
+			Properties: props,
+		})
+	}
+}
+
 func (s *handler) libraryDeploy(ctx context.Context, c *vim25.Client, lib *library.Library, item *item, deploy vcenter.Deploy) (*nfc.LeaseInfo, error) {
 	name := item.ovf()
 	desc, err := ioutil.ReadFile(filepath.Join(libraryPath(lib, item.ID), name))
@@ -1730,7 +1844,7 @@ func (s *handler) libraryDeploy(ctx context.Context, c *vim25.Client, lib *libra
 		case vcenter.TypePropertyParams:
 			for _, prop := range p.Properties {
 				cisp.PropertyMapping = append(cisp.PropertyMapping, types.KeyValue{
-					Key:   prop.ID,
+					Key:   prop.Key(),
 					Value: prop.Value,
 				})
 			}
@@ -1878,6 +1992,9 @@ func (s *handler) libraryItemOVFID(w http.ResponseWriter, r *http.Request) {
 		res := vcenter.FilterResponse{
 			Name: item.Name,
 		}
+		if e := s.libraryEnvelope(lib, item); e != nil {
+			libraryFilter(e, &res)
+		}
 		OK(w, res)
 	default:
 		http.NotFound(w, r)
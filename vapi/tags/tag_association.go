@@ -17,6 +17,7 @@ limitations under the License.
 package tags
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -68,12 +69,9 @@ type batchResponse struct {
 	Errors  BatchErrors `json:"error_messages,omitempty"`
 }
 
-// AttachTagToMultipleObjects attaches a tag ID to multiple managed objects.
-// This operation is idempotent, i.e. if a tag is already attached to the
-// object, then the individual operation is a no-op and no error will be thrown.
-//
-// This operation was added in vSphere API 6.5.
-func (c *Manager) AttachTagToMultipleObjects(ctx context.Context, tagID string, refs []mo.Reference) error {
+// tagMultipleObjects applies the given tag association action to multiple managed objects.
+// Servers prior to vSphere API 6.7 respond without a body, otherwise the response is a batchResponse.
+func (c *Manager) tagMultipleObjects(ctx context.Context, action string, tagID string, refs []mo.Reference) error {
 	id, err := c.tagID(ctx, tagID)
 	if err != nil {
 		return err
@@ -88,8 +86,47 @@ func (c *Manager) AttachTagToMultipleObjects(ctx context.Context, tagID string,
 		ObjectIDs []internal.AssociatedObject `json:"object_ids"`
 	}{ids}
 
-	url := c.Resource(internal.AssociationPath).WithID(id).WithAction("attach-tag-to-multiple-objects")
-	return c.Do(ctx, url.Request(http.MethodPost, spec), nil)
+	var body bytes.Buffer
+	url := c.Resource(internal.AssociationPath).WithID(id).WithAction(action)
+	err = c.Do(ctx, url.Request(http.MethodPost, spec), &body)
+	if err != nil || body.Len() == 0 {
+		return err
+	}
+
+	var res struct {
+		Value *batchResponse `json:"value"`
+	}
+	if err = json.Unmarshal(body.Bytes(), &res); err != nil {
+		return err
+	}
+
+	if res.Value != nil && !res.Value.Success && len(res.Value.Errors) != 0 {
+		return res.Value.Errors
+	}
+
+	return nil
+}
+
+// AttachTagToMultipleObjects attaches a tag ID to multiple managed objects.
+// This operation is idempotent, i.e. if a tag is already attached to the
+// object, then the individual operation is a no-op and no error will be thrown.
+// If the server reports a failure for any of the objects, BatchErrors is returned
+// and can be used to analyse failure reasons on each failed object.
+//
+// This operation was added in vSphere API 6.5.
+func (c *Manager) AttachTagToMultipleObjects(ctx context.Context, tagID string, refs []mo.Reference) error {
+	return c.tagMultipleObjects(ctx, "attach-tag-to-multiple-objects", tagID, refs)
+}
+
+// DetachTagFromMultipleObjects detaches a tag ID from multiple managed objects.
+// This operation is idempotent, i.e. if a tag is not attached to the object,
+// then the individual operation is a no-op and no error will be thrown.
+// If the server reports a failure for any of the objects, BatchErrors is returned
+// and can be used to analyse failure reasons on each failed object.
+//
+// This operation was added in vSphere API 6.7.
+func (c *Manager) DetachTagFromMultipleObjects(ctx context.Context, tagID string, refs []mo.Reference) error {
+	return c.tagMultipleObjects(ctx, "detach-tag-from-multiple-objects", tagID, refs)
 }
 
 // AttachMultipleTagsToObject attaches multiple tag IDs to a managed object.
@@ -28,6 +28,7 @@ import (
 	"github.com/vmware/govmomi/view"
 	"github.com/vmware/govmomi/vim25"
 	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
 )
 
 func TestManager_AttachMultipleTagsToObject(t *testing.T) {
@@ -296,6 +297,60 @@ func TestManager_DetachMultipleTagsFromObject(t *testing.T) {
 	}
 }
 
+func TestManager_TagMultipleObjects(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		m := tags.NewManager(c)
+
+		ids, err := createTags(t, ctx, m, []string{"bulk-tag"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		id := ids["bulk-tag"]
+
+		var refs []mo.Reference
+		for _, vm := range simulator.Map.All("VirtualMachine") {
+			refs = append(refs, vm.Reference())
+		}
+
+		attached := func() int {
+			objs, err := m.ListAttachedObjects(ctx, id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return len(objs)
+		}
+
+		if err = m.AttachTagToMultipleObjects(ctx, id, refs); err != nil {
+			t.Fatal(err)
+		}
+		if n := attached(); n != len(refs) {
+			t.Errorf("attached=%d, expected %d", n, len(refs))
+		}
+
+		if err = m.DetachTagFromMultipleObjects(ctx, id, refs[1:]); err != nil {
+			t.Fatal(err)
+		}
+		if n := attached(); n != 1 {
+			t.Errorf("attached=%d, expected 1", n)
+		}
+
+		// objects which do not exist are reported via BatchErrors, the others are still attached
+		enoent := types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"}
+		err = m.AttachTagToMultipleObjects(ctx, id, append(refs, enoent))
+		if errs, ok := err.(tags.BatchErrors); !ok || len(errs) != 1 {
+			t.Errorf("err=%v", err)
+		}
+		if n := attached(); n != len(refs) {
+			t.Errorf("attached=%d, expected %d", n, len(refs))
+		}
+	})
+}
+
 // createTags creates the given tag to category mappings and returns a map of
 // names to IDs (URNs) for all created tags
 func createTags(t *testing.T, ctx context.Context, mgr *tags.Manager, tagNames []string) (map[string]string, error) {
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/vmware/govmomi/vapi/internal"
 	"github.com/vmware/govmomi/vapi/rest"
@@ -97,6 +99,19 @@ type Property struct {
 	Value       string `json:"value,omitempty"`
 }
 
+// Key returns the property's fully qualified key, in the form "class.id.instance",
+// where the class and instance are omitted if empty.
+func (p Property) Key() string {
+	key := []string{p.ID}
+	if p.ClassID != "" {
+		key = append([]string{p.ClassID}, key...)
+	}
+	if p.InstanceID != "" {
+		key = append(key, p.InstanceID)
+	}
+	return strings.Join(key, ".")
+}
+
 // UnknownSection contains information about an unknown section in an OVF package
 type UnknownSection struct {
 	Tag  string `json:"tag,omitempty"`
@@ -302,3 +317,145 @@ func (c *Manager) FilterLibraryItem(ctx context.Context, libraryItemID string, f
 	var res FilterResponse
 	return res, c.Do(ctx, url.Request(http.MethodPost, filter), &res)
 }
+
+// DeploySpec specifies the placement and OVF mappings used by DeployOVF.
+type DeploySpec struct {
+	// Name of the deployed VM, defaults to the name in the OVF descriptor.
+	Name string
+	// Annotation of the deployed VM, defaults to the annotation in the OVF descriptor.
+	Annotation string
+	// Folder is the target VM folder, if not set the datacenter's default VM folder is used.
+	Folder *types.ManagedObjectReference
+	// ResourcePool is the target resource pool, required.
+	ResourcePool types.ManagedObjectReference
+	// Host is the optional target host.
+	Host *types.ManagedObjectReference
+	// Datastore is the default datastore for the VM's disks.
+	Datastore *types.ManagedObjectReference
+	// Networks maps OVF network names to a vSphere network.
+	Networks map[string]types.ManagedObjectReference
+	// Properties maps OVF property IDs to their value.
+	// A property can also be specified by its Key, which is required when
+	// the same ID is used by more than one class or instance.
+	Properties map[string]string
+	// AcceptAllEULA must be set if the OVF contains an EULA.
+	AcceptAllEULA bool
+}
+
+// DeployOVF deploys the OVF library item using the given spec and returns the new VM reference.
+// The network names and property IDs of the spec are first validated against the item,
+// using FilterLibraryItem, with an error listing each unknown name or ID.
+// The deploy call returns once the deployment has completed.
+func (c *Manager) DeployOVF(ctx context.Context, itemID string, spec DeploySpec) (types.ManagedObjectReference, error) {
+	var ref types.ManagedObjectReference
+
+	target := Target{ResourcePoolID: spec.ResourcePool.Value}
+	if spec.Folder != nil {
+		target.FolderID = spec.Folder.Value
+	}
+	if spec.Host != nil {
+		target.HostID = spec.Host.Value
+	}
+
+	filter, err := c.FilterLibraryItem(ctx, itemID, FilterRequest{Target: target})
+	if err != nil {
+		return ref, err
+	}
+
+	deploy := Deploy{
+		DeploymentSpec: DeploymentSpec{
+			Name:          spec.Name,
+			Annotation:    spec.Annotation,
+			AcceptAllEULA: spec.AcceptAllEULA,
+		},
+		Target: target,
+	}
+	if spec.Datastore != nil {
+		deploy.DefaultDatastoreID = spec.Datastore.Value
+	}
+
+	var problems []string
+
+	valid := make(map[string]bool)
+	for _, name := range filter.Networks {
+		valid[name] = true
+	}
+
+	var names []string
+	for name := range spec.Networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !valid[name] {
+			problems = append(problems, fmt.Sprintf("unknown network %q, valid names=%s", name, filter.Networks))
+			continue
+		}
+		deploy.NetworkMappings = append(deploy.NetworkMappings, NetworkMapping{
+			Key:   name,
+			Value: spec.Networks[name].Value,
+		})
+	}
+
+	var ids []string
+	props := make(map[string]Property)
+	byID := make(map[string][]string)
+	for _, p := range filter.AdditionalParams {
+		if p.Type != TypePropertyParams {
+			continue
+		}
+		for _, prop := range p.Properties {
+			key := prop.Key()
+			ids = append(ids, key)
+			props[key] = prop
+			byID[prop.ID] = append(byID[prop.ID], key)
+		}
+	}
+
+	var keys []string
+	for id := range spec.Properties {
+		keys = append(keys, id)
+	}
+	sort.Strings(keys)
+
+	params := AdditionalParams{Class: ClassPropertyParams, Type: TypePropertyParams}
+	set := make(map[string]string)
+	for _, id := range keys {
+		key := id
+		if _, ok := props[key]; !ok {
+			switch match := byID[id]; len(match) {
+			case 0:
+				problems = append(problems, fmt.Sprintf("unknown property %q, valid ids=%s", id, ids))
+				continue
+			case 1:
+				key = match[0]
+			default:
+				problems = append(problems, fmt.Sprintf("ambiguous property %q, use one of %s", id, match))
+				continue
+			}
+		}
+		if prev, ok := set[key]; ok {
+			problems = append(problems, fmt.Sprintf("property %q set by both %q and %q", key, prev, id))
+			continue
+		}
+		set[key] = id
+		prop := props[key]
+		prop.Value = spec.Properties[id]
+		params.Properties = append(params.Properties, prop)
+	}
+	if len(params.Properties) != 0 {
+		deploy.AdditionalParams = append(deploy.AdditionalParams, params)
+	}
+
+	if len(problems) != 0 {
+		return ref, fmt.Errorf("deploy %s: %s", itemID, strings.Join(problems, "; "))
+	}
+
+	res, err := c.DeployLibraryItem(ctx, itemID, deploy)
+	if err != nil {
+		return ref, err
+	}
+
+	return *res, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vcenter_test
+
+import (
+	"archive/tar"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vapi/library"
+	"github.com/vmware/govmomi/vapi/rest"
+	"github.com/vmware/govmomi/vapi/vcenter"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+
+	_ "github.com/vmware/govmomi/vapi/simulator"
+)
+
+const product = `<ProductSection ovf:class="vm">
+      <Info>Properties</Info>
+      <Property ovf:key="ip0" ovf:type="string" ovf:userConfigurable="true" ovf:value=""/>
+    </ProductSection>
+    <ProductSection ovf:class="app" ovf:instance="1">
+      <Info>Properties</Info>
+      <Property ovf:key="ip0" ovf:type="string" ovf:userConfigurable="true" ovf:value=""/>
+    </ProductSection>
+    `
+
+// writeOVA writes an OVA with the ttylinux descriptor, a ProductSection and an empty disk.
+func writeOVA(t *testing.T, name string) {
+	desc, err := ioutil.ReadFile("../../ovf/fixtures/ttylinux.ovf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ovf := strings.Replace(string(desc), "<OperatingSystemSection", product+"<OperatingSystemSection", 1)
+
+	f, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := tar.NewWriter(f)
+	files := []struct{ name, content string }{
+		{"ttylinux.ovf", ovf},
+		{"ttylinux-pc_i486-16.1-disk1.vmdk", ""},
+	}
+	for _, file := range files {
+		h := &tar.Header{Name: file.name, Mode: 0600, Size: int64(len(file.content))}
+		if err = w.WriteHeader(h); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(file.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDeployOVF(t *testing.T) {
+	simulator.Test(func(ctx context.Context, vc *vim25.Client) {
+		c := rest.NewClient(vc)
+		if err := c.Login(ctx, simulator.DefaultLogin); err != nil {
+			t.Fatal(err)
+		}
+
+		finder := find.NewFinder(vc)
+		ds, err := finder.DefaultDatastore(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pool, err := finder.ResourcePool(ctx, "DC0_H0/Resources")
+		if err != nil {
+			t.Fatal(err)
+		}
+		net, err := finder.Network(ctx, "VM Network")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		lm := library.NewManager(c)
+		lib, err := lm.CreateLibrary(ctx, library.Library{
+			Name: "deploy",
+			Type: "LOCAL",
+			Storage: []library.StorageBackings{{
+				DatastoreID: ds.Reference().Value,
+				Type:        "DATASTORE",
+			}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		dir, err := ioutil.TempDir("", "vcenter-deploy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		ova := filepath.Join(dir, "ttylinux.ova")
+		writeOVA(t, ova)
+
+		item, err := lm.UploadOVA(ctx, lib, "ttylinux", ova)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := vcenter.NewManager(c)
+
+		ds1 := ds.Reference()
+		spec := vcenter.DeploySpec{
+			Name:         "ttylinux-1",
+			ResourcePool: pool.Reference(),
+			Datastore:    &ds1,
+			Networks:     map[string]types.ManagedObjectReference{"bridged": net.Reference()},
+			Properties:   map[string]string{"ip1": "10.0.0.1"},
+		}
+
+		_, err = m.DeployOVF(ctx, item, spec)
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		for _, s := range []string{`unknown network "bridged"`, `unknown property "ip1"`} {
+			if !strings.Contains(err.Error(), s) {
+				t.Errorf("%q not in error: %s", s, err)
+			}
+		}
+
+		spec.Networks = map[string]types.ManagedObjectReference{"nat": net.Reference()}
+		spec.Properties = map[string]string{"ip0": "10.0.0.1"}
+
+		_, err = m.DeployOVF(ctx, item, spec)
+		if err == nil || !strings.Contains(err.Error(), `ambiguous property "ip0"`) {
+			t.Fatalf("expected ambiguous error: %v", err)
+		}
+
+		spec.Properties = map[string]string{"vm.ip0": "10.0.0.1", "app.ip0.1": "10.0.0.2"}
+
+		ref, err := m.DeployOVF(ctx, item, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var vm mo.VirtualMachine
+		err = object.NewVirtualMachine(vc, ref).Properties(ctx, ref, []string{"name", "config.extraConfig"}, &vm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if vm.Name != spec.Name {
+			t.Errorf("name=%s", vm.Name)
+		}
+
+		found := make(map[string]interface{})
+		for _, opt := range vm.Config.ExtraConfig {
+			o := opt.GetOptionValue()
+			found[o.Key] = o.Value
+		}
+		for key, val := range spec.Properties {
+			if found[key] != val {
+				t.Errorf("property %s=%v", key, found[key])
+			}
+		}
+	})
+}
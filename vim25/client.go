@@ -21,7 +21,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/vmware/govmomi/vim25/methods"
@@ -88,6 +90,69 @@ func NewClient(ctx context.Context, rt soap.RoundTripper) (*Client, error) {
 	return &c, nil
 }
 
+// Connect creates a Client for the given URL, negotiating the API version with the endpoint.
+// The opts functions are applied to the soap.Client before any requests are made, to configure TLS for example.
+// The service version is first discovered via UseServiceVersion, falling back to the package Version
+// for endpoints that do not provide vimServiceVersions.xml, such as older ESXi.
+// Once the ServiceContent is retrieved, the soap.Client Version is set to the lower of the package Version and
+// the endpoint's about.apiVersion, such that requests are made with a version supported by both ends.
+// The negotiated version is available via Client.Version.
+func Connect(ctx context.Context, u *url.URL, insecure bool, opts ...func(*soap.Client)) (*Client, error) {
+	sc := soap.NewClient(u, insecure)
+	for _, opt := range opts {
+		opt(sc)
+	}
+
+	if err := (&Client{Client: sc}).UseServiceVersion(); err != nil {
+		sc.Namespace, sc.Version = "", "" // NewClient applies the defaults
+	}
+
+	if newerVersion(sc.Version, Version) {
+		sc.Version = Version
+	}
+
+	c, err := NewClient(ctx, sc)
+	if err != nil {
+		return nil, err
+	}
+
+	c.Version = Version
+	if api := c.ServiceContent.About.ApiVersion; newerVersion(Version, api) {
+		c.Version = api
+	}
+
+	return c, nil
+}
+
+// newerVersion returns true if version a is newer than version b, such as "7.0" compared to "6.7.3".
+// False is returned if either version cannot be parsed, such as development builds.
+func newerVersion(a, b string) bool {
+	parse := func(s string) []int {
+		var v []int
+		for _, p := range strings.Split(s, ".") {
+			i, err := strconv.Atoi(p)
+			if err != nil {
+				return nil
+			}
+			v = append(v, i)
+		}
+		return v
+	}
+
+	va, vb := parse(a), parse(b)
+	if va == nil || vb == nil {
+		return false
+	}
+
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		if va[i] != vb[i] {
+			return va[i] > vb[i]
+		}
+	}
+
+	return len(va) > len(vb)
+}
+
 // UseServiceVersion sets soap.Client.Version to the current version of the service endpoint via /sdk/vimServiceVersions.xml
 func (c *Client) UseServiceVersion(kind ...string) error {
 	ns := "vim"
@@ -185,7 +250,9 @@ func (c *Client) Path() string {
 	return Path
 }
 
-// IsVC returns true if we are connected to a vCenter
+// IsVC returns true if we are connected to a vCenter.
+// The determination is made from the ServiceContent retrieved once by NewClient,
+// so IsVC does not make any requests and is cheap to call in loops.
 func (c *Client) IsVC() bool {
 	return c.ServiceContent.About.ApiType == "VirtualCenter"
 }
@@ -95,3 +95,48 @@ func TestClientSerialization(t *testing.T) {
 	// Check the session is still valid
 	sessionCheck(t, c2)
 }
+
+type failRoundTripper struct {
+	t *testing.T
+}
+
+func (rt failRoundTripper) RoundTrip(ctx context.Context, req, res soap.HasFault) error {
+	rt.t.Fatalf("unexpected RoundTrip: %T", req)
+	return nil
+}
+
+func TestClientIsVC(t *testing.T) {
+	c := &Client{RoundTripper: failRoundTripper{t}}
+
+	for kind, isVC := range map[string]bool{"VirtualCenter": true, "HostAgent": false} {
+		c.ServiceContent.About.ApiType = kind
+
+		for i := 0; i < 3; i++ {
+			if c.IsVC() != isVC {
+				t.Errorf("%s: IsVC=%t", kind, !isVC)
+			}
+		}
+	}
+}
+
+func TestNewerVersion(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		newer bool
+	}{
+		{"7.0", "6.7.3", true},
+		{"6.7.3", "7.0", false},
+		{"7.0", "7.0", false},
+		{"7.0.1.0", "7.0", true},
+		{"7.0", "7.0.1.0", false},
+		{"8.0.1.0", "7.0", true},
+		{"r4A70F", "7.0", false},
+		{"7.0", "", false},
+	}
+
+	for _, test := range tests {
+		if newer := newerVersion(test.a, test.b); newer != test.newer {
+			t.Errorf("newerVersion(%q, %q)=%t", test.a, test.b, newer)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package vim25_test
 import (
 	"context"
 	"fmt"
+	"log"
 	"net/http"
 	"time"
 
@@ -55,3 +56,24 @@ func ExampleTemporaryNetworkError() {
 	})
 	// Output: poweredOn
 }
+
+func ExampleConnect() {
+	model := simulator.VPX()
+	defer model.Remove()
+
+	err := model.Create()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := model.Service.NewServer()
+	defer s.Close()
+
+	c, err := vim25.Connect(context.Background(), s.URL, true)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(c.ServiceContent.About.ApiVersion, c.Version)
+	// Output: 6.5 6.5
+}
@@ -18,6 +18,7 @@ package mo
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/vmware/govmomi/vim25/methods"
@@ -107,6 +108,8 @@ func LoadObjectContent(content []types.ObjectContent, dst interface{}) error {
 	}
 
 	if isSlice {
+		et := rv.Type().Elem()
+
 		for _, p := range content {
 			v, err := ObjectContentToType(p)
 			if err != nil {
@@ -115,12 +118,14 @@ func LoadObjectContent(content []types.ObjectContent, dst interface{}) error {
 
 			vt := reflect.TypeOf(v)
 
-			if !rv.Type().AssignableTo(vt) {
+			if !vt.AssignableTo(et) {
 				// For example: dst is []ManagedEntity, res is []HostSystem
-				if field, ok := vt.FieldByName(rt.Elem().Elem().Name()); ok && field.Anonymous {
-					rv.Set(reflect.Append(rv, reflect.ValueOf(v).FieldByIndex(field.Index)))
-					continue
+				field, ok := vt.FieldByName(et.Name())
+				if !ok || !field.Anonymous {
+					return fmt.Errorf("cannot load %s into %s", p.Obj, et)
 				}
+				rv.Set(reflect.Append(rv, reflect.ValueOf(v).FieldByIndex(field.Index)))
+				continue
 			}
 
 			rv.Set(reflect.Append(rv, reflect.ValueOf(v)))
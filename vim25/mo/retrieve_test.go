@@ -144,6 +144,49 @@ func TestEmbeddedTypePropertySlice(t *testing.T) {
 	}
 }
 
+func TestEmbeddedTypePropertyMixedSlice(t *testing.T) {
+	content := func(kind, id, name string) types.ObjectContent {
+		return types.ObjectContent{
+			Obj:     types.ManagedObjectReference{Type: kind, Value: id},
+			PropSet: []types.DynamicProperty{{Name: "name", Val: name}},
+		}
+	}
+
+	objs := []types.ObjectContent{
+		content("VirtualMachine", "vm-1", "vm"),
+		content("HostSystem", "host-1", "host"),
+		content("ClusterComputeResource", "domain-c1", "cluster"),
+		content("Datacenter", "datacenter-1", "dc"),
+	}
+
+	var me []ManagedEntity
+
+	err := LoadObjectContent(objs, &me)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %s", err)
+	}
+
+	if len(me) != len(objs) {
+		t.Fatalf("Expected %d elements", len(objs))
+	}
+
+	for i, m := range me {
+		if m.Self != objs[i].Obj {
+			t.Errorf("Expected Self=%s, got: %s", objs[i].Obj, m.Self)
+		}
+		if m.Name != objs[i].PropSet[0].Val {
+			t.Errorf("Expected Name=%s, got: %s", objs[i].PropSet[0].Val, m.Name)
+		}
+	}
+
+	objs = append(objs, types.ObjectContent{Obj: types.ManagedObjectReference{Type: "SessionManager", Value: "SessionManager"}})
+
+	err = LoadObjectContent(objs, &me)
+	if err == nil {
+		t.Fatal("Expected error")
+	}
+}
+
 func TestReferences(t *testing.T) {
 	var cr ComputeResource
 
@@ -0,0 +1,158 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Sum is a Sinker that combines the reports of a fixed number of concurrent
+// child sinks into a single aggregate report, such as for the disks of an OVF
+// import uploaded in parallel. Unlike Aggregator, which forwards the reports of
+// one sink at a time, Sum reports the overall percentage across all children.
+type Sum struct {
+	mu    sync.Mutex
+	total int
+	n     int
+
+	upstream   chan sumUpdate
+	downstream chan Report
+}
+
+type sumUpdate struct {
+	i      int
+	r      Report
+	closed bool
+}
+
+// SumReport is the aggregate Report sent by Sum.
+type SumReport struct {
+	percentage float32
+	done       int
+	err        error
+	children   []Report
+}
+
+func (r SumReport) Percentage() float32 {
+	return r.percentage
+}
+
+func (r SumReport) Detail() string {
+	return fmt.Sprintf("%d/%d", r.done, len(r.children))
+}
+
+// Error returns the first error reported by a child sink, if any.
+func (r SumReport) Error() error {
+	return r.err
+}
+
+// Children returns the most recent report of each child sink, in the order the
+// sinks were created. The report of a child that has not yet reported is nil.
+func (r SumReport) Children() []Report {
+	return r.children
+}
+
+// NewSum returns a Sum expecting the given total number of child sinks.
+// Overall percentage is the average of the children's percentage, where a
+// child sink that has not been created or has not yet reported counts as 0%
+// and a child sink closed without error counts as 100%.
+func NewSum(total int) *Sum {
+	s := &Sum{
+		total:      total,
+		upstream:   make(chan sumUpdate),
+		downstream: make(chan Report),
+	}
+
+	go s.loop()
+
+	return s
+}
+
+// Sink returns a channel for the reports of the next child.
+// Sink is safe for concurrent use, but panics if called more than total times.
+func (s *Sum) Sink() chan<- Report {
+	s.mu.Lock()
+	if s.n == s.total {
+		s.mu.Unlock()
+		panic(fmt.Sprintf("progress: more than %d sinks", s.total))
+	}
+	i := s.n
+	s.n++
+	s.mu.Unlock()
+
+	ch := make(chan Report)
+
+	go func() {
+		for r := range ch {
+			s.upstream <- sumUpdate{i: i, r: r}
+		}
+		s.upstream <- sumUpdate{i: i, closed: true}
+	}()
+
+	return ch
+}
+
+// Report returns the aggregate report channel, on which a SumReport is sent for each
+// report of a child sink. The channel is closed once all child sinks have been closed.
+func (s *Sum) Report() <-chan Report {
+	return s.downstream
+}
+
+func (s *Sum) loop() {
+	defer close(s.downstream)
+
+	children := make([]Report, s.total)
+	closed := make([]bool, s.total)
+	done := 0
+
+	for done < s.total {
+		u := <-s.upstream
+
+		if u.closed {
+			closed[u.i] = true
+			done++
+		} else {
+			children[u.i] = u.r
+		}
+
+		r := SumReport{
+			done:     done,
+			children: make([]Report, s.total),
+		}
+
+		copy(r.children, children)
+
+		for i, c := range children {
+			switch {
+			case c != nil && c.Error() != nil:
+				if r.err == nil {
+					r.err = c.Error()
+				}
+				r.percentage += c.Percentage()
+			case closed[i]:
+				r.percentage += 100
+			case c != nil:
+				r.percentage += c.Percentage()
+			}
+		}
+
+		r.percentage /= float32(s.total)
+
+		s.downstream <- r
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSumNoSinks(t *testing.T) {
+	s := NewSum(0)
+
+	_, ok := <-s.Report()
+	if ok {
+		t.Errorf("Expected channel to be closed")
+	}
+}
+
+func TestSumMultipleSinks(t *testing.T) {
+	s := NewSum(4)
+
+	for i := 0; i < 4; i++ {
+		go func(ch chan<- Report) {
+			ch <- dummyReport{p: 50}
+			close(ch)
+		}(s.Sink())
+	}
+
+	var last Report
+	n := 0
+	for r := range s.Report() {
+		if last != nil && r.Percentage() < last.Percentage() {
+			t.Errorf("percentage decreased from %f to %f", last.Percentage(), r.Percentage())
+		}
+		last = r
+		n++
+	}
+
+	if n != 8 {
+		t.Errorf("expected 8 reports, got %d", n)
+	}
+
+	if last.Percentage() != 100 {
+		t.Errorf("expected 100%%, got %f", last.Percentage())
+	}
+
+	if last.Detail() != "4/4" {
+		t.Errorf("detail=%s", last.Detail())
+	}
+
+	if len(last.(SumReport).Children()) != 4 {
+		t.Errorf("expected 4 children")
+	}
+}
+
+func TestSumError(t *testing.T) {
+	s := NewSum(2)
+
+	ch := s.Sink()
+	go func() {
+		ch <- dummyReport{p: 20, e: errors.New("failed")}
+		close(ch)
+	}()
+
+	r := <-s.Report()
+	if r.Error() == nil {
+		t.Error("expected error")
+	}
+	if r.Percentage() != 10 {
+		t.Errorf("percentage=%f", r.Percentage())
+	}
+	<-s.Report()
+
+	close(s.Sink())
+	<-s.Report()
+
+	_, ok := <-s.Report()
+	if ok {
+		t.Errorf("Expected channel to be closed")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic")
+		}
+	}()
+	s.Sink()
+}
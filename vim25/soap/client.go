@@ -41,6 +41,8 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/time/rate"
+
 	"github.com/vmware/govmomi/vim25/progress"
 	"github.com/vmware/govmomi/vim25/types"
 	"github.com/vmware/govmomi/vim25/xml"
@@ -65,10 +67,18 @@ type Client struct {
 	k bool // Named after curl's -k flag
 	d *debugContainer
 	t *http.Transport
+	s *stats
+	w []func(http.RoundTripper) http.RoundTripper
 
 	hostsMu sync.Mutex
 	hosts   map[string]string
 
+	hooksMu sync.Mutex
+	hooks   []func(RequestInfo)
+
+	limitMu sync.Mutex
+	limit   *rate.Limiter
+
 	Namespace string // Vim namespace
 	Version   string // Vim version
 	Types     types.Func
@@ -125,6 +135,7 @@ func NewClient(u *url.URL, insecure bool) *Client {
 		u: u,
 		k: insecure,
 		d: newDebug(),
+		s: newStats(),
 
 		Types: types.TypeFunc(),
 	}
@@ -168,6 +179,16 @@ func (c *Client) DefaultTransport() *http.Transport {
 	return c.t
 }
 
+// WrapTransport wraps the Client's http.RoundTripper with the one returned by fn, for example to log requests or collect metrics.
+// The wrapper sees each final http.Request, including SOAP requests after marshaling,
+// and should delegate to the given http.RoundTripper, which retains the TLS configuration and thumbprint verification.
+// Wrappers are applied in the order WrapTransport is called and are inherited by clients created via NewServiceClient,
+// such that fn is called again for each of those clients and should return a new http.RoundTripper each time.
+func (c *Client) WrapTransport(fn func(http.RoundTripper) http.RoundTripper) {
+	c.w = append(c.w, fn)
+	c.Client.Transport = fn(c.Client.Transport)
+}
+
 // NewServiceClient creates a NewClient with the given URL.Path and namespace.
 func (c *Client) NewServiceClient(path string, namespace string) *Client {
 	vc := c.URL()
@@ -183,10 +204,21 @@ func (c *Client) NewServiceClient(path string, namespace string) *Client {
 	client := NewClient(u, c.k)
 	client.Namespace = "urn:" + namespace
 	client.DefaultTransport().TLSClientConfig = c.DefaultTransport().TLSClientConfig
+	for _, fn := range c.w {
+		client.WrapTransport(fn)
+	}
 	if cert := c.Certificate(); cert != nil {
 		client.SetCertificate(*cert)
 	}
 
+	// Copy the OnRequest callbacks
+	c.hooksMu.Lock()
+	client.hooks = append(client.hooks, c.hooks...)
+	c.hooksMu.Unlock()
+
+	// Share the rate limiter, as requests are sent to the same server
+	client.limit = c.rateLimiter()
+
 	// Copy the trusted thumbprints
 	c.hostsMu.Lock()
 	for k, v := range c.hosts {
@@ -407,7 +439,7 @@ func (c *Client) Certificate() *tls.Certificate {
 }
 
 func (c *Client) SetCertificate(cert tls.Certificate) {
-	t := c.Client.Transport.(*http.Transport)
+	t := c.t
 
 	// Extension or HoK certificate
 	t.TLSClientConfig.Certificates = []tls.Certificate{cert}
@@ -418,7 +450,7 @@ func (c *Client) SetCertificate(cert tls.Certificate) {
 // and optional for other methods.
 func (c *Client) Tunnel() *Client {
 	tunnel := c.NewServiceClient(c.u.Path, c.Namespace)
-	t := tunnel.Client.Transport.(*http.Transport)
+	t := tunnel.t
 	// Proxy to vCenter host on port 80
 	host := tunnel.u.Hostname()
 	// Should be no reason to change the default port other than testing
@@ -583,10 +615,44 @@ func newStatusError(res *http.Response) error {
 	}
 }
 
+// SetRateLimit limits the rate of RoundTrip requests to rps requests per second, allowing bursts of up to burst requests.
+// RoundTrip blocks until a request is allowed, returning an error if ctx is done,
+// or would be done by its deadline, while waiting.
+// Clients created by NewServiceClient after calling SetRateLimit share the same limit.
+// An rps value of 0 or less removes the limit.
+func (c *Client) SetRateLimit(rps float64, burst int) {
+	c.limitMu.Lock()
+	defer c.limitMu.Unlock()
+
+	if rps <= 0 {
+		c.limit = nil
+		return
+	}
+
+	if burst < 1 {
+		burst = 1
+	}
+
+	c.limit = rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+func (c *Client) rateLimiter() *rate.Limiter {
+	c.limitMu.Lock()
+	defer c.limitMu.Unlock()
+
+	return c.limit
+}
+
 func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error {
 	var err error
 	var b []byte
 
+	if limit := c.rateLimiter(); limit != nil {
+		if err = limit.Wait(ctx); err != nil {
+			return err
+		}
+	}
+
 	reqEnv := Envelope{Body: reqBody}
 	resEnv := Envelope{Body: resBody}
 
@@ -617,6 +683,19 @@ func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error
 		}
 	}
 
+	var in *countingReader
+	tstart := time.Now()
+	defer func() {
+		var n int64
+		if in != nil {
+			n = in.n
+		}
+		d := time.Since(tstart)
+		out := int64(len(xml.Header) + len(b))
+		c.s.record(d, out, n, err)
+		c.onRequest(reqBody, d, out, n, err)
+	}()
+
 	rawReqBody := io.MultiReader(strings.NewReader(xml.Header), bytes.NewReader(b))
 	req, err := http.NewRequest("POST", c.u.String(), rawReqBody)
 	if err != nil {
@@ -631,7 +710,7 @@ func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error
 	}
 	req.Header.Set(`SOAPAction`, action)
 
-	return c.Do(context.WithValue(ctx, kindContext{}, resBody), req, func(res *http.Response) error {
+	err = c.Do(context.WithValue(ctx, kindContext{}, resBody), req, func(res *http.Response) error {
 		switch res.StatusCode {
 		case http.StatusOK:
 			// OK
@@ -641,7 +720,8 @@ func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error
 			return newStatusError(res)
 		}
 
-		dec := xml.NewDecoder(res.Body)
+		in = &countingReader{r: res.Body}
+		dec := xml.NewDecoder(in)
 		dec.TypeFunc = c.Types
 		err = dec.Decode(&resEnv)
 		if err != nil {
@@ -654,6 +734,8 @@ func (c *Client) RoundTrip(ctx context.Context, reqBody, resBody HasFault) error
 
 		return err
 	})
+
+	return err
 }
 
 func (c *Client) CloseIdleConnections() {
@@ -685,6 +767,7 @@ type Upload struct {
 	Headers       map[string]string
 	Ticket        *http.Cookie
 	Progress      progress.Sinker
+	RateLimit     int64 // Maximum transfer rate in bytes per second, 0 for no limit
 }
 
 var DefaultUpload = Upload{
@@ -696,6 +779,10 @@ var DefaultUpload = Upload{
 func (c *Client) Upload(ctx context.Context, f io.Reader, u *url.URL, param *Upload) error {
 	var err error
 
+	if param.RateLimit > 0 {
+		f = newThrottledReader(ctx, f, param.RateLimit)
+	}
+
 	if param.Progress != nil {
 		pr := progress.NewReader(ctx, param.Progress, f, param.ContentLength)
 		f = pr
@@ -765,11 +852,12 @@ func (c *Client) UploadFile(ctx context.Context, file string, u *url.URL, param
 }
 
 type Download struct {
-	Method   string
-	Headers  map[string]string
-	Ticket   *http.Cookie
-	Progress progress.Sinker
-	Writer   io.Writer
+	Method    string
+	Headers   map[string]string
+	Ticket    *http.Cookie
+	Progress  progress.Sinker
+	Writer    io.Writer
+	RateLimit int64 // Maximum transfer rate in bytes per second, 0 for no limit
 }
 
 var DefaultDownload = Download{
@@ -815,6 +903,10 @@ func (c *Client) Download(ctx context.Context, u *url.URL, param *Download) (io.
 
 	r := res.Body
 
+	if param.RateLimit > 0 {
+		r = &readCloser{newThrottledReader(ctx, r, param.RateLimit), r}
+	}
+
 	return r, res.ContentLength, nil
 }
 
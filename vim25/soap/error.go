@@ -18,6 +18,7 @@ package soap
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 
@@ -127,3 +128,72 @@ func IsVimFault(err error) bool {
 func ToVimFault(err error) types.BaseMethodFault {
 	return err.(vimFaultError).fault
 }
+
+// FaultDetail returns the name and detail of the fault wrapped by err, if any.
+// err can be a SOAP fault, a vim fault or an error that implements Fault() types.BaseMethodFault,
+// such as task.Error, including when wrapped by fmt.Errorf with %w.
+// The fault detail is always returned as a pointer, for example *types.FileNotFound.
+func FaultDetail(err error) (string, types.BaseMethodFault, bool) {
+	for ; err != nil; err = errors.Unwrap(err) {
+		var detail interface{}
+
+		switch e := err.(type) {
+		case soapFaultError:
+			detail = e.fault.Detail.Fault
+		case interface{ Fault() types.BaseMethodFault }:
+			detail = e.Fault()
+		default:
+			continue
+		}
+
+		if detail == nil {
+			return "", nil, false
+		}
+
+		// Decoded SOAP fault details are values, but types.BaseMethodFault is implemented by pointers
+		val := reflect.ValueOf(detail)
+		if val.Kind() != reflect.Ptr {
+			ptr := reflect.New(val.Type())
+			ptr.Elem().Set(val)
+			val = ptr
+		}
+
+		fault, ok := val.Interface().(types.BaseMethodFault)
+		if !ok {
+			return "", nil, false
+		}
+
+		return val.Elem().Type().Name(), fault, true
+	}
+
+	return "", nil, false
+}
+
+// AsFault finds the fault detail wrapped by err, as returned by FaultDetail, and if assignable to the value
+// pointed to by target, sets target to the fault detail and returns true.
+// Otherwise, it returns false. AsFault panics if target is not a non-nil pointer.
+// For example:
+//
+//	var fault *types.FileNotFound
+//	if soap.AsFault(err, &fault) {
+//	  fmt.Println(fault.File)
+//	}
+func AsFault(err error, target interface{}) bool {
+	val := reflect.ValueOf(target)
+	if val.Kind() != reflect.Ptr || val.IsNil() {
+		panic("soap: target must be a non-nil pointer")
+	}
+
+	_, fault, ok := FaultDetail(err)
+	if !ok {
+		return false
+	}
+
+	detail := reflect.ValueOf(fault)
+	if !detail.Type().AssignableTo(val.Elem().Type()) {
+		return false
+	}
+
+	val.Elem().Set(detail)
+	return true
+}
@@ -0,0 +1,86 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type taskError struct {
+	fault types.BaseMethodFault
+}
+
+func (e taskError) Error() string {
+	return "task error"
+}
+
+func (e taskError) Fault() types.BaseMethodFault {
+	return e.fault
+}
+
+func TestFaultDetail(t *testing.T) {
+	soapFault := new(Fault)
+	soapFault.Detail.Fault = types.FileNotFound{FileFault: types.FileFault{File: "foo"}}
+
+	tests := []struct {
+		err  error
+		name string
+	}{
+		{WrapSoapFault(soapFault), "FileNotFound"},
+		{WrapVimFault(&types.FileNotFound{FileFault: types.FileFault{File: "foo"}}), "FileNotFound"},
+		{taskError{&types.FileNotFound{FileFault: types.FileFault{File: "foo"}}}, "FileNotFound"},
+		{fmt.Errorf("wrapped: %w", WrapSoapFault(soapFault)), "FileNotFound"},
+	}
+
+	for _, test := range tests {
+		name, fault, ok := FaultDetail(test.err)
+		if !ok {
+			t.Fatalf("%s: no fault", test.err)
+		}
+		if name != test.name {
+			t.Errorf("name=%s", name)
+		}
+
+		var nf *types.FileNotFound
+		if !AsFault(test.err, &nf) {
+			t.Errorf("%s: %T", test.err, fault)
+		} else if nf.File != "foo" {
+			t.Errorf("file=%s", nf.File)
+		}
+
+		// assignable to an interface
+		var ff types.BaseFileFault
+		if !AsFault(test.err, &ff) {
+			t.Errorf("%s: not a BaseFileFault", test.err)
+		}
+
+		var nv *types.InvalidArgument
+		if AsFault(test.err, &nv) {
+			t.Errorf("%s: InvalidArgument", test.err)
+		}
+	}
+
+	for _, err := range []error{nil, errors.New("no fault"), WrapSoapFault(new(Fault)), WrapRegularError(errors.New("regular"))} {
+		if _, _, ok := FaultDetail(err); ok {
+			t.Errorf("%v: fault", err)
+		}
+	}
+}
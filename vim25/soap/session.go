@@ -0,0 +1,208 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/vmware/govmomi/vim25/types"
+	"github.com/vmware/govmomi/vim25/xml"
+)
+
+// savedSession is the file format used by SaveSession and LoadSession.
+type savedSession struct {
+	URL         *url.URL
+	Cookies     []*http.Cookie
+	Thumbprints map[string]string
+	Namespace   string
+	Version     string
+}
+
+// SaveSession writes the Client's session cookies and known certificate thumbprints to the given file,
+// which is replaced by a new file with 0600 permissions. The session can be restored by another process using LoadSession.
+func (c *Client) SaveSession(path string) error {
+	s := savedSession{
+		URL:         c.URL(),
+		Cookies:     c.Jar.Cookies(c.u),
+		Thumbprints: make(map[string]string),
+		Namespace:   c.Namespace,
+		Version:     c.Version,
+	}
+	s.URL.User = nil // credentials are not saved
+
+	c.hostsMu.Lock()
+	for host, thumbprint := range c.hosts {
+		s.Thumbprints[host] = thumbprint
+	}
+	c.hostsMu.Unlock()
+
+	// Write to a temporary file and rename, such that an existing file with looser permissions is replaced
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+
+	err = json.NewEncoder(f).Encode(&s)
+	if err == nil {
+		err = f.Chmod(0600)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
+
+// LoadSession restores session cookies and certificate thumbprints saved by SaveSession and validates the session.
+// Returns false if the file does not exist, was saved for a different URL host, or the session is no longer valid,
+// in which case the Client requires authentication.
+// An error is returned if the file cannot be read or decoded, or the session cannot be validated.
+func (c *Client) LoadSession(ctx context.Context, path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var s savedSession
+	err = json.NewDecoder(f).Decode(&s)
+	_ = f.Close()
+	if err != nil {
+		return false, err
+	}
+
+	if s.URL == nil || s.URL.Host != c.u.Host {
+		return false, nil
+	}
+
+	for host, thumbprint := range s.Thumbprints {
+		c.SetThumbprint(host, thumbprint)
+	}
+
+	if c.Namespace == "" {
+		c.Namespace = s.Namespace
+	}
+	if c.Version == "" {
+		c.Version = s.Version
+	}
+
+	c.Jar.SetCookies(c.u, s.Cookies)
+
+	return c.sessionValid(ctx)
+}
+
+// The request XMLName is set at runtime, such that requests use the Client's Namespace.
+type retrieveServiceContentRequest struct {
+	XMLName xml.Name
+	types.RetrieveServiceContent
+}
+
+type retrieveServiceContentBody struct {
+	Req    *retrieveServiceContentRequest        `xml:",omitempty"`
+	Res    *types.RetrieveServiceContentResponse `xml:"RetrieveServiceContentResponse,omitempty"`
+	Fault_ *Fault                                `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *retrieveServiceContentBody) Fault() *Fault { return b.Fault_ }
+
+type retrievePropertiesRequest struct {
+	XMLName xml.Name
+	types.RetrieveProperties
+}
+
+type retrievePropertiesBody struct {
+	Req    *retrievePropertiesRequest        `xml:",omitempty"`
+	Res    *types.RetrievePropertiesResponse `xml:"RetrievePropertiesResponse,omitempty"`
+	Fault_ *Fault                            `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault,omitempty"`
+}
+
+func (b *retrievePropertiesBody) Fault() *Fault { return b.Fault_ }
+
+// sessionValid reports whether the Client's session is authenticated, via the SessionManager currentSession property.
+// The methods package depends on this package, so the request bodies are declared above.
+func (c *Client) sessionValid(ctx context.Context) (bool, error) {
+	ns := c.Namespace
+	if ns == "" {
+		ns = "urn:vim25"
+	}
+
+	var scReq, scRes retrieveServiceContentBody
+	scReq.Req = &retrieveServiceContentRequest{
+		XMLName: xml.Name{Space: ns, Local: "RetrieveServiceContent"},
+		RetrieveServiceContent: types.RetrieveServiceContent{
+			This: types.ManagedObjectReference{Type: "ServiceInstance", Value: "ServiceInstance"},
+		},
+	}
+
+	if err := c.RoundTrip(ctx, &scReq, &scRes); err != nil {
+		return false, err
+	}
+
+	content := scRes.Res.Returnval
+	if content.SessionManager == nil {
+		return false, nil
+	}
+
+	var req, res retrievePropertiesBody
+	req.Req = &retrievePropertiesRequest{
+		XMLName: xml.Name{Space: ns, Local: "RetrieveProperties"},
+		RetrieveProperties: types.RetrieveProperties{
+			This: content.PropertyCollector,
+			SpecSet: []types.PropertyFilterSpec{{
+				ObjectSet: []types.ObjectSpec{{Obj: *content.SessionManager}},
+				PropSet: []types.PropertySpec{{
+					Type:    content.SessionManager.Type,
+					PathSet: []string{"currentSession"},
+				}},
+			}},
+		},
+	}
+
+	if err := c.RoundTrip(ctx, &req, &res); err != nil {
+		if IsSoapFault(err) {
+			switch ToSoapFault(err).VimFault().(type) {
+			case types.NotAuthenticated, types.ManagedObjectNotFound:
+				return false, nil
+			}
+		}
+		return false, err
+	}
+
+	for _, obj := range res.Res.Returnval {
+		for _, prop := range obj.PropSet {
+			if _, ok := prop.Val.(types.UserSession); ok {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vmware/govmomi/session"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+func TestClientSaveSession(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		dir, err := ioutil.TempDir("", "govmomi-session")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "session.json")
+
+		load := func() bool {
+			sc := soap.NewClient(c.URL(), true)
+			ok, err := sc.LoadSession(ctx, path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return ok
+		}
+
+		if load() {
+			t.Error("expected false without a saved session")
+		}
+
+		c.SetThumbprint("example.com", "AA:BB")
+
+		// an existing file is replaced with 0600 permissions
+		if err = ioutil.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		if err = c.SaveSession(path); err != nil {
+			t.Fatal(err)
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("mode=%s", info.Mode())
+		}
+
+		// session validation requests use the saved Namespace
+		var bodies []string
+		sc := soap.NewClient(c.URL(), true)
+		sc.WrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+			return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+				b, err := ioutil.ReadAll(req.Body)
+				if err != nil {
+					return nil, err
+				}
+				bodies = append(bodies, string(b))
+				req.Body = ioutil.NopCloser(bytes.NewReader(b))
+				return rt.RoundTrip(req)
+			})
+		})
+		ok, err := sc.LoadSession(ctx, path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			t.Error("expected valid session")
+		}
+		if sc.Namespace != c.Namespace {
+			t.Errorf("namespace=%s", sc.Namespace)
+		}
+		for _, body := range bodies {
+			if !strings.Contains(body, `xmlns="`+c.Namespace+`"`) {
+				t.Errorf("body=%s", body)
+			}
+		}
+		if len(bodies) == 0 {
+			t.Error("no requests")
+		}
+		if sc.Thumbprint("example.com") != "AA:BB" {
+			t.Error("thumbprint not restored")
+		}
+
+		if err = session.NewManager(c).Logout(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		if load() {
+			t.Error("expected invalid session after logout")
+		}
+	})
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LatencyBuckets are the upper bounds of the Stats.Latency histogram buckets.
+// A final implicit bucket counts round trips slower than the last bound.
+var LatencyBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// LatencyBucket is a single Stats.Latency histogram bucket.
+type LatencyBucket struct {
+	UpperBound time.Duration // Zero for the final, unbounded bucket
+	Count      uint64        // Number of round trips in this bucket (not cumulative)
+}
+
+// Stats is a snapshot of the counters maintained by Client.RoundTrip.
+type Stats struct {
+	Calls    uint64          // Total number of round trips
+	Faults   uint64          // Round trips that returned a SOAP fault
+	Errors   uint64          // Round trips that failed without a SOAP fault (network, http status, decoding)
+	BytesOut int64           // Total request body bytes sent
+	BytesIn  int64           // Total response body bytes received
+	Latency  []LatencyBucket // Round trip latency histogram
+	Elapsed  time.Duration   // Sum of all round trip latencies
+}
+
+// stats maintains the Client.RoundTrip counters.
+type stats struct {
+	mu      sync.Mutex
+	calls   uint64
+	faults  uint64
+	errors  uint64
+	out     int64
+	in      int64
+	buckets []uint64
+	elapsed time.Duration
+}
+
+func newStats() *stats {
+	return &stats{buckets: make([]uint64, len(LatencyBuckets)+1)}
+}
+
+func (s *stats) record(d time.Duration, out, in int64, err error) {
+	i := len(LatencyBuckets)
+	for j, bound := range LatencyBuckets {
+		if d <= bound {
+			i = j
+			break
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls++
+	if err != nil {
+		if IsSoapFault(err) {
+			s.faults++
+		} else {
+			s.errors++
+		}
+	}
+	s.out += out
+	s.in += in
+	s.buckets[i]++
+	s.elapsed += d
+}
+
+func (s *stats) snapshot() Stats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	latency := make([]LatencyBucket, len(s.buckets))
+	for i, n := range s.buckets {
+		if i < len(LatencyBuckets) {
+			latency[i].UpperBound = LatencyBuckets[i]
+		}
+		latency[i].Count = n
+	}
+
+	return Stats{
+		Calls:    s.calls,
+		Faults:   s.faults,
+		Errors:   s.errors,
+		BytesOut: s.out,
+		BytesIn:  s.in,
+		Latency:  latency,
+		Elapsed:  s.elapsed,
+	}
+}
+
+// Stats returns a snapshot of the RoundTrip counters for this Client,
+// suitable for exporting to a metrics system such as Prometheus.
+func (c *Client) Stats() Stats {
+	return c.s.snapshot()
+}
+
+// RequestInfo describes a single Client.RoundTrip, as passed to the callbacks registered with Client.OnRequest.
+type RequestInfo struct {
+	Method   string        // Method name, such as "RetrievePropertiesEx"
+	Duration time.Duration // Round trip latency
+	BytesOut int64         // Request body bytes sent
+	BytesIn  int64         // Response body bytes received
+	Err      error         // Error returned by RoundTrip, use IsSoapFault to check for a SOAP fault
+}
+
+// OnRequest registers a callback invoked after every Client.RoundTrip, including those that fail.
+// Callbacks are invoked synchronously in the order they were registered and are inherited by clients
+// created with NewServiceClient.
+// Unlike the debug package, callbacks are not given the request or response content,
+// making them suitable for production logging and metrics.
+func (c *Client) OnRequest(fn func(RequestInfo)) {
+	c.hooksMu.Lock()
+	c.hooks = append(c.hooks, fn)
+	c.hooksMu.Unlock()
+}
+
+// onRequest invokes the callbacks registered with OnRequest.
+func (c *Client) onRequest(req HasFault, d time.Duration, out, in int64, err error) {
+	c.hooksMu.Lock()
+	hooks := c.hooks
+	c.hooksMu.Unlock()
+
+	if len(hooks) == 0 {
+		return
+	}
+
+	info := RequestInfo{
+		Method:   methodName(req),
+		Duration: d,
+		BytesOut: out,
+		BytesIn:  in,
+		Err:      err,
+	}
+
+	for _, fn := range hooks {
+		fn(info)
+	}
+}
+
+// methodName returns the method name of a request body, such as "RetrievePropertiesEx" for *methods.RetrievePropertiesExBody.
+func methodName(req HasFault) string {
+	t := reflect.TypeOf(req)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return strings.TrimSuffix(t.Name(), "Body")
+}
+
+// countingReader counts the bytes read from an io.Reader.
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
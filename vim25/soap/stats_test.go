@@ -0,0 +1,143 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/soap"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func TestClientStats(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		before := c.Client.Stats()
+
+		_, err := methods.GetCurrentTime(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := types.Destroy_Task{
+			This: types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"},
+		}
+		_, err = methods.Destroy_Task(ctx, c, &req)
+		if err == nil {
+			t.Fatal("expected fault")
+		}
+
+		s := c.Client.Stats()
+
+		if n := s.Calls - before.Calls; n != 2 {
+			t.Errorf("calls=%d", n)
+		}
+		if n := s.Faults - before.Faults; n != 1 {
+			t.Errorf("faults=%d", n)
+		}
+		if s.Errors != before.Errors {
+			t.Errorf("errors=%d", s.Errors)
+		}
+		if s.BytesOut <= before.BytesOut || s.BytesIn <= before.BytesIn {
+			t.Errorf("bytes out=%d in=%d", s.BytesOut, s.BytesIn)
+		}
+
+		var total uint64
+		for _, b := range s.Latency {
+			total += b.Count
+		}
+		if total != s.Calls {
+			t.Errorf("latency histogram count %d != %d calls", total, s.Calls)
+		}
+	})
+}
+
+func TestClientOnRequest(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		var info []soap.RequestInfo
+
+		c.Client.OnRequest(func(r soap.RequestInfo) {
+			info = append(info, r)
+		})
+
+		_, err := methods.GetCurrentTime(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		req := types.Destroy_Task{
+			This: types.ManagedObjectReference{Type: "VirtualMachine", Value: "enoent"},
+		}
+		_, err = methods.Destroy_Task(ctx, c, &req)
+		if err == nil {
+			t.Fatal("expected fault")
+		}
+
+		if len(info) != 2 {
+			t.Fatalf("%d requests", len(info))
+		}
+
+		if info[0].Method != "CurrentTime" || info[0].Err != nil {
+			t.Errorf("%#v", info[0])
+		}
+		if info[1].Method != "Destroy_Task" || !soap.IsSoapFault(info[1].Err) {
+			t.Errorf("%#v", info[1])
+		}
+
+		for _, r := range info {
+			if r.BytesOut == 0 || r.BytesIn == 0 || r.Duration <= 0 {
+				t.Errorf("%#v", r)
+			}
+		}
+	})
+}
+
+func TestClientSetRateLimit(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		c.Client.SetRateLimit(1, 1)
+
+		_, err := methods.GetCurrentTime(ctx, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+
+		_, err = methods.GetCurrentTime(tctx, c)
+		if err == nil {
+			t.Error("expected error")
+		}
+
+		c.Client.SetRateLimit(0, 0)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			_, err = methods.GetCurrentTime(ctx, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 900*time.Millisecond {
+			t.Errorf("rate limit not removed, elapsed=%s", elapsed)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// throttledReader limits the rate at which bytes are read from an io.Reader,
+// as used by Upload.RateLimit and Download.RateLimit.
+type throttledReader struct {
+	ctx   context.Context
+	r     io.Reader
+	rate  int64 // bytes per second
+	start time.Time
+	n     int64
+}
+
+func newThrottledReader(ctx context.Context, r io.Reader, rate int64) *throttledReader {
+	return &throttledReader{
+		ctx:  ctx,
+		r:    r,
+		rate: rate,
+	}
+}
+
+func (t *throttledReader) Read(p []byte) (int, error) {
+	if t.start.IsZero() {
+		t.start = time.Now()
+	}
+
+	// Read at most 1/10th of a second worth of bytes at a time, for a steady transfer rate
+	if max := t.rate/10 + 1; int64(len(p)) > max {
+		p = p[:max]
+	}
+
+	n, err := t.r.Read(p)
+	t.n += int64(n)
+
+	// Sleep until the bytes read so far are within the rate limit
+	expect := time.Duration(float64(t.n) / float64(t.rate) * float64(time.Second))
+	if d := expect - time.Since(t.start); d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-t.ctx.Done():
+			return n, t.ctx.Err()
+		}
+	}
+
+	return n, err
+}
+
+// readCloser combines the Reader and Closer of different objects.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestThrottledReader(t *testing.T) {
+	data := make([]byte, 3000)
+
+	start := time.Now()
+	r := newThrottledReader(context.Background(), bytes.NewReader(data), 10000)
+
+	n, err := io.Copy(ioutil.Discard, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != int64(len(data)) {
+		t.Errorf("n=%d", n)
+	}
+
+	// 3000 bytes at 10000 bytes/sec should take about 300ms
+	if elapsed := time.Since(start); elapsed < 250*time.Millisecond || elapsed > 2*time.Second {
+		t.Errorf("elapsed=%s", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	r = newThrottledReader(ctx, bytes.NewReader(data), 1000)
+	_, err = io.Copy(ioutil.Discard, r)
+	if err != context.DeadlineExceeded {
+		t.Errorf("err=%v", err)
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package soap_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+)
+
+type countingTransport struct {
+	http.RoundTripper
+	n      *int32
+	action *atomic.Value // SOAPAction header of the last request
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(t.n, 1)
+	t.action.Store(req.Header.Get("SOAPAction"))
+	return t.RoundTripper.RoundTrip(req)
+}
+
+func TestClientWrapTransport(t *testing.T) {
+	simulator.Test(func(ctx context.Context, c *vim25.Client) {
+		var n int32
+		var action atomic.Value
+
+		c.Client.WrapTransport(func(rt http.RoundTripper) http.RoundTripper {
+			return &countingTransport{RoundTripper: rt, n: &n, action: &action}
+		})
+
+		for i := 0; i < 3; i++ {
+			_, err := methods.GetCurrentTime(ctx, c)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if n := atomic.LoadInt32(&n); n != 3 {
+			t.Errorf("requests=%d", n)
+		}
+
+		if action := action.Load(); action == "" || action == nil {
+			t.Error("expected SOAPAction header")
+		}
+
+		// wrappers are inherited by service clients
+		sc := c.Client.NewServiceClient("/pbm/sdk", "pbm")
+		if _, ok := sc.Client.Transport.(*countingTransport); !ok {
+			t.Errorf("transport=%T", sc.Client.Transport)
+		}
+	})
+}
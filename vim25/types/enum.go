@@ -16,7 +16,11 @@ limitations under the License.
 
 package types
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
 
 type ActionParameter string
 
@@ -32,6 +36,29 @@ const (
 	ActionParameterAlarm             = ActionParameter("alarm")
 )
 
+func (e ActionParameter) String() string {
+	return string(e)
+}
+
+func ParseActionParameter(s string) (ActionParameter, error) {
+	for _, e := range []ActionParameter{
+		ActionParameterTargetName,
+		ActionParameterAlarmName,
+		ActionParameterOldStatus,
+		ActionParameterNewStatus,
+		ActionParameterTriggeringSummary,
+		ActionParameterDeclaringSummary,
+		ActionParameterEventDescription,
+		ActionParameterTarget,
+		ActionParameterAlarm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ActionParameter: %q", s)
+}
+
 func init() {
 	t["ActionParameter"] = reflect.TypeOf((*ActionParameter)(nil)).Elem()
 }
@@ -49,6 +76,28 @@ const (
 	ActionTypeHostInfraUpdateHaV1 = ActionType("HostInfraUpdateHaV1")
 )
 
+func (e ActionType) String() string {
+	return string(e)
+}
+
+func ParseActionType(s string) (ActionType, error) {
+	for _, e := range []ActionType{
+		ActionTypeMigrationV1,
+		ActionTypeVmPowerV1,
+		ActionTypeHostPowerV1,
+		ActionTypeHostMaintenanceV1,
+		ActionTypeStorageMigrationV1,
+		ActionTypeStoragePlacementV1,
+		ActionTypePlacementV1,
+		ActionTypeHostInfraUpdateHaV1,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ActionType: %q", s)
+}
+
 func init() {
 	t["ActionType"] = reflect.TypeOf((*ActionType)(nil)).Elem()
 }
@@ -60,6 +109,22 @@ const (
 	AffinityTypeCpu    = AffinityType("cpu")
 )
 
+func (e AffinityType) String() string {
+	return string(e)
+}
+
+func ParseAffinityType(s string) (AffinityType, error) {
+	for _, e := range []AffinityType{
+		AffinityTypeMemory,
+		AffinityTypeCpu,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AffinityType: %q", s)
+}
+
 func init() {
 	t["AffinityType"] = reflect.TypeOf((*AffinityType)(nil)).Elem()
 }
@@ -78,6 +143,29 @@ const (
 	AgentInstallFailedReasonUnknownInstallerError       = AgentInstallFailedReason("UnknownInstallerError")
 )
 
+func (e AgentInstallFailedReason) String() string {
+	return string(e)
+}
+
+func ParseAgentInstallFailedReason(s string) (AgentInstallFailedReason, error) {
+	for _, e := range []AgentInstallFailedReason{
+		AgentInstallFailedReasonNotEnoughSpaceOnDevice,
+		AgentInstallFailedReasonPrepareToUpgradeFailed,
+		AgentInstallFailedReasonAgentNotRunning,
+		AgentInstallFailedReasonAgentNotReachable,
+		AgentInstallFailedReasonInstallTimedout,
+		AgentInstallFailedReasonSignatureVerificationFailed,
+		AgentInstallFailedReasonAgentUploadFailed,
+		AgentInstallFailedReasonAgentUploadTimedout,
+		AgentInstallFailedReasonUnknownInstallerError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AgentInstallFailedReason: %q", s)
+}
+
 func init() {
 	t["AgentInstallFailedReason"] = reflect.TypeOf((*AgentInstallFailedReason)(nil)).Elem()
 }
@@ -90,6 +178,23 @@ const (
 	AlarmFilterSpecAlarmTypeByEntityEntityTypeVm   = AlarmFilterSpecAlarmTypeByEntity("entityTypeVm")
 )
 
+func (e AlarmFilterSpecAlarmTypeByEntity) String() string {
+	return string(e)
+}
+
+func ParseAlarmFilterSpecAlarmTypeByEntity(s string) (AlarmFilterSpecAlarmTypeByEntity, error) {
+	for _, e := range []AlarmFilterSpecAlarmTypeByEntity{
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeAll,
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeHost,
+		AlarmFilterSpecAlarmTypeByEntityEntityTypeVm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AlarmFilterSpecAlarmTypeByEntity: %q", s)
+}
+
 func init() {
 	t["AlarmFilterSpecAlarmTypeByEntity"] = reflect.TypeOf((*AlarmFilterSpecAlarmTypeByEntity)(nil)).Elem()
 }
@@ -102,6 +207,23 @@ const (
 	AlarmFilterSpecAlarmTypeByTriggerTriggerTypeMetric = AlarmFilterSpecAlarmTypeByTrigger("triggerTypeMetric")
 )
 
+func (e AlarmFilterSpecAlarmTypeByTrigger) String() string {
+	return string(e)
+}
+
+func ParseAlarmFilterSpecAlarmTypeByTrigger(s string) (AlarmFilterSpecAlarmTypeByTrigger, error) {
+	for _, e := range []AlarmFilterSpecAlarmTypeByTrigger{
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeAll,
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeEvent,
+		AlarmFilterSpecAlarmTypeByTriggerTriggerTypeMetric,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AlarmFilterSpecAlarmTypeByTrigger: %q", s)
+}
+
 func init() {
 	t["AlarmFilterSpecAlarmTypeByTrigger"] = reflect.TypeOf((*AlarmFilterSpecAlarmTypeByTrigger)(nil)).Elem()
 }
@@ -114,6 +236,23 @@ const (
 	AnswerFileValidationInfoStatusFailed_defaults = AnswerFileValidationInfoStatus("failed_defaults")
 )
 
+func (e AnswerFileValidationInfoStatus) String() string {
+	return string(e)
+}
+
+func ParseAnswerFileValidationInfoStatus(s string) (AnswerFileValidationInfoStatus, error) {
+	for _, e := range []AnswerFileValidationInfoStatus{
+		AnswerFileValidationInfoStatusSuccess,
+		AnswerFileValidationInfoStatusFailed,
+		AnswerFileValidationInfoStatusFailed_defaults,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AnswerFileValidationInfoStatus: %q", s)
+}
+
 func init() {
 	t["AnswerFileValidationInfoStatus"] = reflect.TypeOf((*AnswerFileValidationInfoStatus)(nil)).Elem()
 }
@@ -131,6 +270,28 @@ const (
 	ApplyHostProfileConfigurationResultStatusCanceled                    = ApplyHostProfileConfigurationResultStatus("canceled")
 )
 
+func (e ApplyHostProfileConfigurationResultStatus) String() string {
+	return string(e)
+}
+
+func ParseApplyHostProfileConfigurationResultStatus(s string) (ApplyHostProfileConfigurationResultStatus, error) {
+	for _, e := range []ApplyHostProfileConfigurationResultStatus{
+		ApplyHostProfileConfigurationResultStatusSuccess,
+		ApplyHostProfileConfigurationResultStatusFailed,
+		ApplyHostProfileConfigurationResultStatusReboot_failed,
+		ApplyHostProfileConfigurationResultStatusStateless_reboot_failed,
+		ApplyHostProfileConfigurationResultStatusCheck_compliance_failed,
+		ApplyHostProfileConfigurationResultStatusState_not_satisfied,
+		ApplyHostProfileConfigurationResultStatusExit_maintenancemode_failed,
+		ApplyHostProfileConfigurationResultStatusCanceled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ApplyHostProfileConfigurationResultStatus: %q", s)
+}
+
 func init() {
 	t["ApplyHostProfileConfigurationResultStatus"] = reflect.TypeOf((*ApplyHostProfileConfigurationResultStatus)(nil)).Elem()
 }
@@ -143,6 +304,23 @@ const (
 	ArrayUpdateOperationEdit   = ArrayUpdateOperation("edit")
 )
 
+func (e ArrayUpdateOperation) String() string {
+	return string(e)
+}
+
+func ParseArrayUpdateOperation(s string) (ArrayUpdateOperation, error) {
+	for _, e := range []ArrayUpdateOperation{
+		ArrayUpdateOperationAdd,
+		ArrayUpdateOperationRemove,
+		ArrayUpdateOperationEdit,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ArrayUpdateOperation: %q", s)
+}
+
 func init() {
 	t["ArrayUpdateOperation"] = reflect.TypeOf((*ArrayUpdateOperation)(nil)).Elem()
 }
@@ -158,6 +336,26 @@ const (
 	AutoStartActionSuspend       = AutoStartAction("suspend")
 )
 
+func (e AutoStartAction) String() string {
+	return string(e)
+}
+
+func ParseAutoStartAction(s string) (AutoStartAction, error) {
+	for _, e := range []AutoStartAction{
+		AutoStartActionNone,
+		AutoStartActionSystemDefault,
+		AutoStartActionPowerOn,
+		AutoStartActionPowerOff,
+		AutoStartActionGuestShutdown,
+		AutoStartActionSuspend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AutoStartAction: %q", s)
+}
+
 func init() {
 	t["AutoStartAction"] = reflect.TypeOf((*AutoStartAction)(nil)).Elem()
 }
@@ -170,6 +368,23 @@ const (
 	AutoStartWaitHeartbeatSettingSystemDefault = AutoStartWaitHeartbeatSetting("systemDefault")
 )
 
+func (e AutoStartWaitHeartbeatSetting) String() string {
+	return string(e)
+}
+
+func ParseAutoStartWaitHeartbeatSetting(s string) (AutoStartWaitHeartbeatSetting, error) {
+	for _, e := range []AutoStartWaitHeartbeatSetting{
+		AutoStartWaitHeartbeatSettingYes,
+		AutoStartWaitHeartbeatSettingNo,
+		AutoStartWaitHeartbeatSettingSystemDefault,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid AutoStartWaitHeartbeatSetting: %q", s)
+}
+
 func init() {
 	t["AutoStartWaitHeartbeatSetting"] = reflect.TypeOf((*AutoStartWaitHeartbeatSetting)(nil)).Elem()
 }
@@ -182,6 +397,23 @@ const (
 	BaseConfigInfoDiskFileBackingInfoProvisioningTypeLazyZeroedThick  = BaseConfigInfoDiskFileBackingInfoProvisioningType("lazyZeroedThick")
 )
 
+func (e BaseConfigInfoDiskFileBackingInfoProvisioningType) String() string {
+	return string(e)
+}
+
+func ParseBaseConfigInfoDiskFileBackingInfoProvisioningType(s string) (BaseConfigInfoDiskFileBackingInfoProvisioningType, error) {
+	for _, e := range []BaseConfigInfoDiskFileBackingInfoProvisioningType{
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeThin,
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeEagerZeroedThick,
+		BaseConfigInfoDiskFileBackingInfoProvisioningTypeLazyZeroedThick,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BaseConfigInfoDiskFileBackingInfoProvisioningType: %q", s)
+}
+
 func init() {
 	t["BaseConfigInfoDiskFileBackingInfoProvisioningType"] = reflect.TypeOf((*BaseConfigInfoDiskFileBackingInfoProvisioningType)(nil)).Elem()
 }
@@ -193,6 +425,22 @@ const (
 	BatchResultResultFail    = BatchResultResult("fail")
 )
 
+func (e BatchResultResult) String() string {
+	return string(e)
+}
+
+func ParseBatchResultResult(s string) (BatchResultResult, error) {
+	for _, e := range []BatchResultResult{
+		BatchResultResultSuccess,
+		BatchResultResultFail,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid BatchResultResult: %q", s)
+}
+
 func init() {
 	t["BatchResultResult"] = reflect.TypeOf((*BatchResultResult)(nil)).Elem()
 }
@@ -203,6 +451,21 @@ const (
 	CannotEnableVmcpForClusterReasonAPDTimeoutDisabled = CannotEnableVmcpForClusterReason("APDTimeoutDisabled")
 )
 
+func (e CannotEnableVmcpForClusterReason) String() string {
+	return string(e)
+}
+
+func ParseCannotEnableVmcpForClusterReason(s string) (CannotEnableVmcpForClusterReason, error) {
+	for _, e := range []CannotEnableVmcpForClusterReason{
+		CannotEnableVmcpForClusterReasonAPDTimeoutDisabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CannotEnableVmcpForClusterReason: %q", s)
+}
+
 func init() {
 	t["CannotEnableVmcpForClusterReason"] = reflect.TypeOf((*CannotEnableVmcpForClusterReason)(nil)).Elem()
 }
@@ -214,6 +477,22 @@ const (
 	CannotMoveFaultToleranceVmMoveTypeCluster      = CannotMoveFaultToleranceVmMoveType("cluster")
 )
 
+func (e CannotMoveFaultToleranceVmMoveType) String() string {
+	return string(e)
+}
+
+func ParseCannotMoveFaultToleranceVmMoveType(s string) (CannotMoveFaultToleranceVmMoveType, error) {
+	for _, e := range []CannotMoveFaultToleranceVmMoveType{
+		CannotMoveFaultToleranceVmMoveTypeResourcePool,
+		CannotMoveFaultToleranceVmMoveTypeCluster,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CannotMoveFaultToleranceVmMoveType: %q", s)
+}
+
 func init() {
 	t["CannotMoveFaultToleranceVmMoveType"] = reflect.TypeOf((*CannotMoveFaultToleranceVmMoveType)(nil)).Elem()
 }
@@ -227,6 +506,24 @@ const (
 	CannotPowerOffVmInClusterOperationGuestSuspend  = CannotPowerOffVmInClusterOperation("guestSuspend")
 )
 
+func (e CannotPowerOffVmInClusterOperation) String() string {
+	return string(e)
+}
+
+func ParseCannotPowerOffVmInClusterOperation(s string) (CannotPowerOffVmInClusterOperation, error) {
+	for _, e := range []CannotPowerOffVmInClusterOperation{
+		CannotPowerOffVmInClusterOperationSuspend,
+		CannotPowerOffVmInClusterOperationPowerOff,
+		CannotPowerOffVmInClusterOperationGuestShutdown,
+		CannotPowerOffVmInClusterOperationGuestSuspend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CannotPowerOffVmInClusterOperation: %q", s)
+}
+
 func init() {
 	t["CannotPowerOffVmInClusterOperation"] = reflect.TypeOf((*CannotPowerOffVmInClusterOperation)(nil)).Elem()
 }
@@ -242,6 +539,26 @@ const (
 	CannotUseNetworkReasonMismatchedEnsMode               = CannotUseNetworkReason("MismatchedEnsMode")
 )
 
+func (e CannotUseNetworkReason) String() string {
+	return string(e)
+}
+
+func ParseCannotUseNetworkReason(s string) (CannotUseNetworkReason, error) {
+	for _, e := range []CannotUseNetworkReason{
+		CannotUseNetworkReasonNetworkReservationNotSupported,
+		CannotUseNetworkReasonMismatchedNetworkPolicies,
+		CannotUseNetworkReasonMismatchedDvsVersionOrVendor,
+		CannotUseNetworkReasonVMotionToUnsupportedNetworkType,
+		CannotUseNetworkReasonNetworkUnderMaintenance,
+		CannotUseNetworkReasonMismatchedEnsMode,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CannotUseNetworkReason: %q", s)
+}
+
 func init() {
 	t["CannotUseNetworkReason"] = reflect.TypeOf((*CannotUseNetworkReason)(nil)).Elem()
 }
@@ -256,6 +573,25 @@ const (
 	CheckTestTypeNetworkTests      = CheckTestType("networkTests")
 )
 
+func (e CheckTestType) String() string {
+	return string(e)
+}
+
+func ParseCheckTestType(s string) (CheckTestType, error) {
+	for _, e := range []CheckTestType{
+		CheckTestTypeSourceTests,
+		CheckTestTypeHostTests,
+		CheckTestTypeResourcePoolTests,
+		CheckTestTypeDatastoreTests,
+		CheckTestTypeNetworkTests,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CheckTestType: %q", s)
+}
+
 func init() {
 	t["CheckTestType"] = reflect.TypeOf((*CheckTestType)(nil)).Elem()
 }
@@ -268,6 +604,23 @@ const (
 	ClusterComputeResourceHCIWorkflowStateInvalid     = ClusterComputeResourceHCIWorkflowState("invalid")
 )
 
+func (e ClusterComputeResourceHCIWorkflowState) String() string {
+	return string(e)
+}
+
+func ParseClusterComputeResourceHCIWorkflowState(s string) (ClusterComputeResourceHCIWorkflowState, error) {
+	for _, e := range []ClusterComputeResourceHCIWorkflowState{
+		ClusterComputeResourceHCIWorkflowStateIn_progress,
+		ClusterComputeResourceHCIWorkflowStateDone,
+		ClusterComputeResourceHCIWorkflowStateInvalid,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterComputeResourceHCIWorkflowState: %q", s)
+}
+
 func init() {
 	t["ClusterComputeResourceHCIWorkflowState"] = reflect.TypeOf((*ClusterComputeResourceHCIWorkflowState)(nil)).Elem()
 }
@@ -280,6 +633,23 @@ const (
 	ClusterComputeResourceVcsHealthStatusNonhealthy = ClusterComputeResourceVcsHealthStatus("nonhealthy")
 )
 
+func (e ClusterComputeResourceVcsHealthStatus) String() string {
+	return string(e)
+}
+
+func ParseClusterComputeResourceVcsHealthStatus(s string) (ClusterComputeResourceVcsHealthStatus, error) {
+	for _, e := range []ClusterComputeResourceVcsHealthStatus{
+		ClusterComputeResourceVcsHealthStatusHealthy,
+		ClusterComputeResourceVcsHealthStatusDegraded,
+		ClusterComputeResourceVcsHealthStatusNonhealthy,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterComputeResourceVcsHealthStatus: %q", s)
+}
+
 func init() {
 	t["ClusterComputeResourceVcsHealthStatus"] = reflect.TypeOf((*ClusterComputeResourceVcsHealthStatus)(nil)).Elem()
 }
@@ -291,6 +661,22 @@ const (
 	ClusterCryptoConfigInfoCryptoModeForceEnable = ClusterCryptoConfigInfoCryptoMode("forceEnable")
 )
 
+func (e ClusterCryptoConfigInfoCryptoMode) String() string {
+	return string(e)
+}
+
+func ParseClusterCryptoConfigInfoCryptoMode(s string) (ClusterCryptoConfigInfoCryptoMode, error) {
+	for _, e := range []ClusterCryptoConfigInfoCryptoMode{
+		ClusterCryptoConfigInfoCryptoModeOnDemand,
+		ClusterCryptoConfigInfoCryptoModeForceEnable,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterCryptoConfigInfoCryptoMode: %q", s)
+}
+
 func init() {
 	t["ClusterCryptoConfigInfoCryptoMode"] = reflect.TypeOf((*ClusterCryptoConfigInfoCryptoMode)(nil)).Elem()
 }
@@ -308,6 +694,28 @@ const (
 	ClusterDasAamNodeStateDasStateNodeFailed    = ClusterDasAamNodeStateDasState("nodeFailed")
 )
 
+func (e ClusterDasAamNodeStateDasState) String() string {
+	return string(e)
+}
+
+func ParseClusterDasAamNodeStateDasState(s string) (ClusterDasAamNodeStateDasState, error) {
+	for _, e := range []ClusterDasAamNodeStateDasState{
+		ClusterDasAamNodeStateDasStateUninitialized,
+		ClusterDasAamNodeStateDasStateInitialized,
+		ClusterDasAamNodeStateDasStateConfiguring,
+		ClusterDasAamNodeStateDasStateUnconfiguring,
+		ClusterDasAamNodeStateDasStateRunning,
+		ClusterDasAamNodeStateDasStateError,
+		ClusterDasAamNodeStateDasStateAgentShutdown,
+		ClusterDasAamNodeStateDasStateNodeFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasAamNodeStateDasState: %q", s)
+}
+
 func init() {
 	t["ClusterDasAamNodeStateDasState"] = reflect.TypeOf((*ClusterDasAamNodeStateDasState)(nil)).Elem()
 }
@@ -320,6 +728,23 @@ const (
 	ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDsWithUserPreference = ClusterDasConfigInfoHBDatastoreCandidate("allFeasibleDsWithUserPreference")
 )
 
+func (e ClusterDasConfigInfoHBDatastoreCandidate) String() string {
+	return string(e)
+}
+
+func ParseClusterDasConfigInfoHBDatastoreCandidate(s string) (ClusterDasConfigInfoHBDatastoreCandidate, error) {
+	for _, e := range []ClusterDasConfigInfoHBDatastoreCandidate{
+		ClusterDasConfigInfoHBDatastoreCandidateUserSelectedDs,
+		ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDs,
+		ClusterDasConfigInfoHBDatastoreCandidateAllFeasibleDsWithUserPreference,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasConfigInfoHBDatastoreCandidate: %q", s)
+}
+
 func init() {
 	t["ClusterDasConfigInfoHBDatastoreCandidate"] = reflect.TypeOf((*ClusterDasConfigInfoHBDatastoreCandidate)(nil)).Elem()
 }
@@ -331,6 +756,22 @@ const (
 	ClusterDasConfigInfoServiceStateEnabled  = ClusterDasConfigInfoServiceState("enabled")
 )
 
+func (e ClusterDasConfigInfoServiceState) String() string {
+	return string(e)
+}
+
+func ParseClusterDasConfigInfoServiceState(s string) (ClusterDasConfigInfoServiceState, error) {
+	for _, e := range []ClusterDasConfigInfoServiceState{
+		ClusterDasConfigInfoServiceStateDisabled,
+		ClusterDasConfigInfoServiceStateEnabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasConfigInfoServiceState: %q", s)
+}
+
 func init() {
 	t["ClusterDasConfigInfoServiceState"] = reflect.TypeOf((*ClusterDasConfigInfoServiceState)(nil)).Elem()
 }
@@ -343,6 +784,23 @@ const (
 	ClusterDasConfigInfoVmMonitoringStateVmAndAppMonitoring   = ClusterDasConfigInfoVmMonitoringState("vmAndAppMonitoring")
 )
 
+func (e ClusterDasConfigInfoVmMonitoringState) String() string {
+	return string(e)
+}
+
+func ParseClusterDasConfigInfoVmMonitoringState(s string) (ClusterDasConfigInfoVmMonitoringState, error) {
+	for _, e := range []ClusterDasConfigInfoVmMonitoringState{
+		ClusterDasConfigInfoVmMonitoringStateVmMonitoringDisabled,
+		ClusterDasConfigInfoVmMonitoringStateVmMonitoringOnly,
+		ClusterDasConfigInfoVmMonitoringStateVmAndAppMonitoring,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasConfigInfoVmMonitoringState: %q", s)
+}
+
 func init() {
 	t["ClusterDasConfigInfoVmMonitoringState"] = reflect.TypeOf((*ClusterDasConfigInfoVmMonitoringState)(nil)).Elem()
 }
@@ -362,6 +820,30 @@ const (
 	ClusterDasFdmAvailabilityStateFdmUnreachable               = ClusterDasFdmAvailabilityState("fdmUnreachable")
 )
 
+func (e ClusterDasFdmAvailabilityState) String() string {
+	return string(e)
+}
+
+func ParseClusterDasFdmAvailabilityState(s string) (ClusterDasFdmAvailabilityState, error) {
+	for _, e := range []ClusterDasFdmAvailabilityState{
+		ClusterDasFdmAvailabilityStateUninitialized,
+		ClusterDasFdmAvailabilityStateElection,
+		ClusterDasFdmAvailabilityStateMaster,
+		ClusterDasFdmAvailabilityStateConnectedToMaster,
+		ClusterDasFdmAvailabilityStateNetworkPartitionedFromMaster,
+		ClusterDasFdmAvailabilityStateNetworkIsolated,
+		ClusterDasFdmAvailabilityStateHostDown,
+		ClusterDasFdmAvailabilityStateInitializationError,
+		ClusterDasFdmAvailabilityStateUninitializationError,
+		ClusterDasFdmAvailabilityStateFdmUnreachable,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasFdmAvailabilityState: %q", s)
+}
+
 func init() {
 	t["ClusterDasFdmAvailabilityState"] = reflect.TypeOf((*ClusterDasFdmAvailabilityState)(nil)).Elem()
 }
@@ -375,6 +857,24 @@ const (
 	ClusterDasVmSettingsIsolationResponseClusterIsolationResponse = ClusterDasVmSettingsIsolationResponse("clusterIsolationResponse")
 )
 
+func (e ClusterDasVmSettingsIsolationResponse) String() string {
+	return string(e)
+}
+
+func ParseClusterDasVmSettingsIsolationResponse(s string) (ClusterDasVmSettingsIsolationResponse, error) {
+	for _, e := range []ClusterDasVmSettingsIsolationResponse{
+		ClusterDasVmSettingsIsolationResponseNone,
+		ClusterDasVmSettingsIsolationResponsePowerOff,
+		ClusterDasVmSettingsIsolationResponseShutdown,
+		ClusterDasVmSettingsIsolationResponseClusterIsolationResponse,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasVmSettingsIsolationResponse: %q", s)
+}
+
 func init() {
 	t["ClusterDasVmSettingsIsolationResponse"] = reflect.TypeOf((*ClusterDasVmSettingsIsolationResponse)(nil)).Elem()
 }
@@ -391,6 +891,27 @@ const (
 	ClusterDasVmSettingsRestartPriorityClusterRestartPriority = ClusterDasVmSettingsRestartPriority("clusterRestartPriority")
 )
 
+func (e ClusterDasVmSettingsRestartPriority) String() string {
+	return string(e)
+}
+
+func ParseClusterDasVmSettingsRestartPriority(s string) (ClusterDasVmSettingsRestartPriority, error) {
+	for _, e := range []ClusterDasVmSettingsRestartPriority{
+		ClusterDasVmSettingsRestartPriorityDisabled,
+		ClusterDasVmSettingsRestartPriorityLowest,
+		ClusterDasVmSettingsRestartPriorityLow,
+		ClusterDasVmSettingsRestartPriorityMedium,
+		ClusterDasVmSettingsRestartPriorityHigh,
+		ClusterDasVmSettingsRestartPriorityHighest,
+		ClusterDasVmSettingsRestartPriorityClusterRestartPriority,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterDasVmSettingsRestartPriority: %q", s)
+}
+
 func init() {
 	t["ClusterDasVmSettingsRestartPriority"] = reflect.TypeOf((*ClusterDasVmSettingsRestartPriority)(nil)).Elem()
 }
@@ -403,6 +924,23 @@ const (
 	ClusterHostInfraUpdateHaModeActionOperationTypeEnterMaintenance = ClusterHostInfraUpdateHaModeActionOperationType("enterMaintenance")
 )
 
+func (e ClusterHostInfraUpdateHaModeActionOperationType) String() string {
+	return string(e)
+}
+
+func ParseClusterHostInfraUpdateHaModeActionOperationType(s string) (ClusterHostInfraUpdateHaModeActionOperationType, error) {
+	for _, e := range []ClusterHostInfraUpdateHaModeActionOperationType{
+		ClusterHostInfraUpdateHaModeActionOperationTypeEnterQuarantine,
+		ClusterHostInfraUpdateHaModeActionOperationTypeExitQuarantine,
+		ClusterHostInfraUpdateHaModeActionOperationTypeEnterMaintenance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterHostInfraUpdateHaModeActionOperationType: %q", s)
+}
+
 func init() {
 	t["ClusterHostInfraUpdateHaModeActionOperationType"] = reflect.TypeOf((*ClusterHostInfraUpdateHaModeActionOperationType)(nil)).Elem()
 }
@@ -414,6 +952,22 @@ const (
 	ClusterInfraUpdateHaConfigInfoBehaviorTypeAutomated = ClusterInfraUpdateHaConfigInfoBehaviorType("Automated")
 )
 
+func (e ClusterInfraUpdateHaConfigInfoBehaviorType) String() string {
+	return string(e)
+}
+
+func ParseClusterInfraUpdateHaConfigInfoBehaviorType(s string) (ClusterInfraUpdateHaConfigInfoBehaviorType, error) {
+	for _, e := range []ClusterInfraUpdateHaConfigInfoBehaviorType{
+		ClusterInfraUpdateHaConfigInfoBehaviorTypeManual,
+		ClusterInfraUpdateHaConfigInfoBehaviorTypeAutomated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterInfraUpdateHaConfigInfoBehaviorType: %q", s)
+}
+
 func init() {
 	t["ClusterInfraUpdateHaConfigInfoBehaviorType"] = reflect.TypeOf((*ClusterInfraUpdateHaConfigInfoBehaviorType)(nil)).Elem()
 }
@@ -425,6 +979,22 @@ const (
 	ClusterInfraUpdateHaConfigInfoRemediationTypeMaintenanceMode = ClusterInfraUpdateHaConfigInfoRemediationType("MaintenanceMode")
 )
 
+func (e ClusterInfraUpdateHaConfigInfoRemediationType) String() string {
+	return string(e)
+}
+
+func ParseClusterInfraUpdateHaConfigInfoRemediationType(s string) (ClusterInfraUpdateHaConfigInfoRemediationType, error) {
+	for _, e := range []ClusterInfraUpdateHaConfigInfoRemediationType{
+		ClusterInfraUpdateHaConfigInfoRemediationTypeQuarantineMode,
+		ClusterInfraUpdateHaConfigInfoRemediationTypeMaintenanceMode,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterInfraUpdateHaConfigInfoRemediationType: %q", s)
+}
+
 func init() {
 	t["ClusterInfraUpdateHaConfigInfoRemediationType"] = reflect.TypeOf((*ClusterInfraUpdateHaConfigInfoRemediationType)(nil)).Elem()
 }
@@ -436,6 +1006,22 @@ const (
 	ClusterPowerOnVmOptionReserveResources        = ClusterPowerOnVmOption("ReserveResources")
 )
 
+func (e ClusterPowerOnVmOption) String() string {
+	return string(e)
+}
+
+func ParseClusterPowerOnVmOption(s string) (ClusterPowerOnVmOption, error) {
+	for _, e := range []ClusterPowerOnVmOption{
+		ClusterPowerOnVmOptionOverrideAutomationLevel,
+		ClusterPowerOnVmOptionReserveResources,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterPowerOnVmOption: %q", s)
+}
+
 func init() {
 	t["ClusterPowerOnVmOption"] = reflect.TypeOf((*ClusterPowerOnVmOption)(nil)).Elem()
 }
@@ -449,6 +1035,24 @@ const (
 	ClusterProfileServiceTypeFT  = ClusterProfileServiceType("FT")
 )
 
+func (e ClusterProfileServiceType) String() string {
+	return string(e)
+}
+
+func ParseClusterProfileServiceType(s string) (ClusterProfileServiceType, error) {
+	for _, e := range []ClusterProfileServiceType{
+		ClusterProfileServiceTypeDRS,
+		ClusterProfileServiceTypeHA,
+		ClusterProfileServiceTypeDPM,
+		ClusterProfileServiceTypeFT,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterProfileServiceType: %q", s)
+}
+
 func init() {
 	t["ClusterProfileServiceType"] = reflect.TypeOf((*ClusterProfileServiceType)(nil)).Elem()
 }
@@ -463,6 +1067,25 @@ const (
 	ClusterVmComponentProtectionSettingsStorageVmReactionClusterDefault      = ClusterVmComponentProtectionSettingsStorageVmReaction("clusterDefault")
 )
 
+func (e ClusterVmComponentProtectionSettingsStorageVmReaction) String() string {
+	return string(e)
+}
+
+func ParseClusterVmComponentProtectionSettingsStorageVmReaction(s string) (ClusterVmComponentProtectionSettingsStorageVmReaction, error) {
+	for _, e := range []ClusterVmComponentProtectionSettingsStorageVmReaction{
+		ClusterVmComponentProtectionSettingsStorageVmReactionDisabled,
+		ClusterVmComponentProtectionSettingsStorageVmReactionWarning,
+		ClusterVmComponentProtectionSettingsStorageVmReactionRestartConservative,
+		ClusterVmComponentProtectionSettingsStorageVmReactionRestartAggressive,
+		ClusterVmComponentProtectionSettingsStorageVmReactionClusterDefault,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterVmComponentProtectionSettingsStorageVmReaction: %q", s)
+}
+
 func init() {
 	t["ClusterVmComponentProtectionSettingsStorageVmReaction"] = reflect.TypeOf((*ClusterVmComponentProtectionSettingsStorageVmReaction)(nil)).Elem()
 }
@@ -475,6 +1098,23 @@ const (
 	ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedUseClusterDefault = ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared("useClusterDefault")
 )
 
+func (e ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared) String() string {
+	return string(e)
+}
+
+func ParseClusterVmComponentProtectionSettingsVmReactionOnAPDCleared(s string) (ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared, error) {
+	for _, e := range []ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared{
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedNone,
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedReset,
+		ClusterVmComponentProtectionSettingsVmReactionOnAPDClearedUseClusterDefault,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared: %q", s)
+}
+
 func init() {
 	t["ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared"] = reflect.TypeOf((*ClusterVmComponentProtectionSettingsVmReactionOnAPDCleared)(nil)).Elem()
 }
@@ -489,6 +1129,25 @@ const (
 	ClusterVmReadinessReadyConditionUseClusterDefault  = ClusterVmReadinessReadyCondition("useClusterDefault")
 )
 
+func (e ClusterVmReadinessReadyCondition) String() string {
+	return string(e)
+}
+
+func ParseClusterVmReadinessReadyCondition(s string) (ClusterVmReadinessReadyCondition, error) {
+	for _, e := range []ClusterVmReadinessReadyCondition{
+		ClusterVmReadinessReadyConditionNone,
+		ClusterVmReadinessReadyConditionPoweredOn,
+		ClusterVmReadinessReadyConditionGuestHbStatusGreen,
+		ClusterVmReadinessReadyConditionAppHbStatusGreen,
+		ClusterVmReadinessReadyConditionUseClusterDefault,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ClusterVmReadinessReadyCondition: %q", s)
+}
+
 func init() {
 	t["ClusterVmReadinessReadyCondition"] = reflect.TypeOf((*ClusterVmReadinessReadyCondition)(nil)).Elem()
 }
@@ -502,6 +1161,24 @@ const (
 	ComplianceResultStatusRunning      = ComplianceResultStatus("running")
 )
 
+func (e ComplianceResultStatus) String() string {
+	return string(e)
+}
+
+func ParseComplianceResultStatus(s string) (ComplianceResultStatus, error) {
+	for _, e := range []ComplianceResultStatus{
+		ComplianceResultStatusCompliant,
+		ComplianceResultStatusNonCompliant,
+		ComplianceResultStatusUnknown,
+		ComplianceResultStatusRunning,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ComplianceResultStatus: %q", s)
+}
+
 func init() {
 	t["ComplianceResultStatus"] = reflect.TypeOf((*ComplianceResultStatus)(nil)).Elem()
 }
@@ -514,6 +1191,23 @@ const (
 	ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnknown    = ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState("unknown")
 )
 
+func (e ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState) String() string {
+	return string(e)
+}
+
+func ParseComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState(s string) (ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState, error) {
+	for _, e := range []ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState{
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateLicensed,
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnlicensed,
+		ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState: %q", s)
+}
+
 func init() {
 	t["ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState"] = reflect.TypeOf((*ComputeResourceHostSPBMLicenseInfoHostSPBMLicenseState)(nil)).Elem()
 }
@@ -526,6 +1220,23 @@ const (
 	ConfigSpecOperationRemove = ConfigSpecOperation("remove")
 )
 
+func (e ConfigSpecOperation) String() string {
+	return string(e)
+}
+
+func ParseConfigSpecOperation(s string) (ConfigSpecOperation, error) {
+	for _, e := range []ConfigSpecOperation{
+		ConfigSpecOperationAdd,
+		ConfigSpecOperationEdit,
+		ConfigSpecOperationRemove,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ConfigSpecOperation: %q", s)
+}
+
 func init() {
 	t["ConfigSpecOperation"] = reflect.TypeOf((*ConfigSpecOperation)(nil)).Elem()
 }
@@ -541,6 +1252,26 @@ const (
 	CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateManagedByTrustAuthority = CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason("KeyStateManagedByTrustAuthority")
 )
 
+func (e CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason) String() string {
+	return string(e)
+}
+
+func ParseCryptoManagerKmipCryptoKeyStatusKeyUnavailableReason(s string) (CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason, error) {
+	for _, e := range []CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason{
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInCache,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterInvalid,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateClusterUnreachable,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateMissingInKMS,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateNotActiveOrEnabled,
+		CryptoManagerKmipCryptoKeyStatusKeyUnavailableReasonKeyStateManagedByTrustAuthority,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason: %q", s)
+}
+
 func init() {
 	t["CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason"] = reflect.TypeOf((*CryptoManagerKmipCryptoKeyStatusKeyUnavailableReason)(nil)).Elem()
 }
@@ -552,6 +1283,22 @@ const (
 	CustomizationFailedReasonCodeCustomizationDisabled     = CustomizationFailedReasonCode("customizationDisabled")
 )
 
+func (e CustomizationFailedReasonCode) String() string {
+	return string(e)
+}
+
+func ParseCustomizationFailedReasonCode(s string) (CustomizationFailedReasonCode, error) {
+	for _, e := range []CustomizationFailedReasonCode{
+		CustomizationFailedReasonCodeUserDefinedScriptDisabled,
+		CustomizationFailedReasonCodeCustomizationDisabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CustomizationFailedReasonCode: %q", s)
+}
+
 func init() {
 	t["CustomizationFailedReasonCode"] = reflect.TypeOf((*CustomizationFailedReasonCode)(nil)).Elem()
 }
@@ -563,6 +1310,22 @@ const (
 	CustomizationLicenseDataModePerSeat   = CustomizationLicenseDataMode("perSeat")
 )
 
+func (e CustomizationLicenseDataMode) String() string {
+	return string(e)
+}
+
+func ParseCustomizationLicenseDataMode(s string) (CustomizationLicenseDataMode, error) {
+	for _, e := range []CustomizationLicenseDataMode{
+		CustomizationLicenseDataModePerServer,
+		CustomizationLicenseDataModePerSeat,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CustomizationLicenseDataMode: %q", s)
+}
+
 func init() {
 	t["CustomizationLicenseDataMode"] = reflect.TypeOf((*CustomizationLicenseDataMode)(nil)).Elem()
 }
@@ -575,6 +1338,23 @@ const (
 	CustomizationNetBIOSModeDisableNetBIOS       = CustomizationNetBIOSMode("disableNetBIOS")
 )
 
+func (e CustomizationNetBIOSMode) String() string {
+	return string(e)
+}
+
+func ParseCustomizationNetBIOSMode(s string) (CustomizationNetBIOSMode, error) {
+	for _, e := range []CustomizationNetBIOSMode{
+		CustomizationNetBIOSModeEnableNetBIOSViaDhcp,
+		CustomizationNetBIOSModeEnableNetBIOS,
+		CustomizationNetBIOSModeDisableNetBIOS,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CustomizationNetBIOSMode: %q", s)
+}
+
 func init() {
 	t["CustomizationNetBIOSMode"] = reflect.TypeOf((*CustomizationNetBIOSMode)(nil)).Elem()
 }
@@ -587,6 +1367,23 @@ const (
 	CustomizationSysprepRebootOptionShutdown = CustomizationSysprepRebootOption("shutdown")
 )
 
+func (e CustomizationSysprepRebootOption) String() string {
+	return string(e)
+}
+
+func ParseCustomizationSysprepRebootOption(s string) (CustomizationSysprepRebootOption, error) {
+	for _, e := range []CustomizationSysprepRebootOption{
+		CustomizationSysprepRebootOptionReboot,
+		CustomizationSysprepRebootOptionNoreboot,
+		CustomizationSysprepRebootOptionShutdown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid CustomizationSysprepRebootOption: %q", s)
+}
+
 func init() {
 	t["CustomizationSysprepRebootOption"] = reflect.TypeOf((*CustomizationSysprepRebootOption)(nil)).Elem()
 }
@@ -600,6 +1397,24 @@ const (
 	DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptDisabledForPort             = DVPortStatusVmDirectPathGen2InactiveReasonNetwork("portNptDisabledForPort")
 )
 
+func (e DVPortStatusVmDirectPathGen2InactiveReasonNetwork) String() string {
+	return string(e)
+}
+
+func ParseDVPortStatusVmDirectPathGen2InactiveReasonNetwork(s string) (DVPortStatusVmDirectPathGen2InactiveReasonNetwork, error) {
+	for _, e := range []DVPortStatusVmDirectPathGen2InactiveReasonNetwork{
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptIncompatibleDvs,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptNoCompatibleNics,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptNoVirtualFunctionsAvailable,
+		DVPortStatusVmDirectPathGen2InactiveReasonNetworkPortNptDisabledForPort,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DVPortStatusVmDirectPathGen2InactiveReasonNetwork: %q", s)
+}
+
 func init() {
 	t["DVPortStatusVmDirectPathGen2InactiveReasonNetwork"] = reflect.TypeOf((*DVPortStatusVmDirectPathGen2InactiveReasonNetwork)(nil)).Elem()
 }
@@ -611,6 +1426,22 @@ const (
 	DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleConnectee = DVPortStatusVmDirectPathGen2InactiveReasonOther("portNptIncompatibleConnectee")
 )
 
+func (e DVPortStatusVmDirectPathGen2InactiveReasonOther) String() string {
+	return string(e)
+}
+
+func ParseDVPortStatusVmDirectPathGen2InactiveReasonOther(s string) (DVPortStatusVmDirectPathGen2InactiveReasonOther, error) {
+	for _, e := range []DVPortStatusVmDirectPathGen2InactiveReasonOther{
+		DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleHost,
+		DVPortStatusVmDirectPathGen2InactiveReasonOtherPortNptIncompatibleConnectee,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DVPortStatusVmDirectPathGen2InactiveReasonOther: %q", s)
+}
+
 func init() {
 	t["DVPortStatusVmDirectPathGen2InactiveReasonOther"] = reflect.TypeOf((*DVPortStatusVmDirectPathGen2InactiveReasonOther)(nil)).Elem()
 }
@@ -622,6 +1453,22 @@ const (
 	DVSMacLimitPolicyTypeDrop  = DVSMacLimitPolicyType("drop")
 )
 
+func (e DVSMacLimitPolicyType) String() string {
+	return string(e)
+}
+
+func ParseDVSMacLimitPolicyType(s string) (DVSMacLimitPolicyType, error) {
+	for _, e := range []DVSMacLimitPolicyType{
+		DVSMacLimitPolicyTypeAllow,
+		DVSMacLimitPolicyTypeDrop,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DVSMacLimitPolicyType: %q", s)
+}
+
 func init() {
 	t["DVSMacLimitPolicyType"] = reflect.TypeOf((*DVSMacLimitPolicyType)(nil)).Elem()
 }
@@ -642,6 +1489,31 @@ const (
 	DasConfigFaultDasConfigFaultReasonApplyHAVibsOnClusterFailed  = DasConfigFaultDasConfigFaultReason("ApplyHAVibsOnClusterFailed")
 )
 
+func (e DasConfigFaultDasConfigFaultReason) String() string {
+	return string(e)
+}
+
+func ParseDasConfigFaultDasConfigFaultReason(s string) (DasConfigFaultDasConfigFaultReason, error) {
+	for _, e := range []DasConfigFaultDasConfigFaultReason{
+		DasConfigFaultDasConfigFaultReasonHostNetworkMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonHostMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonInsufficientPrivileges,
+		DasConfigFaultDasConfigFaultReasonNoPrimaryAgentAvailable,
+		DasConfigFaultDasConfigFaultReasonOther,
+		DasConfigFaultDasConfigFaultReasonNoDatastoresConfigured,
+		DasConfigFaultDasConfigFaultReasonCreateConfigVvolFailed,
+		DasConfigFaultDasConfigFaultReasonVSanNotSupportedOnHost,
+		DasConfigFaultDasConfigFaultReasonDasNetworkMisconfiguration,
+		DasConfigFaultDasConfigFaultReasonSetDesiredImageSpecFailed,
+		DasConfigFaultDasConfigFaultReasonApplyHAVibsOnClusterFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DasConfigFaultDasConfigFaultReason: %q", s)
+}
+
 func init() {
 	t["DasConfigFaultDasConfigFaultReason"] = reflect.TypeOf((*DasConfigFaultDasConfigFaultReason)(nil)).Elem()
 }
@@ -655,6 +1527,24 @@ const (
 	DasVmPriorityHigh     = DasVmPriority("high")
 )
 
+func (e DasVmPriority) String() string {
+	return string(e)
+}
+
+func ParseDasVmPriority(s string) (DasVmPriority, error) {
+	for _, e := range []DasVmPriority{
+		DasVmPriorityDisabled,
+		DasVmPriorityLow,
+		DasVmPriorityMedium,
+		DasVmPriorityHigh,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DasVmPriority: %q", s)
+}
+
 func init() {
 	t["DasVmPriority"] = reflect.TypeOf((*DasVmPriority)(nil)).Elem()
 }
@@ -666,6 +1556,22 @@ const (
 	DatastoreAccessibleFalse = DatastoreAccessible("False")
 )
 
+func (e DatastoreAccessible) String() string {
+	return string(e)
+}
+
+func ParseDatastoreAccessible(s string) (DatastoreAccessible, error) {
+	for _, e := range []DatastoreAccessible{
+		DatastoreAccessibleTrue,
+		DatastoreAccessibleFalse,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DatastoreAccessible: %q", s)
+}
+
 func init() {
 	t["DatastoreAccessible"] = reflect.TypeOf((*DatastoreAccessible)(nil)).Elem()
 }
@@ -678,6 +1584,23 @@ const (
 	DatastoreSummaryMaintenanceModeStateInMaintenance       = DatastoreSummaryMaintenanceModeState("inMaintenance")
 )
 
+func (e DatastoreSummaryMaintenanceModeState) String() string {
+	return string(e)
+}
+
+func ParseDatastoreSummaryMaintenanceModeState(s string) (DatastoreSummaryMaintenanceModeState, error) {
+	for _, e := range []DatastoreSummaryMaintenanceModeState{
+		DatastoreSummaryMaintenanceModeStateNormal,
+		DatastoreSummaryMaintenanceModeStateEnteringMaintenance,
+		DatastoreSummaryMaintenanceModeStateInMaintenance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DatastoreSummaryMaintenanceModeState: %q", s)
+}
+
 func init() {
 	t["DatastoreSummaryMaintenanceModeState"] = reflect.TypeOf((*DatastoreSummaryMaintenanceModeState)(nil)).Elem()
 }
@@ -694,6 +1617,27 @@ const (
 	DayOfWeekSaturday  = DayOfWeek("saturday")
 )
 
+func (e DayOfWeek) String() string {
+	return string(e)
+}
+
+func ParseDayOfWeek(s string) (DayOfWeek, error) {
+	for _, e := range []DayOfWeek{
+		DayOfWeekSunday,
+		DayOfWeekMonday,
+		DayOfWeekTuesday,
+		DayOfWeekWednesday,
+		DayOfWeekThursday,
+		DayOfWeekFriday,
+		DayOfWeekSaturday,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DayOfWeek: %q", s)
+}
+
 func init() {
 	t["DayOfWeek"] = reflect.TypeOf((*DayOfWeek)(nil)).Elem()
 }
@@ -705,6 +1649,22 @@ const (
 	DeviceNotSupportedReasonGuest = DeviceNotSupportedReason("guest")
 )
 
+func (e DeviceNotSupportedReason) String() string {
+	return string(e)
+}
+
+func ParseDeviceNotSupportedReason(s string) (DeviceNotSupportedReason, error) {
+	for _, e := range []DeviceNotSupportedReason{
+		DeviceNotSupportedReasonHost,
+		DeviceNotSupportedReasonGuest,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DeviceNotSupportedReason: %q", s)
+}
+
 func init() {
 	t["DeviceNotSupportedReason"] = reflect.TypeOf((*DeviceNotSupportedReason)(nil)).Elem()
 }
@@ -721,6 +1681,27 @@ const (
 	DiagnosticManagerLogCreatorRecordLog = DiagnosticManagerLogCreator("recordLog")
 )
 
+func (e DiagnosticManagerLogCreator) String() string {
+	return string(e)
+}
+
+func ParseDiagnosticManagerLogCreator(s string) (DiagnosticManagerLogCreator, error) {
+	for _, e := range []DiagnosticManagerLogCreator{
+		DiagnosticManagerLogCreatorVpxd,
+		DiagnosticManagerLogCreatorVpxa,
+		DiagnosticManagerLogCreatorHostd,
+		DiagnosticManagerLogCreatorServerd,
+		DiagnosticManagerLogCreatorInstall,
+		DiagnosticManagerLogCreatorVpxClient,
+		DiagnosticManagerLogCreatorRecordLog,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DiagnosticManagerLogCreator: %q", s)
+}
+
 func init() {
 	t["DiagnosticManagerLogCreator"] = reflect.TypeOf((*DiagnosticManagerLogCreator)(nil)).Elem()
 }
@@ -731,6 +1712,21 @@ const (
 	DiagnosticManagerLogFormatPlain = DiagnosticManagerLogFormat("plain")
 )
 
+func (e DiagnosticManagerLogFormat) String() string {
+	return string(e)
+}
+
+func ParseDiagnosticManagerLogFormat(s string) (DiagnosticManagerLogFormat, error) {
+	for _, e := range []DiagnosticManagerLogFormat{
+		DiagnosticManagerLogFormatPlain,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DiagnosticManagerLogFormat: %q", s)
+}
+
 func init() {
 	t["DiagnosticManagerLogFormat"] = reflect.TypeOf((*DiagnosticManagerLogFormat)(nil)).Elem()
 }
@@ -742,6 +1738,22 @@ const (
 	DiagnosticPartitionStorageTypeNetworkAttached = DiagnosticPartitionStorageType("networkAttached")
 )
 
+func (e DiagnosticPartitionStorageType) String() string {
+	return string(e)
+}
+
+func ParseDiagnosticPartitionStorageType(s string) (DiagnosticPartitionStorageType, error) {
+	for _, e := range []DiagnosticPartitionStorageType{
+		DiagnosticPartitionStorageTypeDirectAttached,
+		DiagnosticPartitionStorageTypeNetworkAttached,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DiagnosticPartitionStorageType: %q", s)
+}
+
 func init() {
 	t["DiagnosticPartitionStorageType"] = reflect.TypeOf((*DiagnosticPartitionStorageType)(nil)).Elem()
 }
@@ -753,6 +1765,22 @@ const (
 	DiagnosticPartitionTypeMultiHost  = DiagnosticPartitionType("multiHost")
 )
 
+func (e DiagnosticPartitionType) String() string {
+	return string(e)
+}
+
+func ParseDiagnosticPartitionType(s string) (DiagnosticPartitionType, error) {
+	for _, e := range []DiagnosticPartitionType{
+		DiagnosticPartitionTypeSingleHost,
+		DiagnosticPartitionTypeMultiHost,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DiagnosticPartitionType: %q", s)
+}
+
 func init() {
 	t["DiagnosticPartitionType"] = reflect.TypeOf((*DiagnosticPartitionType)(nil)).Elem()
 }
@@ -763,6 +1791,21 @@ const (
 	DisallowedChangeByServiceDisallowedChangeHotExtendDisk = DisallowedChangeByServiceDisallowedChange("hotExtendDisk")
 )
 
+func (e DisallowedChangeByServiceDisallowedChange) String() string {
+	return string(e)
+}
+
+func ParseDisallowedChangeByServiceDisallowedChange(s string) (DisallowedChangeByServiceDisallowedChange, error) {
+	for _, e := range []DisallowedChangeByServiceDisallowedChange{
+		DisallowedChangeByServiceDisallowedChangeHotExtendDisk,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DisallowedChangeByServiceDisallowedChange: %q", s)
+}
+
 func init() {
 	t["DisallowedChangeByServiceDisallowedChange"] = reflect.TypeOf((*DisallowedChangeByServiceDisallowedChange)(nil)).Elem()
 }
@@ -774,6 +1817,22 @@ const (
 	DistributedVirtualPortgroupBackingTypeNsx      = DistributedVirtualPortgroupBackingType("nsx")
 )
 
+func (e DistributedVirtualPortgroupBackingType) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualPortgroupBackingType(s string) (DistributedVirtualPortgroupBackingType, error) {
+	for _, e := range []DistributedVirtualPortgroupBackingType{
+		DistributedVirtualPortgroupBackingTypeStandard,
+		DistributedVirtualPortgroupBackingTypeNsx,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualPortgroupBackingType: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualPortgroupBackingType"] = reflect.TypeOf((*DistributedVirtualPortgroupBackingType)(nil)).Elem()
 }
@@ -786,6 +1845,23 @@ const (
 	DistributedVirtualPortgroupMetaTagNamePortIndex     = DistributedVirtualPortgroupMetaTagName("portIndex")
 )
 
+func (e DistributedVirtualPortgroupMetaTagName) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualPortgroupMetaTagName(s string) (DistributedVirtualPortgroupMetaTagName, error) {
+	for _, e := range []DistributedVirtualPortgroupMetaTagName{
+		DistributedVirtualPortgroupMetaTagNameDvsName,
+		DistributedVirtualPortgroupMetaTagNamePortgroupName,
+		DistributedVirtualPortgroupMetaTagNamePortIndex,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualPortgroupMetaTagName: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualPortgroupMetaTagName"] = reflect.TypeOf((*DistributedVirtualPortgroupMetaTagName)(nil)).Elem()
 }
@@ -798,6 +1874,23 @@ const (
 	DistributedVirtualPortgroupPortgroupTypeEphemeral    = DistributedVirtualPortgroupPortgroupType("ephemeral")
 )
 
+func (e DistributedVirtualPortgroupPortgroupType) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualPortgroupPortgroupType(s string) (DistributedVirtualPortgroupPortgroupType, error) {
+	for _, e := range []DistributedVirtualPortgroupPortgroupType{
+		DistributedVirtualPortgroupPortgroupTypeEarlyBinding,
+		DistributedVirtualPortgroupPortgroupTypeLateBinding,
+		DistributedVirtualPortgroupPortgroupTypeEphemeral,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualPortgroupPortgroupType: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualPortgroupPortgroupType"] = reflect.TypeOf((*DistributedVirtualPortgroupPortgroupType)(nil)).Elem()
 }
@@ -817,6 +1910,30 @@ const (
 	DistributedVirtualSwitchHostInfrastructureTrafficClassBackupNfc      = DistributedVirtualSwitchHostInfrastructureTrafficClass("backupNfc")
 )
 
+func (e DistributedVirtualSwitchHostInfrastructureTrafficClass) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchHostInfrastructureTrafficClass(s string) (DistributedVirtualSwitchHostInfrastructureTrafficClass, error) {
+	for _, e := range []DistributedVirtualSwitchHostInfrastructureTrafficClass{
+		DistributedVirtualSwitchHostInfrastructureTrafficClassManagement,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassFaultTolerance,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVmotion,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVirtualMachine,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassISCSI,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassNfs,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassHbr,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVsan,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassVdp,
+		DistributedVirtualSwitchHostInfrastructureTrafficClassBackupNfc,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchHostInfrastructureTrafficClass: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchHostInfrastructureTrafficClass"] = reflect.TypeOf((*DistributedVirtualSwitchHostInfrastructureTrafficClass)(nil)).Elem()
 }
@@ -832,6 +1949,26 @@ const (
 	DistributedVirtualSwitchHostMemberHostComponentStateDown         = DistributedVirtualSwitchHostMemberHostComponentState("down")
 )
 
+func (e DistributedVirtualSwitchHostMemberHostComponentState) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchHostMemberHostComponentState(s string) (DistributedVirtualSwitchHostMemberHostComponentState, error) {
+	for _, e := range []DistributedVirtualSwitchHostMemberHostComponentState{
+		DistributedVirtualSwitchHostMemberHostComponentStateUp,
+		DistributedVirtualSwitchHostMemberHostComponentStatePending,
+		DistributedVirtualSwitchHostMemberHostComponentStateOutOfSync,
+		DistributedVirtualSwitchHostMemberHostComponentStateWarning,
+		DistributedVirtualSwitchHostMemberHostComponentStateDisconnected,
+		DistributedVirtualSwitchHostMemberHostComponentStateDown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchHostMemberHostComponentState: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchHostMemberHostComponentState"] = reflect.TypeOf((*DistributedVirtualSwitchHostMemberHostComponentState)(nil)).Elem()
 }
@@ -843,17 +1980,49 @@ const (
 	DistributedVirtualSwitchHostMemberTransportZoneTypeOverlay = DistributedVirtualSwitchHostMemberTransportZoneType("overlay")
 )
 
-func init() {
-	t["DistributedVirtualSwitchHostMemberTransportZoneType"] = reflect.TypeOf((*DistributedVirtualSwitchHostMemberTransportZoneType)(nil)).Elem()
+func (e DistributedVirtualSwitchHostMemberTransportZoneType) String() string {
+	return string(e)
 }
 
-type DistributedVirtualSwitchNetworkResourceControlVersion string
+func ParseDistributedVirtualSwitchHostMemberTransportZoneType(s string) (DistributedVirtualSwitchHostMemberTransportZoneType, error) {
+	for _, e := range []DistributedVirtualSwitchHostMemberTransportZoneType{
+		DistributedVirtualSwitchHostMemberTransportZoneTypeVlan,
+		DistributedVirtualSwitchHostMemberTransportZoneTypeOverlay,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchHostMemberTransportZoneType: %q", s)
+}
+
+func init() {
+	t["DistributedVirtualSwitchHostMemberTransportZoneType"] = reflect.TypeOf((*DistributedVirtualSwitchHostMemberTransportZoneType)(nil)).Elem()
+}
+
+type DistributedVirtualSwitchNetworkResourceControlVersion string
 
 const (
 	DistributedVirtualSwitchNetworkResourceControlVersionVersion2 = DistributedVirtualSwitchNetworkResourceControlVersion("version2")
 	DistributedVirtualSwitchNetworkResourceControlVersionVersion3 = DistributedVirtualSwitchNetworkResourceControlVersion("version3")
 )
 
+func (e DistributedVirtualSwitchNetworkResourceControlVersion) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchNetworkResourceControlVersion(s string) (DistributedVirtualSwitchNetworkResourceControlVersion, error) {
+	for _, e := range []DistributedVirtualSwitchNetworkResourceControlVersion{
+		DistributedVirtualSwitchNetworkResourceControlVersionVersion2,
+		DistributedVirtualSwitchNetworkResourceControlVersionVersion3,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchNetworkResourceControlVersion: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchNetworkResourceControlVersion"] = reflect.TypeOf((*DistributedVirtualSwitchNetworkResourceControlVersion)(nil)).Elem()
 }
@@ -868,6 +2037,25 @@ const (
 	DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_loadbased = DistributedVirtualSwitchNicTeamingPolicyMode("loadbalance_loadbased")
 )
 
+func (e DistributedVirtualSwitchNicTeamingPolicyMode) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchNicTeamingPolicyMode(s string) (DistributedVirtualSwitchNicTeamingPolicyMode, error) {
+	for _, e := range []DistributedVirtualSwitchNicTeamingPolicyMode{
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_ip,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_srcmac,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_srcid,
+		DistributedVirtualSwitchNicTeamingPolicyModeFailover_explicit,
+		DistributedVirtualSwitchNicTeamingPolicyModeLoadbalance_loadbased,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchNicTeamingPolicyMode: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchNicTeamingPolicyMode"] = reflect.TypeOf((*DistributedVirtualSwitchNicTeamingPolicyMode)(nil)).Elem()
 }
@@ -881,6 +2069,24 @@ const (
 	DistributedVirtualSwitchPortConnecteeConnecteeTypeHostVmkVnic     = DistributedVirtualSwitchPortConnecteeConnecteeType("hostVmkVnic")
 )
 
+func (e DistributedVirtualSwitchPortConnecteeConnecteeType) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchPortConnecteeConnecteeType(s string) (DistributedVirtualSwitchPortConnecteeConnecteeType, error) {
+	for _, e := range []DistributedVirtualSwitchPortConnecteeConnecteeType{
+		DistributedVirtualSwitchPortConnecteeConnecteeTypePnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeVmVnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeHostConsoleVnic,
+		DistributedVirtualSwitchPortConnecteeConnecteeTypeHostVmkVnic,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchPortConnecteeConnecteeType: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchPortConnecteeConnecteeType"] = reflect.TypeOf((*DistributedVirtualSwitchPortConnecteeConnecteeType)(nil)).Elem()
 }
@@ -895,6 +2101,25 @@ const (
 	DistributedVirtualSwitchProductSpecOperationTypeUpdateBundleInfo       = DistributedVirtualSwitchProductSpecOperationType("updateBundleInfo")
 )
 
+func (e DistributedVirtualSwitchProductSpecOperationType) String() string {
+	return string(e)
+}
+
+func ParseDistributedVirtualSwitchProductSpecOperationType(s string) (DistributedVirtualSwitchProductSpecOperationType, error) {
+	for _, e := range []DistributedVirtualSwitchProductSpecOperationType{
+		DistributedVirtualSwitchProductSpecOperationTypePreInstall,
+		DistributedVirtualSwitchProductSpecOperationTypeUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeNotifyAvailableUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeProceedWithUpgrade,
+		DistributedVirtualSwitchProductSpecOperationTypeUpdateBundleInfo,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DistributedVirtualSwitchProductSpecOperationType: %q", s)
+}
+
 func init() {
 	t["DistributedVirtualSwitchProductSpecOperationType"] = reflect.TypeOf((*DistributedVirtualSwitchProductSpecOperationType)(nil)).Elem()
 }
@@ -906,6 +2131,22 @@ const (
 	DpmBehaviorAutomated = DpmBehavior("automated")
 )
 
+func (e DpmBehavior) String() string {
+	return string(e)
+}
+
+func ParseDpmBehavior(s string) (DpmBehavior, error) {
+	for _, e := range []DpmBehavior{
+		DpmBehaviorManual,
+		DpmBehaviorAutomated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DpmBehavior: %q", s)
+}
+
 func init() {
 	t["DpmBehavior"] = reflect.TypeOf((*DpmBehavior)(nil)).Elem()
 }
@@ -918,6 +2159,23 @@ const (
 	DrsBehaviorFullyAutomated     = DrsBehavior("fullyAutomated")
 )
 
+func (e DrsBehavior) String() string {
+	return string(e)
+}
+
+func ParseDrsBehavior(s string) (DrsBehavior, error) {
+	for _, e := range []DrsBehavior{
+		DrsBehaviorManual,
+		DrsBehaviorPartiallyAutomated,
+		DrsBehaviorFullyAutomated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DrsBehavior: %q", s)
+}
+
 func init() {
 	t["DrsBehavior"] = reflect.TypeOf((*DrsBehavior)(nil)).Elem()
 }
@@ -929,6 +2187,22 @@ const (
 	DrsInjectorWorkloadCorrelationStateUncorrelated = DrsInjectorWorkloadCorrelationState("Uncorrelated")
 )
 
+func (e DrsInjectorWorkloadCorrelationState) String() string {
+	return string(e)
+}
+
+func ParseDrsInjectorWorkloadCorrelationState(s string) (DrsInjectorWorkloadCorrelationState, error) {
+	for _, e := range []DrsInjectorWorkloadCorrelationState{
+		DrsInjectorWorkloadCorrelationStateCorrelated,
+		DrsInjectorWorkloadCorrelationStateUncorrelated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DrsInjectorWorkloadCorrelationState: %q", s)
+}
+
 func init() {
 	t["DrsInjectorWorkloadCorrelationState"] = reflect.TypeOf((*DrsInjectorWorkloadCorrelationState)(nil)).Elem()
 }
@@ -943,6 +2217,25 @@ const (
 	DrsRecommendationReasonCodeHostMaint      = DrsRecommendationReasonCode("hostMaint")
 )
 
+func (e DrsRecommendationReasonCode) String() string {
+	return string(e)
+}
+
+func ParseDrsRecommendationReasonCode(s string) (DrsRecommendationReasonCode, error) {
+	for _, e := range []DrsRecommendationReasonCode{
+		DrsRecommendationReasonCodeFairnessCpuAvg,
+		DrsRecommendationReasonCodeFairnessMemAvg,
+		DrsRecommendationReasonCodeJointAffin,
+		DrsRecommendationReasonCodeAntiAffin,
+		DrsRecommendationReasonCodeHostMaint,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DrsRecommendationReasonCode: %q", s)
+}
+
 func init() {
 	t["DrsRecommendationReasonCode"] = reflect.TypeOf((*DrsRecommendationReasonCode)(nil)).Elem()
 }
@@ -956,6 +2249,24 @@ const (
 	DvsEventPortBlockStateUnknown   = DvsEventPortBlockState("unknown")
 )
 
+func (e DvsEventPortBlockState) String() string {
+	return string(e)
+}
+
+func ParseDvsEventPortBlockState(s string) (DvsEventPortBlockState, error) {
+	for _, e := range []DvsEventPortBlockState{
+		DvsEventPortBlockStateUnset,
+		DvsEventPortBlockStateBlocked,
+		DvsEventPortBlockStateUnblocked,
+		DvsEventPortBlockStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DvsEventPortBlockState: %q", s)
+}
+
 func init() {
 	t["DvsEventPortBlockState"] = reflect.TypeOf((*DvsEventPortBlockState)(nil)).Elem()
 }
@@ -967,6 +2278,22 @@ const (
 	DvsFilterOnFailureFailClosed = DvsFilterOnFailure("failClosed")
 )
 
+func (e DvsFilterOnFailure) String() string {
+	return string(e)
+}
+
+func ParseDvsFilterOnFailure(s string) (DvsFilterOnFailure, error) {
+	for _, e := range []DvsFilterOnFailure{
+		DvsFilterOnFailureFailOpen,
+		DvsFilterOnFailureFailClosed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DvsFilterOnFailure: %q", s)
+}
+
 func init() {
 	t["DvsFilterOnFailure"] = reflect.TypeOf((*DvsFilterOnFailure)(nil)).Elem()
 }
@@ -979,6 +2306,23 @@ const (
 	DvsNetworkRuleDirectionTypeBoth            = DvsNetworkRuleDirectionType("both")
 )
 
+func (e DvsNetworkRuleDirectionType) String() string {
+	return string(e)
+}
+
+func ParseDvsNetworkRuleDirectionType(s string) (DvsNetworkRuleDirectionType, error) {
+	for _, e := range []DvsNetworkRuleDirectionType{
+		DvsNetworkRuleDirectionTypeIncomingPackets,
+		DvsNetworkRuleDirectionTypeOutgoingPackets,
+		DvsNetworkRuleDirectionTypeBoth,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid DvsNetworkRuleDirectionType: %q", s)
+}
+
 func init() {
 	t["DvsNetworkRuleDirectionType"] = reflect.TypeOf((*DvsNetworkRuleDirectionType)(nil)).Elem()
 }
@@ -991,6 +2335,23 @@ const (
 	EntityImportTypeApplyToEntitySpecified             = EntityImportType("applyToEntitySpecified")
 )
 
+func (e EntityImportType) String() string {
+	return string(e)
+}
+
+func ParseEntityImportType(s string) (EntityImportType, error) {
+	for _, e := range []EntityImportType{
+		EntityImportTypeCreateEntityWithNewIdentifier,
+		EntityImportTypeCreateEntityWithOriginalIdentifier,
+		EntityImportTypeApplyToEntitySpecified,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EntityImportType: %q", s)
+}
+
 func init() {
 	t["EntityImportType"] = reflect.TypeOf((*EntityImportType)(nil)).Elem()
 }
@@ -1002,6 +2363,22 @@ const (
 	EntityTypeDistributedVirtualPortgroup = EntityType("distributedVirtualPortgroup")
 )
 
+func (e EntityType) String() string {
+	return string(e)
+}
+
+func ParseEntityType(s string) (EntityType, error) {
+	for _, e := range []EntityType{
+		EntityTypeDistributedVirtualSwitch,
+		EntityTypeDistributedVirtualPortgroup,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EntityType: %q", s)
+}
+
 func init() {
 	t["EntityType"] = reflect.TypeOf((*EntityType)(nil)).Elem()
 }
@@ -1017,6 +2394,26 @@ const (
 	EventAlarmExpressionComparisonOperatorDoesNotEndWith   = EventAlarmExpressionComparisonOperator("doesNotEndWith")
 )
 
+func (e EventAlarmExpressionComparisonOperator) String() string {
+	return string(e)
+}
+
+func ParseEventAlarmExpressionComparisonOperator(s string) (EventAlarmExpressionComparisonOperator, error) {
+	for _, e := range []EventAlarmExpressionComparisonOperator{
+		EventAlarmExpressionComparisonOperatorEquals,
+		EventAlarmExpressionComparisonOperatorNotEqualTo,
+		EventAlarmExpressionComparisonOperatorStartsWith,
+		EventAlarmExpressionComparisonOperatorDoesNotStartWith,
+		EventAlarmExpressionComparisonOperatorEndsWith,
+		EventAlarmExpressionComparisonOperatorDoesNotEndWith,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventAlarmExpressionComparisonOperator: %q", s)
+}
+
 func init() {
 	t["EventAlarmExpressionComparisonOperator"] = reflect.TypeOf((*EventAlarmExpressionComparisonOperator)(nil)).Elem()
 }
@@ -1030,6 +2427,24 @@ const (
 	EventCategoryUser    = EventCategory("user")
 )
 
+func (e EventCategory) String() string {
+	return string(e)
+}
+
+func ParseEventCategory(s string) (EventCategory, error) {
+	for _, e := range []EventCategory{
+		EventCategoryInfo,
+		EventCategoryWarning,
+		EventCategoryError,
+		EventCategoryUser,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventCategory: %q", s)
+}
+
 func init() {
 	t["EventCategory"] = reflect.TypeOf((*EventCategory)(nil)).Elem()
 }
@@ -1043,6 +2458,24 @@ const (
 	EventEventSeverityUser    = EventEventSeverity("user")
 )
 
+func (e EventEventSeverity) String() string {
+	return string(e)
+}
+
+func ParseEventEventSeverity(s string) (EventEventSeverity, error) {
+	for _, e := range []EventEventSeverity{
+		EventEventSeverityError,
+		EventEventSeverityWarning,
+		EventEventSeverityInfo,
+		EventEventSeverityUser,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventEventSeverity: %q", s)
+}
+
 func init() {
 	t["EventEventSeverity"] = reflect.TypeOf((*EventEventSeverity)(nil)).Elem()
 }
@@ -1055,6 +2488,23 @@ const (
 	EventFilterSpecRecursionOptionAll      = EventFilterSpecRecursionOption("all")
 )
 
+func (e EventFilterSpecRecursionOption) String() string {
+	return string(e)
+}
+
+func ParseEventFilterSpecRecursionOption(s string) (EventFilterSpecRecursionOption, error) {
+	for _, e := range []EventFilterSpecRecursionOption{
+		EventFilterSpecRecursionOptionSelf,
+		EventFilterSpecRecursionOptionChildren,
+		EventFilterSpecRecursionOptionAll,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid EventFilterSpecRecursionOption: %q", s)
+}
+
 func init() {
 	t["EventFilterSpecRecursionOption"] = reflect.TypeOf((*EventFilterSpecRecursionOption)(nil)).Elem()
 }
@@ -1068,6 +2518,24 @@ const (
 	FibreChannelPortTypeUnknown      = FibreChannelPortType("unknown")
 )
 
+func (e FibreChannelPortType) String() string {
+	return string(e)
+}
+
+func ParseFibreChannelPortType(s string) (FibreChannelPortType, error) {
+	for _, e := range []FibreChannelPortType{
+		FibreChannelPortTypeFabric,
+		FibreChannelPortTypeLoop,
+		FibreChannelPortTypePointToPoint,
+		FibreChannelPortTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FibreChannelPortType: %q", s)
+}
+
 func init() {
 	t["FibreChannelPortType"] = reflect.TypeOf((*FibreChannelPortType)(nil)).Elem()
 }
@@ -1080,6 +2548,23 @@ const (
 	FileSystemMountInfoVStorageSupportStatusVStorageUnknown     = FileSystemMountInfoVStorageSupportStatus("vStorageUnknown")
 )
 
+func (e FileSystemMountInfoVStorageSupportStatus) String() string {
+	return string(e)
+}
+
+func ParseFileSystemMountInfoVStorageSupportStatus(s string) (FileSystemMountInfoVStorageSupportStatus, error) {
+	for _, e := range []FileSystemMountInfoVStorageSupportStatus{
+		FileSystemMountInfoVStorageSupportStatusVStorageSupported,
+		FileSystemMountInfoVStorageSupportStatusVStorageUnsupported,
+		FileSystemMountInfoVStorageSupportStatusVStorageUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FileSystemMountInfoVStorageSupportStatus: %q", s)
+}
+
 func init() {
 	t["FileSystemMountInfoVStorageSupportStatus"] = reflect.TypeOf((*FileSystemMountInfoVStorageSupportStatus)(nil)).Elem()
 }
@@ -1091,6 +2576,22 @@ const (
 	FolderDesiredHostStateNon_maintenance = FolderDesiredHostState("non_maintenance")
 )
 
+func (e FolderDesiredHostState) String() string {
+	return string(e)
+}
+
+func ParseFolderDesiredHostState(s string) (FolderDesiredHostState, error) {
+	for _, e := range []FolderDesiredHostState{
+		FolderDesiredHostStateMaintenance,
+		FolderDesiredHostStateNon_maintenance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FolderDesiredHostState: %q", s)
+}
+
 func init() {
 	t["FolderDesiredHostState"] = reflect.TypeOf((*FolderDesiredHostState)(nil)).Elem()
 }
@@ -1103,6 +2604,23 @@ const (
 	FtIssuesOnHostHostSelectionTypeDrs  = FtIssuesOnHostHostSelectionType("drs")
 )
 
+func (e FtIssuesOnHostHostSelectionType) String() string {
+	return string(e)
+}
+
+func ParseFtIssuesOnHostHostSelectionType(s string) (FtIssuesOnHostHostSelectionType, error) {
+	for _, e := range []FtIssuesOnHostHostSelectionType{
+		FtIssuesOnHostHostSelectionTypeUser,
+		FtIssuesOnHostHostSelectionTypeVc,
+		FtIssuesOnHostHostSelectionTypeDrs,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid FtIssuesOnHostHostSelectionType: %q", s)
+}
+
 func init() {
 	t["FtIssuesOnHostHostSelectionType"] = reflect.TypeOf((*FtIssuesOnHostHostSelectionType)(nil)).Elem()
 }
@@ -1115,6 +2633,23 @@ const (
 	GuestFileTypeSymlink   = GuestFileType("symlink")
 )
 
+func (e GuestFileType) String() string {
+	return string(e)
+}
+
+func ParseGuestFileType(s string) (GuestFileType, error) {
+	for _, e := range []GuestFileType{
+		GuestFileTypeFile,
+		GuestFileTypeDirectory,
+		GuestFileTypeSymlink,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestFileType: %q", s)
+}
+
 func init() {
 	t["GuestFileType"] = reflect.TypeOf((*GuestFileType)(nil)).Elem()
 }
@@ -1127,6 +2662,23 @@ const (
 	GuestInfoAppStateTypeAppStateNeedReset = GuestInfoAppStateType("appStateNeedReset")
 )
 
+func (e GuestInfoAppStateType) String() string {
+	return string(e)
+}
+
+func ParseGuestInfoAppStateType(s string) (GuestInfoAppStateType, error) {
+	for _, e := range []GuestInfoAppStateType{
+		GuestInfoAppStateTypeNone,
+		GuestInfoAppStateTypeAppStateOk,
+		GuestInfoAppStateTypeAppStateNeedReset,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestInfoAppStateType: %q", s)
+}
+
 func init() {
 	t["GuestInfoAppStateType"] = reflect.TypeOf((*GuestInfoAppStateType)(nil)).Elem()
 }
@@ -1141,6 +2693,25 @@ const (
 	GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED    = GuestInfoCustomizationStatus("TOOLSDEPLOYPKG_FAILED")
 )
 
+func (e GuestInfoCustomizationStatus) String() string {
+	return string(e)
+}
+
+func ParseGuestInfoCustomizationStatus(s string) (GuestInfoCustomizationStatus, error) {
+	for _, e := range []GuestInfoCustomizationStatus{
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_IDLE,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_PENDING,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_RUNNING,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_SUCCEEDED,
+		GuestInfoCustomizationStatusTOOLSDEPLOYPKG_FAILED,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestInfoCustomizationStatus: %q", s)
+}
+
 func init() {
 	t["GuestInfoCustomizationStatus"] = reflect.TypeOf((*GuestInfoCustomizationStatus)(nil)).Elem()
 }
@@ -1152,6 +2723,22 @@ const (
 	GuestOsDescriptorFirmwareTypeEfi  = GuestOsDescriptorFirmwareType("efi")
 )
 
+func (e GuestOsDescriptorFirmwareType) String() string {
+	return string(e)
+}
+
+func ParseGuestOsDescriptorFirmwareType(s string) (GuestOsDescriptorFirmwareType, error) {
+	for _, e := range []GuestOsDescriptorFirmwareType{
+		GuestOsDescriptorFirmwareTypeBios,
+		GuestOsDescriptorFirmwareTypeEfi,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestOsDescriptorFirmwareType: %q", s)
+}
+
 func init() {
 	t["GuestOsDescriptorFirmwareType"] = reflect.TypeOf((*GuestOsDescriptorFirmwareType)(nil)).Elem()
 }
@@ -1168,6 +2755,27 @@ const (
 	GuestOsDescriptorSupportLevelTechPreview  = GuestOsDescriptorSupportLevel("techPreview")
 )
 
+func (e GuestOsDescriptorSupportLevel) String() string {
+	return string(e)
+}
+
+func ParseGuestOsDescriptorSupportLevel(s string) (GuestOsDescriptorSupportLevel, error) {
+	for _, e := range []GuestOsDescriptorSupportLevel{
+		GuestOsDescriptorSupportLevelExperimental,
+		GuestOsDescriptorSupportLevelLegacy,
+		GuestOsDescriptorSupportLevelTerminated,
+		GuestOsDescriptorSupportLevelSupported,
+		GuestOsDescriptorSupportLevelUnsupported,
+		GuestOsDescriptorSupportLevelDeprecated,
+		GuestOsDescriptorSupportLevelTechPreview,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestOsDescriptorSupportLevel: %q", s)
+}
+
 func init() {
 	t["GuestOsDescriptorSupportLevel"] = reflect.TypeOf((*GuestOsDescriptorSupportLevel)(nil)).Elem()
 }
@@ -1180,6 +2788,23 @@ const (
 	GuestRegKeyWowSpecWOW64     = GuestRegKeyWowSpec("WOW64")
 )
 
+func (e GuestRegKeyWowSpec) String() string {
+	return string(e)
+}
+
+func ParseGuestRegKeyWowSpec(s string) (GuestRegKeyWowSpec, error) {
+	for _, e := range []GuestRegKeyWowSpec{
+		GuestRegKeyWowSpecWOWNative,
+		GuestRegKeyWowSpecWOW32,
+		GuestRegKeyWowSpecWOW64,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid GuestRegKeyWowSpec: %q", s)
+}
+
 func init() {
 	t["GuestRegKeyWowSpec"] = reflect.TypeOf((*GuestRegKeyWowSpec)(nil)).Elem()
 }
@@ -1194,6 +2819,25 @@ const (
 	HealthUpdateInfoComponentTypeStorage = HealthUpdateInfoComponentType("Storage")
 )
 
+func (e HealthUpdateInfoComponentType) String() string {
+	return string(e)
+}
+
+func ParseHealthUpdateInfoComponentType(s string) (HealthUpdateInfoComponentType, error) {
+	for _, e := range []HealthUpdateInfoComponentType{
+		HealthUpdateInfoComponentTypeMemory,
+		HealthUpdateInfoComponentTypePower,
+		HealthUpdateInfoComponentTypeFan,
+		HealthUpdateInfoComponentTypeNetwork,
+		HealthUpdateInfoComponentTypeStorage,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HealthUpdateInfoComponentType: %q", s)
+}
+
 func init() {
 	t["HealthUpdateInfoComponentType"] = reflect.TypeOf((*HealthUpdateInfoComponentType)(nil)).Elem()
 }
@@ -1208,6 +2852,25 @@ const (
 	HostAccessModeAccessOther    = HostAccessMode("accessOther")
 )
 
+func (e HostAccessMode) String() string {
+	return string(e)
+}
+
+func ParseHostAccessMode(s string) (HostAccessMode, error) {
+	for _, e := range []HostAccessMode{
+		HostAccessModeAccessNone,
+		HostAccessModeAccessAdmin,
+		HostAccessModeAccessNoAccess,
+		HostAccessModeAccessReadOnly,
+		HostAccessModeAccessOther,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostAccessMode: %q", s)
+}
+
 func init() {
 	t["HostAccessMode"] = reflect.TypeOf((*HostAccessMode)(nil)).Elem()
 }
@@ -1218,6 +2881,21 @@ const (
 	HostActiveDirectoryAuthenticationCertificateDigestSHA1 = HostActiveDirectoryAuthenticationCertificateDigest("SHA1")
 )
 
+func (e HostActiveDirectoryAuthenticationCertificateDigest) String() string {
+	return string(e)
+}
+
+func ParseHostActiveDirectoryAuthenticationCertificateDigest(s string) (HostActiveDirectoryAuthenticationCertificateDigest, error) {
+	for _, e := range []HostActiveDirectoryAuthenticationCertificateDigest{
+		HostActiveDirectoryAuthenticationCertificateDigestSHA1,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostActiveDirectoryAuthenticationCertificateDigest: %q", s)
+}
+
 func init() {
 	t["HostActiveDirectoryAuthenticationCertificateDigest"] = reflect.TypeOf((*HostActiveDirectoryAuthenticationCertificateDigest)(nil)).Elem()
 }
@@ -1234,6 +2912,27 @@ const (
 	HostActiveDirectoryInfoDomainMembershipStatusOtherProblem      = HostActiveDirectoryInfoDomainMembershipStatus("otherProblem")
 )
 
+func (e HostActiveDirectoryInfoDomainMembershipStatus) String() string {
+	return string(e)
+}
+
+func ParseHostActiveDirectoryInfoDomainMembershipStatus(s string) (HostActiveDirectoryInfoDomainMembershipStatus, error) {
+	for _, e := range []HostActiveDirectoryInfoDomainMembershipStatus{
+		HostActiveDirectoryInfoDomainMembershipStatusUnknown,
+		HostActiveDirectoryInfoDomainMembershipStatusOk,
+		HostActiveDirectoryInfoDomainMembershipStatusNoServers,
+		HostActiveDirectoryInfoDomainMembershipStatusClientTrustBroken,
+		HostActiveDirectoryInfoDomainMembershipStatusServerTrustBroken,
+		HostActiveDirectoryInfoDomainMembershipStatusInconsistentTrust,
+		HostActiveDirectoryInfoDomainMembershipStatusOtherProblem,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostActiveDirectoryInfoDomainMembershipStatus: %q", s)
+}
+
 func init() {
 	t["HostActiveDirectoryInfoDomainMembershipStatus"] = reflect.TypeOf((*HostActiveDirectoryInfoDomainMembershipStatus)(nil)).Elem()
 }
@@ -1252,6 +2951,29 @@ const (
 	HostCapabilityFtUnsupportedReasonCpuHvDisabled       = HostCapabilityFtUnsupportedReason("cpuHvDisabled")
 )
 
+func (e HostCapabilityFtUnsupportedReason) String() string {
+	return string(e)
+}
+
+func ParseHostCapabilityFtUnsupportedReason(s string) (HostCapabilityFtUnsupportedReason, error) {
+	for _, e := range []HostCapabilityFtUnsupportedReason{
+		HostCapabilityFtUnsupportedReasonVMotionNotLicensed,
+		HostCapabilityFtUnsupportedReasonMissingVMotionNic,
+		HostCapabilityFtUnsupportedReasonMissingFTLoggingNic,
+		HostCapabilityFtUnsupportedReasonFtNotLicensed,
+		HostCapabilityFtUnsupportedReasonHaAgentIssue,
+		HostCapabilityFtUnsupportedReasonUnsupportedProduct,
+		HostCapabilityFtUnsupportedReasonCpuHvUnsupported,
+		HostCapabilityFtUnsupportedReasonCpuHwmmuUnsupported,
+		HostCapabilityFtUnsupportedReasonCpuHvDisabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCapabilityFtUnsupportedReason: %q", s)
+}
+
 func init() {
 	t["HostCapabilityFtUnsupportedReason"] = reflect.TypeOf((*HostCapabilityFtUnsupportedReason)(nil)).Elem()
 }
@@ -1264,6 +2986,23 @@ const (
 	HostCapabilityUnmapMethodSupportedDynamic  = HostCapabilityUnmapMethodSupported("dynamic")
 )
 
+func (e HostCapabilityUnmapMethodSupported) String() string {
+	return string(e)
+}
+
+func ParseHostCapabilityUnmapMethodSupported(s string) (HostCapabilityUnmapMethodSupported, error) {
+	for _, e := range []HostCapabilityUnmapMethodSupported{
+		HostCapabilityUnmapMethodSupportedPriority,
+		HostCapabilityUnmapMethodSupportedFixed,
+		HostCapabilityUnmapMethodSupportedDynamic,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCapabilityUnmapMethodSupported: %q", s)
+}
+
 func init() {
 	t["HostCapabilityUnmapMethodSupported"] = reflect.TypeOf((*HostCapabilityUnmapMethodSupported)(nil)).Elem()
 }
@@ -1276,6 +3015,23 @@ const (
 	HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptDisabled             = HostCapabilityVmDirectPathGen2UnsupportedReason("hostNptDisabled")
 )
 
+func (e HostCapabilityVmDirectPathGen2UnsupportedReason) String() string {
+	return string(e)
+}
+
+func ParseHostCapabilityVmDirectPathGen2UnsupportedReason(s string) (HostCapabilityVmDirectPathGen2UnsupportedReason, error) {
+	for _, e := range []HostCapabilityVmDirectPathGen2UnsupportedReason{
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptIncompatibleProduct,
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptIncompatibleHardware,
+		HostCapabilityVmDirectPathGen2UnsupportedReasonHostNptDisabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCapabilityVmDirectPathGen2UnsupportedReason: %q", s)
+}
+
 func init() {
 	t["HostCapabilityVmDirectPathGen2UnsupportedReason"] = reflect.TypeOf((*HostCapabilityVmDirectPathGen2UnsupportedReason)(nil)).Elem()
 }
@@ -1291,6 +3047,26 @@ const (
 	HostCertificateManagerCertificateInfoCertificateStatusGood               = HostCertificateManagerCertificateInfoCertificateStatus("good")
 )
 
+func (e HostCertificateManagerCertificateInfoCertificateStatus) String() string {
+	return string(e)
+}
+
+func ParseHostCertificateManagerCertificateInfoCertificateStatus(s string) (HostCertificateManagerCertificateInfoCertificateStatus, error) {
+	for _, e := range []HostCertificateManagerCertificateInfoCertificateStatus{
+		HostCertificateManagerCertificateInfoCertificateStatusUnknown,
+		HostCertificateManagerCertificateInfoCertificateStatusExpired,
+		HostCertificateManagerCertificateInfoCertificateStatusExpiring,
+		HostCertificateManagerCertificateInfoCertificateStatusExpiringShortly,
+		HostCertificateManagerCertificateInfoCertificateStatusExpirationImminent,
+		HostCertificateManagerCertificateInfoCertificateStatusGood,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCertificateManagerCertificateInfoCertificateStatus: %q", s)
+}
+
 func init() {
 	t["HostCertificateManagerCertificateInfoCertificateStatus"] = reflect.TypeOf((*HostCertificateManagerCertificateInfoCertificateStatus)(nil)).Elem()
 }
@@ -1302,6 +3078,22 @@ const (
 	HostConfigChangeModeReplace = HostConfigChangeMode("replace")
 )
 
+func (e HostConfigChangeMode) String() string {
+	return string(e)
+}
+
+func ParseHostConfigChangeMode(s string) (HostConfigChangeMode, error) {
+	for _, e := range []HostConfigChangeMode{
+		HostConfigChangeModeModify,
+		HostConfigChangeModeReplace,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostConfigChangeMode: %q", s)
+}
+
 func init() {
 	t["HostConfigChangeMode"] = reflect.TypeOf((*HostConfigChangeMode)(nil)).Elem()
 }
@@ -1315,6 +3107,24 @@ const (
 	HostConfigChangeOperationIgnore = HostConfigChangeOperation("ignore")
 )
 
+func (e HostConfigChangeOperation) String() string {
+	return string(e)
+}
+
+func ParseHostConfigChangeOperation(s string) (HostConfigChangeOperation, error) {
+	for _, e := range []HostConfigChangeOperation{
+		HostConfigChangeOperationAdd,
+		HostConfigChangeOperationRemove,
+		HostConfigChangeOperationEdit,
+		HostConfigChangeOperationIgnore,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostConfigChangeOperation: %q", s)
+}
+
 func init() {
 	t["HostConfigChangeOperation"] = reflect.TypeOf((*HostConfigChangeOperation)(nil)).Elem()
 }
@@ -1328,6 +3138,24 @@ const (
 	HostCpuPackageVendorHygon   = HostCpuPackageVendor("hygon")
 )
 
+func (e HostCpuPackageVendor) String() string {
+	return string(e)
+}
+
+func ParseHostCpuPackageVendor(s string) (HostCpuPackageVendor, error) {
+	for _, e := range []HostCpuPackageVendor{
+		HostCpuPackageVendorUnknown,
+		HostCpuPackageVendorIntel,
+		HostCpuPackageVendorAmd,
+		HostCpuPackageVendorHygon,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCpuPackageVendor: %q", s)
+}
+
 func init() {
 	t["HostCpuPackageVendor"] = reflect.TypeOf((*HostCpuPackageVendor)(nil)).Elem()
 }
@@ -1340,6 +3168,23 @@ const (
 	HostCpuPowerManagementInfoPolicyTypeDynamicPolicy = HostCpuPowerManagementInfoPolicyType("dynamicPolicy")
 )
 
+func (e HostCpuPowerManagementInfoPolicyType) String() string {
+	return string(e)
+}
+
+func ParseHostCpuPowerManagementInfoPolicyType(s string) (HostCpuPowerManagementInfoPolicyType, error) {
+	for _, e := range []HostCpuPowerManagementInfoPolicyType{
+		HostCpuPowerManagementInfoPolicyTypeOff,
+		HostCpuPowerManagementInfoPolicyTypeStaticPolicy,
+		HostCpuPowerManagementInfoPolicyTypeDynamicPolicy,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCpuPowerManagementInfoPolicyType: %q", s)
+}
+
 func init() {
 	t["HostCpuPowerManagementInfoPolicyType"] = reflect.TypeOf((*HostCpuPowerManagementInfoPolicyType)(nil)).Elem()
 }
@@ -1353,6 +3198,24 @@ const (
 	HostCryptoStatePendingIncapable = HostCryptoState("pendingIncapable")
 )
 
+func (e HostCryptoState) String() string {
+	return string(e)
+}
+
+func ParseHostCryptoState(s string) (HostCryptoState, error) {
+	for _, e := range []HostCryptoState{
+		HostCryptoStateIncapable,
+		HostCryptoStatePrepared,
+		HostCryptoStateSafe,
+		HostCryptoStatePendingIncapable,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostCryptoState: %q", s)
+}
+
 func init() {
 	t["HostCryptoState"] = reflect.TypeOf((*HostCryptoState)(nil)).Elem()
 }
@@ -1370,6 +3233,28 @@ const (
 	HostDasErrorEventHostDasErrorReasonOther                      = HostDasErrorEventHostDasErrorReason("other")
 )
 
+func (e HostDasErrorEventHostDasErrorReason) String() string {
+	return string(e)
+}
+
+func ParseHostDasErrorEventHostDasErrorReason(s string) (HostDasErrorEventHostDasErrorReason, error) {
+	for _, e := range []HostDasErrorEventHostDasErrorReason{
+		HostDasErrorEventHostDasErrorReasonConfigFailed,
+		HostDasErrorEventHostDasErrorReasonTimeout,
+		HostDasErrorEventHostDasErrorReasonCommunicationInitFailed,
+		HostDasErrorEventHostDasErrorReasonHealthCheckScriptFailed,
+		HostDasErrorEventHostDasErrorReasonAgentFailed,
+		HostDasErrorEventHostDasErrorReasonAgentShutdown,
+		HostDasErrorEventHostDasErrorReasonIsolationAddressUnpingable,
+		HostDasErrorEventHostDasErrorReasonOther,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDasErrorEventHostDasErrorReason: %q", s)
+}
+
 func init() {
 	t["HostDasErrorEventHostDasErrorReason"] = reflect.TypeOf((*HostDasErrorEventHostDasErrorReason)(nil)).Elem()
 }
@@ -1381,6 +3266,22 @@ const (
 	HostDateTimeInfoProtocolPtp = HostDateTimeInfoProtocol("ptp")
 )
 
+func (e HostDateTimeInfoProtocol) String() string {
+	return string(e)
+}
+
+func ParseHostDateTimeInfoProtocol(s string) (HostDateTimeInfoProtocol, error) {
+	for _, e := range []HostDateTimeInfoProtocol{
+		HostDateTimeInfoProtocolNtp,
+		HostDateTimeInfoProtocolPtp,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDateTimeInfoProtocol: %q", s)
+}
+
 func init() {
 	t["HostDateTimeInfoProtocol"] = reflect.TypeOf((*HostDateTimeInfoProtocol)(nil)).Elem()
 }
@@ -1396,6 +3297,26 @@ const (
 	HostDigestInfoDigestMethodTypeSM3_256 = HostDigestInfoDigestMethodType("SM3_256")
 )
 
+func (e HostDigestInfoDigestMethodType) String() string {
+	return string(e)
+}
+
+func ParseHostDigestInfoDigestMethodType(s string) (HostDigestInfoDigestMethodType, error) {
+	for _, e := range []HostDigestInfoDigestMethodType{
+		HostDigestInfoDigestMethodTypeSHA1,
+		HostDigestInfoDigestMethodTypeMD5,
+		HostDigestInfoDigestMethodTypeSHA256,
+		HostDigestInfoDigestMethodTypeSHA384,
+		HostDigestInfoDigestMethodTypeSHA512,
+		HostDigestInfoDigestMethodTypeSM3_256,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDigestInfoDigestMethodType: %q", s)
+}
+
 func init() {
 	t["HostDigestInfoDigestMethodType"] = reflect.TypeOf((*HostDigestInfoDigestMethodType)(nil)).Elem()
 }
@@ -1414,6 +3335,29 @@ const (
 	HostDisconnectedEventReasonCodeVcVRAMCapacityExceeded    = HostDisconnectedEventReasonCode("vcVRAMCapacityExceeded")
 )
 
+func (e HostDisconnectedEventReasonCode) String() string {
+	return string(e)
+}
+
+func ParseHostDisconnectedEventReasonCode(s string) (HostDisconnectedEventReasonCode, error) {
+	for _, e := range []HostDisconnectedEventReasonCode{
+		HostDisconnectedEventReasonCodeSslThumbprintVerifyFailed,
+		HostDisconnectedEventReasonCodeLicenseExpired,
+		HostDisconnectedEventReasonCodeAgentUpgrade,
+		HostDisconnectedEventReasonCodeUserRequest,
+		HostDisconnectedEventReasonCodeInsufficientLicenses,
+		HostDisconnectedEventReasonCodeAgentOutOfDate,
+		HostDisconnectedEventReasonCodePasswordDecryptFailure,
+		HostDisconnectedEventReasonCodeUnknown,
+		HostDisconnectedEventReasonCodeVcVRAMCapacityExceeded,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDisconnectedEventReasonCode: %q", s)
+}
+
 func init() {
 	t["HostDisconnectedEventReasonCode"] = reflect.TypeOf((*HostDisconnectedEventReasonCode)(nil)).Elem()
 }
@@ -1426,6 +3370,23 @@ const (
 	HostDiskPartitionInfoPartitionFormatUnknown = HostDiskPartitionInfoPartitionFormat("unknown")
 )
 
+func (e HostDiskPartitionInfoPartitionFormat) String() string {
+	return string(e)
+}
+
+func ParseHostDiskPartitionInfoPartitionFormat(s string) (HostDiskPartitionInfoPartitionFormat, error) {
+	for _, e := range []HostDiskPartitionInfoPartitionFormat{
+		HostDiskPartitionInfoPartitionFormatGpt,
+		HostDiskPartitionInfoPartitionFormatMbr,
+		HostDiskPartitionInfoPartitionFormatUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDiskPartitionInfoPartitionFormat: %q", s)
+}
+
 func init() {
 	t["HostDiskPartitionInfoPartitionFormat"] = reflect.TypeOf((*HostDiskPartitionInfoPartitionFormat)(nil)).Elem()
 }
@@ -1443,6 +3404,28 @@ const (
 	HostDiskPartitionInfoTypeVffs          = HostDiskPartitionInfoType("vffs")
 )
 
+func (e HostDiskPartitionInfoType) String() string {
+	return string(e)
+}
+
+func ParseHostDiskPartitionInfoType(s string) (HostDiskPartitionInfoType, error) {
+	for _, e := range []HostDiskPartitionInfoType{
+		HostDiskPartitionInfoTypeNone,
+		HostDiskPartitionInfoTypeVmfs,
+		HostDiskPartitionInfoTypeLinuxNative,
+		HostDiskPartitionInfoTypeLinuxSwap,
+		HostDiskPartitionInfoTypeExtended,
+		HostDiskPartitionInfoTypeNtfs,
+		HostDiskPartitionInfoTypeVmkDiagnostic,
+		HostDiskPartitionInfoTypeVffs,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostDiskPartitionInfoType: %q", s)
+}
+
 func init() {
 	t["HostDiskPartitionInfoType"] = reflect.TypeOf((*HostDiskPartitionInfoType)(nil)).Elem()
 }
@@ -1453,6 +3436,21 @@ const (
 	HostFeatureVersionKeyFaultTolerance = HostFeatureVersionKey("faultTolerance")
 )
 
+func (e HostFeatureVersionKey) String() string {
+	return string(e)
+}
+
+func ParseHostFeatureVersionKey(s string) (HostFeatureVersionKey, error) {
+	for _, e := range []HostFeatureVersionKey{
+		HostFeatureVersionKeyFaultTolerance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostFeatureVersionKey: %q", s)
+}
+
 func init() {
 	t["HostFeatureVersionKey"] = reflect.TypeOf((*HostFeatureVersionKey)(nil)).Elem()
 }
@@ -1472,6 +3470,30 @@ const (
 	HostFileSystemVolumeFileSystemTypeOTHER = HostFileSystemVolumeFileSystemType("OTHER")
 )
 
+func (e HostFileSystemVolumeFileSystemType) String() string {
+	return string(e)
+}
+
+func ParseHostFileSystemVolumeFileSystemType(s string) (HostFileSystemVolumeFileSystemType, error) {
+	for _, e := range []HostFileSystemVolumeFileSystemType{
+		HostFileSystemVolumeFileSystemTypeVMFS,
+		HostFileSystemVolumeFileSystemTypeNFS,
+		HostFileSystemVolumeFileSystemTypeNFS41,
+		HostFileSystemVolumeFileSystemTypeCIFS,
+		HostFileSystemVolumeFileSystemTypeVsan,
+		HostFileSystemVolumeFileSystemTypeVFFS,
+		HostFileSystemVolumeFileSystemTypeVVOL,
+		HostFileSystemVolumeFileSystemTypePMEM,
+		HostFileSystemVolumeFileSystemTypeVsanD,
+		HostFileSystemVolumeFileSystemTypeOTHER,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostFileSystemVolumeFileSystemType: %q", s)
+}
+
 func init() {
 	t["HostFileSystemVolumeFileSystemType"] = reflect.TypeOf((*HostFileSystemVolumeFileSystemType)(nil)).Elem()
 }
@@ -1483,6 +3505,22 @@ const (
 	HostFirewallRuleDirectionOutbound = HostFirewallRuleDirection("outbound")
 )
 
+func (e HostFirewallRuleDirection) String() string {
+	return string(e)
+}
+
+func ParseHostFirewallRuleDirection(s string) (HostFirewallRuleDirection, error) {
+	for _, e := range []HostFirewallRuleDirection{
+		HostFirewallRuleDirectionInbound,
+		HostFirewallRuleDirectionOutbound,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostFirewallRuleDirection: %q", s)
+}
+
 func init() {
 	t["HostFirewallRuleDirection"] = reflect.TypeOf((*HostFirewallRuleDirection)(nil)).Elem()
 }
@@ -1494,6 +3532,22 @@ const (
 	HostFirewallRulePortTypeDst = HostFirewallRulePortType("dst")
 )
 
+func (e HostFirewallRulePortType) String() string {
+	return string(e)
+}
+
+func ParseHostFirewallRulePortType(s string) (HostFirewallRulePortType, error) {
+	for _, e := range []HostFirewallRulePortType{
+		HostFirewallRulePortTypeSrc,
+		HostFirewallRulePortTypeDst,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostFirewallRulePortType: %q", s)
+}
+
 func init() {
 	t["HostFirewallRulePortType"] = reflect.TypeOf((*HostFirewallRulePortType)(nil)).Elem()
 }
@@ -1505,6 +3559,22 @@ const (
 	HostFirewallRuleProtocolUdp = HostFirewallRuleProtocol("udp")
 )
 
+func (e HostFirewallRuleProtocol) String() string {
+	return string(e)
+}
+
+func ParseHostFirewallRuleProtocol(s string) (HostFirewallRuleProtocol, error) {
+	for _, e := range []HostFirewallRuleProtocol{
+		HostFirewallRuleProtocolTcp,
+		HostFirewallRuleProtocolUdp,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostFirewallRuleProtocol: %q", s)
+}
+
 func init() {
 	t["HostFirewallRuleProtocol"] = reflect.TypeOf((*HostFirewallRuleProtocol)(nil)).Elem()
 }
@@ -1516,6 +3586,22 @@ const (
 	HostGraphicsConfigGraphicsTypeSharedDirect = HostGraphicsConfigGraphicsType("sharedDirect")
 )
 
+func (e HostGraphicsConfigGraphicsType) String() string {
+	return string(e)
+}
+
+func ParseHostGraphicsConfigGraphicsType(s string) (HostGraphicsConfigGraphicsType, error) {
+	for _, e := range []HostGraphicsConfigGraphicsType{
+		HostGraphicsConfigGraphicsTypeShared,
+		HostGraphicsConfigGraphicsTypeSharedDirect,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostGraphicsConfigGraphicsType: %q", s)
+}
+
 func init() {
 	t["HostGraphicsConfigGraphicsType"] = reflect.TypeOf((*HostGraphicsConfigGraphicsType)(nil)).Elem()
 }
@@ -1527,6 +3613,22 @@ const (
 	HostGraphicsConfigSharedPassthruAssignmentPolicyConsolidation = HostGraphicsConfigSharedPassthruAssignmentPolicy("consolidation")
 )
 
+func (e HostGraphicsConfigSharedPassthruAssignmentPolicy) String() string {
+	return string(e)
+}
+
+func ParseHostGraphicsConfigSharedPassthruAssignmentPolicy(s string) (HostGraphicsConfigSharedPassthruAssignmentPolicy, error) {
+	for _, e := range []HostGraphicsConfigSharedPassthruAssignmentPolicy{
+		HostGraphicsConfigSharedPassthruAssignmentPolicyPerformance,
+		HostGraphicsConfigSharedPassthruAssignmentPolicyConsolidation,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostGraphicsConfigSharedPassthruAssignmentPolicy: %q", s)
+}
+
 func init() {
 	t["HostGraphicsConfigSharedPassthruAssignmentPolicy"] = reflect.TypeOf((*HostGraphicsConfigSharedPassthruAssignmentPolicy)(nil)).Elem()
 }
@@ -1540,6 +3642,24 @@ const (
 	HostGraphicsInfoGraphicsTypeSharedDirect = HostGraphicsInfoGraphicsType("sharedDirect")
 )
 
+func (e HostGraphicsInfoGraphicsType) String() string {
+	return string(e)
+}
+
+func ParseHostGraphicsInfoGraphicsType(s string) (HostGraphicsInfoGraphicsType, error) {
+	for _, e := range []HostGraphicsInfoGraphicsType{
+		HostGraphicsInfoGraphicsTypeBasic,
+		HostGraphicsInfoGraphicsTypeShared,
+		HostGraphicsInfoGraphicsTypeDirect,
+		HostGraphicsInfoGraphicsTypeSharedDirect,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostGraphicsInfoGraphicsType: %q", s)
+}
+
 func init() {
 	t["HostGraphicsInfoGraphicsType"] = reflect.TypeOf((*HostGraphicsInfoGraphicsType)(nil)).Elem()
 }
@@ -1553,6 +3673,24 @@ const (
 	HostHardwareElementStatusRed     = HostHardwareElementStatus("Red")
 )
 
+func (e HostHardwareElementStatus) String() string {
+	return string(e)
+}
+
+func ParseHostHardwareElementStatus(s string) (HostHardwareElementStatus, error) {
+	for _, e := range []HostHardwareElementStatus{
+		HostHardwareElementStatusUnknown,
+		HostHardwareElementStatusGreen,
+		HostHardwareElementStatusYellow,
+		HostHardwareElementStatusRed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostHardwareElementStatus: %q", s)
+}
+
 func init() {
 	t["HostHardwareElementStatus"] = reflect.TypeOf((*HostHardwareElementStatus)(nil)).Elem()
 }
@@ -1563,6 +3701,21 @@ const (
 	HostHasComponentFailureHostComponentTypeDatastore = HostHasComponentFailureHostComponentType("Datastore")
 )
 
+func (e HostHasComponentFailureHostComponentType) String() string {
+	return string(e)
+}
+
+func ParseHostHasComponentFailureHostComponentType(s string) (HostHasComponentFailureHostComponentType, error) {
+	for _, e := range []HostHasComponentFailureHostComponentType{
+		HostHasComponentFailureHostComponentTypeDatastore,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostHasComponentFailureHostComponentType: %q", s)
+}
+
 func init() {
 	t["HostHasComponentFailureHostComponentType"] = reflect.TypeOf((*HostHasComponentFailureHostComponentType)(nil)).Elem()
 }
@@ -1576,6 +3729,24 @@ const (
 	HostImageAcceptanceLevelCommunity        = HostImageAcceptanceLevel("community")
 )
 
+func (e HostImageAcceptanceLevel) String() string {
+	return string(e)
+}
+
+func ParseHostImageAcceptanceLevel(s string) (HostImageAcceptanceLevel, error) {
+	for _, e := range []HostImageAcceptanceLevel{
+		HostImageAcceptanceLevelVmware_certified,
+		HostImageAcceptanceLevelVmware_accepted,
+		HostImageAcceptanceLevelPartner,
+		HostImageAcceptanceLevelCommunity,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostImageAcceptanceLevel: %q", s)
+}
+
 func init() {
 	t["HostImageAcceptanceLevel"] = reflect.TypeOf((*HostImageAcceptanceLevel)(nil)).Elem()
 }
@@ -1587,6 +3758,22 @@ const (
 	HostIncompatibleForFaultToleranceReasonProcessor = HostIncompatibleForFaultToleranceReason("processor")
 )
 
+func (e HostIncompatibleForFaultToleranceReason) String() string {
+	return string(e)
+}
+
+func ParseHostIncompatibleForFaultToleranceReason(s string) (HostIncompatibleForFaultToleranceReason, error) {
+	for _, e := range []HostIncompatibleForFaultToleranceReason{
+		HostIncompatibleForFaultToleranceReasonProduct,
+		HostIncompatibleForFaultToleranceReasonProcessor,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostIncompatibleForFaultToleranceReason: %q", s)
+}
+
 func init() {
 	t["HostIncompatibleForFaultToleranceReason"] = reflect.TypeOf((*HostIncompatibleForFaultToleranceReason)(nil)).Elem()
 }
@@ -1598,6 +3785,22 @@ const (
 	HostIncompatibleForRecordReplayReasonProcessor = HostIncompatibleForRecordReplayReason("processor")
 )
 
+func (e HostIncompatibleForRecordReplayReason) String() string {
+	return string(e)
+}
+
+func ParseHostIncompatibleForRecordReplayReason(s string) (HostIncompatibleForRecordReplayReason, error) {
+	for _, e := range []HostIncompatibleForRecordReplayReason{
+		HostIncompatibleForRecordReplayReasonProduct,
+		HostIncompatibleForRecordReplayReasonProcessor,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostIncompatibleForRecordReplayReason: %q", s)
+}
+
 func init() {
 	t["HostIncompatibleForRecordReplayReason"] = reflect.TypeOf((*HostIncompatibleForRecordReplayReason)(nil)).Elem()
 }
@@ -1611,6 +3814,24 @@ const (
 	HostInternetScsiHbaChapAuthenticationTypeChapRequired    = HostInternetScsiHbaChapAuthenticationType("chapRequired")
 )
 
+func (e HostInternetScsiHbaChapAuthenticationType) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaChapAuthenticationType(s string) (HostInternetScsiHbaChapAuthenticationType, error) {
+	for _, e := range []HostInternetScsiHbaChapAuthenticationType{
+		HostInternetScsiHbaChapAuthenticationTypeChapProhibited,
+		HostInternetScsiHbaChapAuthenticationTypeChapDiscouraged,
+		HostInternetScsiHbaChapAuthenticationTypeChapPreferred,
+		HostInternetScsiHbaChapAuthenticationTypeChapRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaChapAuthenticationType: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaChapAuthenticationType"] = reflect.TypeOf((*HostInternetScsiHbaChapAuthenticationType)(nil)).Elem()
 }
@@ -1624,6 +3845,24 @@ const (
 	HostInternetScsiHbaDigestTypeDigestRequired    = HostInternetScsiHbaDigestType("digestRequired")
 )
 
+func (e HostInternetScsiHbaDigestType) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaDigestType(s string) (HostInternetScsiHbaDigestType, error) {
+	for _, e := range []HostInternetScsiHbaDigestType{
+		HostInternetScsiHbaDigestTypeDigestProhibited,
+		HostInternetScsiHbaDigestTypeDigestDiscouraged,
+		HostInternetScsiHbaDigestTypeDigestPreferred,
+		HostInternetScsiHbaDigestTypeDigestRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaDigestType: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaDigestType"] = reflect.TypeOf((*HostInternetScsiHbaDigestType)(nil)).Elem()
 }
@@ -1637,6 +3876,24 @@ const (
 	HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeOther          = HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType("Other")
 )
 
+func (e HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType(s string) (HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType, error) {
+	for _, e := range []HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType{
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeDHCP,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeAutoConfigured,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeStatic,
+		HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationTypeOther,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType"] = reflect.TypeOf((*HostInternetScsiHbaIscsiIpv6AddressAddressConfigurationType)(nil)).Elem()
 }
@@ -1648,6 +3905,22 @@ const (
 	HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationRemove = HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation("remove")
 )
 
+func (e HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation(s string) (HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation, error) {
+	for _, e := range []HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation{
+		HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationAdd,
+		HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperationRemove,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation"] = reflect.TypeOf((*HostInternetScsiHbaIscsiIpv6AddressIPv6AddressOperation)(nil)).Elem()
 }
@@ -1660,6 +3933,23 @@ const (
 	HostInternetScsiHbaNetworkBindingSupportTypeRequired     = HostInternetScsiHbaNetworkBindingSupportType("required")
 )
 
+func (e HostInternetScsiHbaNetworkBindingSupportType) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaNetworkBindingSupportType(s string) (HostInternetScsiHbaNetworkBindingSupportType, error) {
+	for _, e := range []HostInternetScsiHbaNetworkBindingSupportType{
+		HostInternetScsiHbaNetworkBindingSupportTypeNotsupported,
+		HostInternetScsiHbaNetworkBindingSupportTypeOptional,
+		HostInternetScsiHbaNetworkBindingSupportTypeRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaNetworkBindingSupportType: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaNetworkBindingSupportType"] = reflect.TypeOf((*HostInternetScsiHbaNetworkBindingSupportType)(nil)).Elem()
 }
@@ -1674,6 +3964,25 @@ const (
 	HostInternetScsiHbaStaticTargetTargetDiscoveryMethodUnknownMethod    = HostInternetScsiHbaStaticTargetTargetDiscoveryMethod("unknownMethod")
 )
 
+func (e HostInternetScsiHbaStaticTargetTargetDiscoveryMethod) String() string {
+	return string(e)
+}
+
+func ParseHostInternetScsiHbaStaticTargetTargetDiscoveryMethod(s string) (HostInternetScsiHbaStaticTargetTargetDiscoveryMethod, error) {
+	for _, e := range []HostInternetScsiHbaStaticTargetTargetDiscoveryMethod{
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodStaticMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodSendTargetMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodSlpMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodIsnsMethod,
+		HostInternetScsiHbaStaticTargetTargetDiscoveryMethodUnknownMethod,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostInternetScsiHbaStaticTargetTargetDiscoveryMethod: %q", s)
+}
+
 func init() {
 	t["HostInternetScsiHbaStaticTargetTargetDiscoveryMethod"] = reflect.TypeOf((*HostInternetScsiHbaStaticTargetTargetDiscoveryMethod)(nil)).Elem()
 }
@@ -1688,6 +3997,25 @@ const (
 	HostIpConfigIpV6AddressConfigTypeRandom    = HostIpConfigIpV6AddressConfigType("random")
 )
 
+func (e HostIpConfigIpV6AddressConfigType) String() string {
+	return string(e)
+}
+
+func ParseHostIpConfigIpV6AddressConfigType(s string) (HostIpConfigIpV6AddressConfigType, error) {
+	for _, e := range []HostIpConfigIpV6AddressConfigType{
+		HostIpConfigIpV6AddressConfigTypeOther,
+		HostIpConfigIpV6AddressConfigTypeManual,
+		HostIpConfigIpV6AddressConfigTypeDhcp,
+		HostIpConfigIpV6AddressConfigTypeLinklayer,
+		HostIpConfigIpV6AddressConfigTypeRandom,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostIpConfigIpV6AddressConfigType: %q", s)
+}
+
 func init() {
 	t["HostIpConfigIpV6AddressConfigType"] = reflect.TypeOf((*HostIpConfigIpV6AddressConfigType)(nil)).Elem()
 }
@@ -1704,6 +4032,27 @@ const (
 	HostIpConfigIpV6AddressStatusDuplicate    = HostIpConfigIpV6AddressStatus("duplicate")
 )
 
+func (e HostIpConfigIpV6AddressStatus) String() string {
+	return string(e)
+}
+
+func ParseHostIpConfigIpV6AddressStatus(s string) (HostIpConfigIpV6AddressStatus, error) {
+	for _, e := range []HostIpConfigIpV6AddressStatus{
+		HostIpConfigIpV6AddressStatusPreferred,
+		HostIpConfigIpV6AddressStatusDeprecated,
+		HostIpConfigIpV6AddressStatusInvalid,
+		HostIpConfigIpV6AddressStatusInaccessible,
+		HostIpConfigIpV6AddressStatusUnknown,
+		HostIpConfigIpV6AddressStatusTentative,
+		HostIpConfigIpV6AddressStatusDuplicate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostIpConfigIpV6AddressStatus: %q", s)
+}
+
 func init() {
 	t["HostIpConfigIpV6AddressStatus"] = reflect.TypeOf((*HostIpConfigIpV6AddressStatus)(nil)).Elem()
 }
@@ -1719,6 +4068,26 @@ const (
 	HostLicensableResourceKeyNumVmsStarting = HostLicensableResourceKey("numVmsStarting")
 )
 
+func (e HostLicensableResourceKey) String() string {
+	return string(e)
+}
+
+func ParseHostLicensableResourceKey(s string) (HostLicensableResourceKey, error) {
+	for _, e := range []HostLicensableResourceKey{
+		HostLicensableResourceKeyNumCpuPackages,
+		HostLicensableResourceKeyNumCpuCores,
+		HostLicensableResourceKeyMemorySize,
+		HostLicensableResourceKeyMemoryForVms,
+		HostLicensableResourceKeyNumVmsStarted,
+		HostLicensableResourceKeyNumVmsStarting,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostLicensableResourceKey: %q", s)
+}
+
 func init() {
 	t["HostLicensableResourceKey"] = reflect.TypeOf((*HostLicensableResourceKey)(nil)).Elem()
 }
@@ -1731,6 +4100,23 @@ const (
 	HostLockdownModeLockdownStrict   = HostLockdownMode("lockdownStrict")
 )
 
+func (e HostLockdownMode) String() string {
+	return string(e)
+}
+
+func ParseHostLockdownMode(s string) (HostLockdownMode, error) {
+	for _, e := range []HostLockdownMode{
+		HostLockdownModeLockdownDisabled,
+		HostLockdownModeLockdownNormal,
+		HostLockdownModeLockdownStrict,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostLockdownMode: %q", s)
+}
+
 func init() {
 	t["HostLockdownMode"] = reflect.TypeOf((*HostLockdownMode)(nil)).Elem()
 }
@@ -1743,6 +4129,23 @@ const (
 	HostLowLevelProvisioningManagerFileTypeDirectory   = HostLowLevelProvisioningManagerFileType("Directory")
 )
 
+func (e HostLowLevelProvisioningManagerFileType) String() string {
+	return string(e)
+}
+
+func ParseHostLowLevelProvisioningManagerFileType(s string) (HostLowLevelProvisioningManagerFileType, error) {
+	for _, e := range []HostLowLevelProvisioningManagerFileType{
+		HostLowLevelProvisioningManagerFileTypeFile,
+		HostLowLevelProvisioningManagerFileTypeVirtualDisk,
+		HostLowLevelProvisioningManagerFileTypeDirectory,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostLowLevelProvisioningManagerFileType: %q", s)
+}
+
 func init() {
 	t["HostLowLevelProvisioningManagerFileType"] = reflect.TypeOf((*HostLowLevelProvisioningManagerFileType)(nil)).Elem()
 }
@@ -1754,6 +4157,22 @@ const (
 	HostLowLevelProvisioningManagerReloadTargetSnapshotConfig = HostLowLevelProvisioningManagerReloadTarget("snapshotConfig")
 )
 
+func (e HostLowLevelProvisioningManagerReloadTarget) String() string {
+	return string(e)
+}
+
+func ParseHostLowLevelProvisioningManagerReloadTarget(s string) (HostLowLevelProvisioningManagerReloadTarget, error) {
+	for _, e := range []HostLowLevelProvisioningManagerReloadTarget{
+		HostLowLevelProvisioningManagerReloadTargetCurrentConfig,
+		HostLowLevelProvisioningManagerReloadTargetSnapshotConfig,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostLowLevelProvisioningManagerReloadTarget: %q", s)
+}
+
 func init() {
 	t["HostLowLevelProvisioningManagerReloadTarget"] = reflect.TypeOf((*HostLowLevelProvisioningManagerReloadTarget)(nil)).Elem()
 }
@@ -1764,6 +4183,21 @@ const (
 	HostMaintenanceSpecPurposeHostUpgrade = HostMaintenanceSpecPurpose("hostUpgrade")
 )
 
+func (e HostMaintenanceSpecPurpose) String() string {
+	return string(e)
+}
+
+func ParseHostMaintenanceSpecPurpose(s string) (HostMaintenanceSpecPurpose, error) {
+	for _, e := range []HostMaintenanceSpecPurpose{
+		HostMaintenanceSpecPurposeHostUpgrade,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostMaintenanceSpecPurpose: %q", s)
+}
+
 func init() {
 	t["HostMaintenanceSpecPurpose"] = reflect.TypeOf((*HostMaintenanceSpecPurpose)(nil)).Elem()
 }
@@ -1776,6 +4210,23 @@ const (
 	HostMountInfoInaccessibleReasonPermanentDeviceLoss  = HostMountInfoInaccessibleReason("PermanentDeviceLoss")
 )
 
+func (e HostMountInfoInaccessibleReason) String() string {
+	return string(e)
+}
+
+func ParseHostMountInfoInaccessibleReason(s string) (HostMountInfoInaccessibleReason, error) {
+	for _, e := range []HostMountInfoInaccessibleReason{
+		HostMountInfoInaccessibleReasonAllPathsDown_Start,
+		HostMountInfoInaccessibleReasonAllPathsDown_Timeout,
+		HostMountInfoInaccessibleReasonPermanentDeviceLoss,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostMountInfoInaccessibleReason: %q", s)
+}
+
 func init() {
 	t["HostMountInfoInaccessibleReason"] = reflect.TypeOf((*HostMountInfoInaccessibleReason)(nil)).Elem()
 }
@@ -1787,6 +4238,22 @@ const (
 	HostMountModeReadOnly  = HostMountMode("readOnly")
 )
 
+func (e HostMountMode) String() string {
+	return string(e)
+}
+
+func ParseHostMountMode(s string) (HostMountMode, error) {
+	for _, e := range []HostMountMode{
+		HostMountModeReadWrite,
+		HostMountModeReadOnly,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostMountMode: %q", s)
+}
+
 func init() {
 	t["HostMountMode"] = reflect.TypeOf((*HostMountMode)(nil)).Elem()
 }
@@ -1799,6 +4266,23 @@ const (
 	HostNasVolumeSecurityTypeSEC_KRB5I = HostNasVolumeSecurityType("SEC_KRB5I")
 )
 
+func (e HostNasVolumeSecurityType) String() string {
+	return string(e)
+}
+
+func ParseHostNasVolumeSecurityType(s string) (HostNasVolumeSecurityType, error) {
+	for _, e := range []HostNasVolumeSecurityType{
+		HostNasVolumeSecurityTypeAUTH_SYS,
+		HostNasVolumeSecurityTypeSEC_KRB5,
+		HostNasVolumeSecurityTypeSEC_KRB5I,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNasVolumeSecurityType: %q", s)
+}
+
 func init() {
 	t["HostNasVolumeSecurityType"] = reflect.TypeOf((*HostNasVolumeSecurityType)(nil)).Elem()
 }
@@ -1810,6 +4294,22 @@ const (
 	HostNetStackInstanceCongestionControlAlgorithmTypeCubic   = HostNetStackInstanceCongestionControlAlgorithmType("cubic")
 )
 
+func (e HostNetStackInstanceCongestionControlAlgorithmType) String() string {
+	return string(e)
+}
+
+func ParseHostNetStackInstanceCongestionControlAlgorithmType(s string) (HostNetStackInstanceCongestionControlAlgorithmType, error) {
+	for _, e := range []HostNetStackInstanceCongestionControlAlgorithmType{
+		HostNetStackInstanceCongestionControlAlgorithmTypeNewreno,
+		HostNetStackInstanceCongestionControlAlgorithmTypeCubic,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNetStackInstanceCongestionControlAlgorithmType: %q", s)
+}
+
 func init() {
 	t["HostNetStackInstanceCongestionControlAlgorithmType"] = reflect.TypeOf((*HostNetStackInstanceCongestionControlAlgorithmType)(nil)).Elem()
 }
@@ -1822,6 +4322,23 @@ const (
 	HostNetStackInstanceSystemStackKeyVSphereProvisioning = HostNetStackInstanceSystemStackKey("vSphereProvisioning")
 )
 
+func (e HostNetStackInstanceSystemStackKey) String() string {
+	return string(e)
+}
+
+func ParseHostNetStackInstanceSystemStackKey(s string) (HostNetStackInstanceSystemStackKey, error) {
+	for _, e := range []HostNetStackInstanceSystemStackKey{
+		HostNetStackInstanceSystemStackKeyDefaultTcpipStack,
+		HostNetStackInstanceSystemStackKeyVmotion,
+		HostNetStackInstanceSystemStackKeyVSphereProvisioning,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNetStackInstanceSystemStackKey: %q", s)
+}
+
 func init() {
 	t["HostNetStackInstanceSystemStackKey"] = reflect.TypeOf((*HostNetStackInstanceSystemStackKey)(nil)).Elem()
 }
@@ -1835,6 +4352,24 @@ const (
 	HostNumericSensorHealthStateRed     = HostNumericSensorHealthState("red")
 )
 
+func (e HostNumericSensorHealthState) String() string {
+	return string(e)
+}
+
+func ParseHostNumericSensorHealthState(s string) (HostNumericSensorHealthState, error) {
+	for _, e := range []HostNumericSensorHealthState{
+		HostNumericSensorHealthStateUnknown,
+		HostNumericSensorHealthStateGreen,
+		HostNumericSensorHealthStateYellow,
+		HostNumericSensorHealthStateRed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNumericSensorHealthState: %q", s)
+}
+
 func init() {
 	t["HostNumericSensorHealthState"] = reflect.TypeOf((*HostNumericSensorHealthState)(nil)).Elem()
 }
@@ -1857,6 +4392,33 @@ const (
 	HostNumericSensorTypeWatchdog    = HostNumericSensorType("watchdog")
 )
 
+func (e HostNumericSensorType) String() string {
+	return string(e)
+}
+
+func ParseHostNumericSensorType(s string) (HostNumericSensorType, error) {
+	for _, e := range []HostNumericSensorType{
+		HostNumericSensorTypeFan,
+		HostNumericSensorTypePower,
+		HostNumericSensorTypeTemperature,
+		HostNumericSensorTypeVoltage,
+		HostNumericSensorTypeOther,
+		HostNumericSensorTypeProcessor,
+		HostNumericSensorTypeMemory,
+		HostNumericSensorTypeStorage,
+		HostNumericSensorTypeSystemBoard,
+		HostNumericSensorTypeBattery,
+		HostNumericSensorTypeBios,
+		HostNumericSensorTypeCable,
+		HostNumericSensorTypeWatchdog,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNumericSensorType: %q", s)
+}
+
 func init() {
 	t["HostNumericSensorType"] = reflect.TypeOf((*HostNumericSensorType)(nil)).Elem()
 }
@@ -1868,6 +4430,22 @@ const (
 	HostNvmeDiscoveryLogSubsystemTypeNvm       = HostNvmeDiscoveryLogSubsystemType("nvm")
 )
 
+func (e HostNvmeDiscoveryLogSubsystemType) String() string {
+	return string(e)
+}
+
+func ParseHostNvmeDiscoveryLogSubsystemType(s string) (HostNvmeDiscoveryLogSubsystemType, error) {
+	for _, e := range []HostNvmeDiscoveryLogSubsystemType{
+		HostNvmeDiscoveryLogSubsystemTypeDiscovery,
+		HostNvmeDiscoveryLogSubsystemTypeNvm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNvmeDiscoveryLogSubsystemType: %q", s)
+}
+
 func init() {
 	t["HostNvmeDiscoveryLogSubsystemType"] = reflect.TypeOf((*HostNvmeDiscoveryLogSubsystemType)(nil)).Elem()
 }
@@ -1880,6 +4458,23 @@ const (
 	HostNvmeDiscoveryLogTransportRequirementsRequirementsNotSpecified = HostNvmeDiscoveryLogTransportRequirements("requirementsNotSpecified")
 )
 
+func (e HostNvmeDiscoveryLogTransportRequirements) String() string {
+	return string(e)
+}
+
+func ParseHostNvmeDiscoveryLogTransportRequirements(s string) (HostNvmeDiscoveryLogTransportRequirements, error) {
+	for _, e := range []HostNvmeDiscoveryLogTransportRequirements{
+		HostNvmeDiscoveryLogTransportRequirementsSecureChannelRequired,
+		HostNvmeDiscoveryLogTransportRequirementsSecureChannelNotRequired,
+		HostNvmeDiscoveryLogTransportRequirementsRequirementsNotSpecified,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNvmeDiscoveryLogTransportRequirements: %q", s)
+}
+
 func init() {
 	t["HostNvmeDiscoveryLogTransportRequirements"] = reflect.TypeOf((*HostNvmeDiscoveryLogTransportRequirements)(nil)).Elem()
 }
@@ -1895,6 +4490,26 @@ const (
 	HostNvmeTransportParametersNvmeAddressFamilyUnknown    = HostNvmeTransportParametersNvmeAddressFamily("unknown")
 )
 
+func (e HostNvmeTransportParametersNvmeAddressFamily) String() string {
+	return string(e)
+}
+
+func ParseHostNvmeTransportParametersNvmeAddressFamily(s string) (HostNvmeTransportParametersNvmeAddressFamily, error) {
+	for _, e := range []HostNvmeTransportParametersNvmeAddressFamily{
+		HostNvmeTransportParametersNvmeAddressFamilyIpv4,
+		HostNvmeTransportParametersNvmeAddressFamilyIpv6,
+		HostNvmeTransportParametersNvmeAddressFamilyInfiniBand,
+		HostNvmeTransportParametersNvmeAddressFamilyFc,
+		HostNvmeTransportParametersNvmeAddressFamilyLoopback,
+		HostNvmeTransportParametersNvmeAddressFamilyUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNvmeTransportParametersNvmeAddressFamily: %q", s)
+}
+
 func init() {
 	t["HostNvmeTransportParametersNvmeAddressFamily"] = reflect.TypeOf((*HostNvmeTransportParametersNvmeAddressFamily)(nil)).Elem()
 }
@@ -1909,6 +4524,25 @@ const (
 	HostNvmeTransportTypeUnsupported  = HostNvmeTransportType("unsupported")
 )
 
+func (e HostNvmeTransportType) String() string {
+	return string(e)
+}
+
+func ParseHostNvmeTransportType(s string) (HostNvmeTransportType, error) {
+	for _, e := range []HostNvmeTransportType{
+		HostNvmeTransportTypePcie,
+		HostNvmeTransportTypeFibreChannel,
+		HostNvmeTransportTypeRdma,
+		HostNvmeTransportTypeLoopback,
+		HostNvmeTransportTypeUnsupported,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostNvmeTransportType: %q", s)
+}
+
 func init() {
 	t["HostNvmeTransportType"] = reflect.TypeOf((*HostNvmeTransportType)(nil)).Elem()
 }
@@ -1922,6 +4556,24 @@ const (
 	HostOpaqueSwitchOpaqueSwitchStateMaintenance = HostOpaqueSwitchOpaqueSwitchState("maintenance")
 )
 
+func (e HostOpaqueSwitchOpaqueSwitchState) String() string {
+	return string(e)
+}
+
+func ParseHostOpaqueSwitchOpaqueSwitchState(s string) (HostOpaqueSwitchOpaqueSwitchState, error) {
+	for _, e := range []HostOpaqueSwitchOpaqueSwitchState{
+		HostOpaqueSwitchOpaqueSwitchStateUp,
+		HostOpaqueSwitchOpaqueSwitchStateWarning,
+		HostOpaqueSwitchOpaqueSwitchStateDown,
+		HostOpaqueSwitchOpaqueSwitchStateMaintenance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostOpaqueSwitchOpaqueSwitchState: %q", s)
+}
+
 func init() {
 	t["HostOpaqueSwitchOpaqueSwitchState"] = reflect.TypeOf((*HostOpaqueSwitchOpaqueSwitchState)(nil)).Elem()
 }
@@ -1933,6 +4585,22 @@ const (
 	HostPatchManagerInstallStateImageActive   = HostPatchManagerInstallState("imageActive")
 )
 
+func (e HostPatchManagerInstallState) String() string {
+	return string(e)
+}
+
+func ParseHostPatchManagerInstallState(s string) (HostPatchManagerInstallState, error) {
+	for _, e := range []HostPatchManagerInstallState{
+		HostPatchManagerInstallStateHostRestarted,
+		HostPatchManagerInstallStateImageActive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostPatchManagerInstallState: %q", s)
+}
+
 func init() {
 	t["HostPatchManagerInstallState"] = reflect.TypeOf((*HostPatchManagerInstallState)(nil)).Elem()
 }
@@ -1949,6 +4617,27 @@ const (
 	HostPatchManagerIntegrityStatusValidationError     = HostPatchManagerIntegrityStatus("validationError")
 )
 
+func (e HostPatchManagerIntegrityStatus) String() string {
+	return string(e)
+}
+
+func ParseHostPatchManagerIntegrityStatus(s string) (HostPatchManagerIntegrityStatus, error) {
+	for _, e := range []HostPatchManagerIntegrityStatus{
+		HostPatchManagerIntegrityStatusValidated,
+		HostPatchManagerIntegrityStatusKeyNotFound,
+		HostPatchManagerIntegrityStatusKeyRevoked,
+		HostPatchManagerIntegrityStatusKeyExpired,
+		HostPatchManagerIntegrityStatusDigestMismatch,
+		HostPatchManagerIntegrityStatusNotEnoughSignatures,
+		HostPatchManagerIntegrityStatusValidationError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostPatchManagerIntegrityStatus: %q", s)
+}
+
 func init() {
 	t["HostPatchManagerIntegrityStatus"] = reflect.TypeOf((*HostPatchManagerIntegrityStatus)(nil)).Elem()
 }
@@ -1964,6 +4653,26 @@ const (
 	HostPatchManagerReasonConflictLib       = HostPatchManagerReason("conflictLib")
 )
 
+func (e HostPatchManagerReason) String() string {
+	return string(e)
+}
+
+func ParseHostPatchManagerReason(s string) (HostPatchManagerReason, error) {
+	for _, e := range []HostPatchManagerReason{
+		HostPatchManagerReasonObsoleted,
+		HostPatchManagerReasonMissingPatch,
+		HostPatchManagerReasonMissingLib,
+		HostPatchManagerReasonHasDependentPatch,
+		HostPatchManagerReasonConflictPatch,
+		HostPatchManagerReasonConflictLib,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostPatchManagerReason: %q", s)
+}
+
 func init() {
 	t["HostPatchManagerReason"] = reflect.TypeOf((*HostPatchManagerReason)(nil)).Elem()
 }
@@ -1975,6 +4684,22 @@ const (
 	HostPowerOperationTypePowerOff = HostPowerOperationType("powerOff")
 )
 
+func (e HostPowerOperationType) String() string {
+	return string(e)
+}
+
+func ParseHostPowerOperationType(s string) (HostPowerOperationType, error) {
+	for _, e := range []HostPowerOperationType{
+		HostPowerOperationTypePowerOn,
+		HostPowerOperationTypePowerOff,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostPowerOperationType: %q", s)
+}
+
 func init() {
 	t["HostPowerOperationType"] = reflect.TypeOf((*HostPowerOperationType)(nil)).Elem()
 }
@@ -1987,6 +4712,23 @@ const (
 	HostProfileManagerAnswerFileStatusUnknown = HostProfileManagerAnswerFileStatus("unknown")
 )
 
+func (e HostProfileManagerAnswerFileStatus) String() string {
+	return string(e)
+}
+
+func ParseHostProfileManagerAnswerFileStatus(s string) (HostProfileManagerAnswerFileStatus, error) {
+	for _, e := range []HostProfileManagerAnswerFileStatus{
+		HostProfileManagerAnswerFileStatusValid,
+		HostProfileManagerAnswerFileStatusInvalid,
+		HostProfileManagerAnswerFileStatusUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileManagerAnswerFileStatus: %q", s)
+}
+
 func init() {
 	t["HostProfileManagerAnswerFileStatus"] = reflect.TypeOf((*HostProfileManagerAnswerFileStatus)(nil)).Elem()
 }
@@ -1998,6 +4740,22 @@ const (
 	HostProfileManagerCompositionResultResultElementStatusError   = HostProfileManagerCompositionResultResultElementStatus("error")
 )
 
+func (e HostProfileManagerCompositionResultResultElementStatus) String() string {
+	return string(e)
+}
+
+func ParseHostProfileManagerCompositionResultResultElementStatus(s string) (HostProfileManagerCompositionResultResultElementStatus, error) {
+	for _, e := range []HostProfileManagerCompositionResultResultElementStatus{
+		HostProfileManagerCompositionResultResultElementStatusSuccess,
+		HostProfileManagerCompositionResultResultElementStatusError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileManagerCompositionResultResultElementStatus: %q", s)
+}
+
 func init() {
 	t["HostProfileManagerCompositionResultResultElementStatus"] = reflect.TypeOf((*HostProfileManagerCompositionResultResultElementStatus)(nil)).Elem()
 }
@@ -2009,6 +4767,22 @@ const (
 	HostProfileManagerCompositionValidationResultResultElementStatusError   = HostProfileManagerCompositionValidationResultResultElementStatus("error")
 )
 
+func (e HostProfileManagerCompositionValidationResultResultElementStatus) String() string {
+	return string(e)
+}
+
+func ParseHostProfileManagerCompositionValidationResultResultElementStatus(s string) (HostProfileManagerCompositionValidationResultResultElementStatus, error) {
+	for _, e := range []HostProfileManagerCompositionValidationResultResultElementStatus{
+		HostProfileManagerCompositionValidationResultResultElementStatusSuccess,
+		HostProfileManagerCompositionValidationResultResultElementStatusError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileManagerCompositionValidationResultResultElementStatus: %q", s)
+}
+
 func init() {
 	t["HostProfileManagerCompositionValidationResultResultElementStatus"] = reflect.TypeOf((*HostProfileManagerCompositionValidationResultResultElementStatus)(nil)).Elem()
 }
@@ -2020,6 +4794,22 @@ const (
 	HostProfileManagerTaskListRequirementRebootRequired          = HostProfileManagerTaskListRequirement("rebootRequired")
 )
 
+func (e HostProfileManagerTaskListRequirement) String() string {
+	return string(e)
+}
+
+func ParseHostProfileManagerTaskListRequirement(s string) (HostProfileManagerTaskListRequirement, error) {
+	for _, e := range []HostProfileManagerTaskListRequirement{
+		HostProfileManagerTaskListRequirementMaintenanceModeRequired,
+		HostProfileManagerTaskListRequirementRebootRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileManagerTaskListRequirement: %q", s)
+}
+
 func init() {
 	t["HostProfileManagerTaskListRequirement"] = reflect.TypeOf((*HostProfileManagerTaskListRequirement)(nil)).Elem()
 }
@@ -2033,6 +4823,24 @@ const (
 	HostProfileValidationFailureInfoUpdateTypeCompose   = HostProfileValidationFailureInfoUpdateType("Compose")
 )
 
+func (e HostProfileValidationFailureInfoUpdateType) String() string {
+	return string(e)
+}
+
+func ParseHostProfileValidationFailureInfoUpdateType(s string) (HostProfileValidationFailureInfoUpdateType, error) {
+	for _, e := range []HostProfileValidationFailureInfoUpdateType{
+		HostProfileValidationFailureInfoUpdateTypeHostBased,
+		HostProfileValidationFailureInfoUpdateTypeImport,
+		HostProfileValidationFailureInfoUpdateTypeEdit,
+		HostProfileValidationFailureInfoUpdateTypeCompose,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileValidationFailureInfoUpdateType: %q", s)
+}
+
 func init() {
 	t["HostProfileValidationFailureInfoUpdateType"] = reflect.TypeOf((*HostProfileValidationFailureInfoUpdateType)(nil)).Elem()
 }
@@ -2045,6 +4853,23 @@ const (
 	HostProfileValidationStateFailed  = HostProfileValidationState("Failed")
 )
 
+func (e HostProfileValidationState) String() string {
+	return string(e)
+}
+
+func ParseHostProfileValidationState(s string) (HostProfileValidationState, error) {
+	for _, e := range []HostProfileValidationState{
+		HostProfileValidationStateReady,
+		HostProfileValidationStateRunning,
+		HostProfileValidationStateFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProfileValidationState: %q", s)
+}
+
 func init() {
 	t["HostProfileValidationState"] = reflect.TypeOf((*HostProfileValidationState)(nil)).Elem()
 }
@@ -2056,6 +4881,22 @@ const (
 	HostProtocolEndpointPETypeNas   = HostProtocolEndpointPEType("nas")
 )
 
+func (e HostProtocolEndpointPEType) String() string {
+	return string(e)
+}
+
+func ParseHostProtocolEndpointPEType(s string) (HostProtocolEndpointPEType, error) {
+	for _, e := range []HostProtocolEndpointPEType{
+		HostProtocolEndpointPETypeBlock,
+		HostProtocolEndpointPETypeNas,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProtocolEndpointPEType: %q", s)
+}
+
 func init() {
 	t["HostProtocolEndpointPEType"] = reflect.TypeOf((*HostProtocolEndpointPEType)(nil)).Elem()
 }
@@ -2068,6 +4909,23 @@ const (
 	HostProtocolEndpointProtocolEndpointTypeNfs4x = HostProtocolEndpointProtocolEndpointType("nfs4x")
 )
 
+func (e HostProtocolEndpointProtocolEndpointType) String() string {
+	return string(e)
+}
+
+func ParseHostProtocolEndpointProtocolEndpointType(s string) (HostProtocolEndpointProtocolEndpointType, error) {
+	for _, e := range []HostProtocolEndpointProtocolEndpointType{
+		HostProtocolEndpointProtocolEndpointTypeScsi,
+		HostProtocolEndpointProtocolEndpointTypeNfs,
+		HostProtocolEndpointProtocolEndpointTypeNfs4x,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostProtocolEndpointProtocolEndpointType: %q", s)
+}
+
 func init() {
 	t["HostProtocolEndpointProtocolEndpointType"] = reflect.TypeOf((*HostProtocolEndpointProtocolEndpointType)(nil)).Elem()
 }
@@ -2083,6 +4941,26 @@ const (
 	HostRdmaDeviceConnectionStateActiveDefer = HostRdmaDeviceConnectionState("activeDefer")
 )
 
+func (e HostRdmaDeviceConnectionState) String() string {
+	return string(e)
+}
+
+func ParseHostRdmaDeviceConnectionState(s string) (HostRdmaDeviceConnectionState, error) {
+	for _, e := range []HostRdmaDeviceConnectionState{
+		HostRdmaDeviceConnectionStateUnknown,
+		HostRdmaDeviceConnectionStateDown,
+		HostRdmaDeviceConnectionStateInit,
+		HostRdmaDeviceConnectionStateArmed,
+		HostRdmaDeviceConnectionStateActive,
+		HostRdmaDeviceConnectionStateActiveDefer,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostRdmaDeviceConnectionState: %q", s)
+}
+
 func init() {
 	t["HostRdmaDeviceConnectionState"] = reflect.TypeOf((*HostRdmaDeviceConnectionState)(nil)).Elem()
 }
@@ -2098,6 +4976,26 @@ const (
 	HostReplayUnsupportedReasonUnknown             = HostReplayUnsupportedReason("unknown")
 )
 
+func (e HostReplayUnsupportedReason) String() string {
+	return string(e)
+}
+
+func ParseHostReplayUnsupportedReason(s string) (HostReplayUnsupportedReason, error) {
+	for _, e := range []HostReplayUnsupportedReason{
+		HostReplayUnsupportedReasonIncompatibleProduct,
+		HostReplayUnsupportedReasonIncompatibleCpu,
+		HostReplayUnsupportedReasonHvDisabled,
+		HostReplayUnsupportedReasonCpuidLimitSet,
+		HostReplayUnsupportedReasonOldBIOS,
+		HostReplayUnsupportedReasonUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostReplayUnsupportedReason: %q", s)
+}
+
 func init() {
 	t["HostReplayUnsupportedReason"] = reflect.TypeOf((*HostReplayUnsupportedReason)(nil)).Elem()
 }
@@ -2111,6 +5009,24 @@ const (
 	HostRuntimeInfoNetStackInstanceRuntimeInfoStateActivating   = HostRuntimeInfoNetStackInstanceRuntimeInfoState("activating")
 )
 
+func (e HostRuntimeInfoNetStackInstanceRuntimeInfoState) String() string {
+	return string(e)
+}
+
+func ParseHostRuntimeInfoNetStackInstanceRuntimeInfoState(s string) (HostRuntimeInfoNetStackInstanceRuntimeInfoState, error) {
+	for _, e := range []HostRuntimeInfoNetStackInstanceRuntimeInfoState{
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateInactive,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateActive,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateDeactivating,
+		HostRuntimeInfoNetStackInstanceRuntimeInfoStateActivating,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostRuntimeInfoNetStackInstanceRuntimeInfoState: %q", s)
+}
+
 func init() {
 	t["HostRuntimeInfoNetStackInstanceRuntimeInfoState"] = reflect.TypeOf((*HostRuntimeInfoNetStackInstanceRuntimeInfoState)(nil)).Elem()
 }
@@ -2123,6 +5039,23 @@ const (
 	HostRuntimeInfoStatelessNvdsMigrationStateUnknown   = HostRuntimeInfoStatelessNvdsMigrationState("unknown")
 )
 
+func (e HostRuntimeInfoStatelessNvdsMigrationState) String() string {
+	return string(e)
+}
+
+func ParseHostRuntimeInfoStatelessNvdsMigrationState(s string) (HostRuntimeInfoStatelessNvdsMigrationState, error) {
+	for _, e := range []HostRuntimeInfoStatelessNvdsMigrationState{
+		HostRuntimeInfoStatelessNvdsMigrationStateReady,
+		HostRuntimeInfoStatelessNvdsMigrationStateNotNeeded,
+		HostRuntimeInfoStatelessNvdsMigrationStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostRuntimeInfoStatelessNvdsMigrationState: %q", s)
+}
+
 func init() {
 	t["HostRuntimeInfoStatelessNvdsMigrationState"] = reflect.TypeOf((*HostRuntimeInfoStatelessNvdsMigrationState)(nil)).Elem()
 }
@@ -2135,6 +5068,23 @@ const (
 	HostServicePolicyOff       = HostServicePolicy("off")
 )
 
+func (e HostServicePolicy) String() string {
+	return string(e)
+}
+
+func ParseHostServicePolicy(s string) (HostServicePolicy, error) {
+	for _, e := range []HostServicePolicy{
+		HostServicePolicyOn,
+		HostServicePolicyAutomatic,
+		HostServicePolicyOff,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostServicePolicy: %q", s)
+}
+
 func init() {
 	t["HostServicePolicy"] = reflect.TypeOf((*HostServicePolicy)(nil)).Elem()
 }
@@ -2147,6 +5097,23 @@ const (
 	HostSevInfoSevStateWorking       = HostSevInfoSevState("working")
 )
 
+func (e HostSevInfoSevState) String() string {
+	return string(e)
+}
+
+func ParseHostSevInfoSevState(s string) (HostSevInfoSevState, error) {
+	for _, e := range []HostSevInfoSevState{
+		HostSevInfoSevStateUninitialized,
+		HostSevInfoSevStateInitialized,
+		HostSevInfoSevStateWorking,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSevInfoSevState: %q", s)
+}
+
 func init() {
 	t["HostSevInfoSevState"] = reflect.TypeOf((*HostSevInfoSevState)(nil)).Elem()
 }
@@ -2159,6 +5126,23 @@ const (
 	HostSgxInfoFlcModesUnlocked = HostSgxInfoFlcModes("unlocked")
 )
 
+func (e HostSgxInfoFlcModes) String() string {
+	return string(e)
+}
+
+func ParseHostSgxInfoFlcModes(s string) (HostSgxInfoFlcModes, error) {
+	for _, e := range []HostSgxInfoFlcModes{
+		HostSgxInfoFlcModesOff,
+		HostSgxInfoFlcModesLocked,
+		HostSgxInfoFlcModesUnlocked,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSgxInfoFlcModes: %q", s)
+}
+
 func init() {
 	t["HostSgxInfoFlcModes"] = reflect.TypeOf((*HostSgxInfoFlcModes)(nil)).Elem()
 }
@@ -2176,6 +5160,28 @@ const (
 	HostSgxInfoSgxStatesEnabled             = HostSgxInfoSgxStates("enabled")
 )
 
+func (e HostSgxInfoSgxStates) String() string {
+	return string(e)
+}
+
+func ParseHostSgxInfoSgxStates(s string) (HostSgxInfoSgxStates, error) {
+	for _, e := range []HostSgxInfoSgxStates{
+		HostSgxInfoSgxStatesNotPresent,
+		HostSgxInfoSgxStatesDisabledBIOS,
+		HostSgxInfoSgxStatesDisabledCFW101,
+		HostSgxInfoSgxStatesDisabledCPUMismatch,
+		HostSgxInfoSgxStatesDisabledNoFLC,
+		HostSgxInfoSgxStatesDisabledNUMAUnsup,
+		HostSgxInfoSgxStatesDisabledMaxEPCRegs,
+		HostSgxInfoSgxStatesEnabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSgxInfoSgxStates: %q", s)
+}
+
 func init() {
 	t["HostSgxInfoSgxStates"] = reflect.TypeOf((*HostSgxInfoSgxStates)(nil)).Elem()
 }
@@ -2188,6 +5194,23 @@ const (
 	HostSnmpAgentCapabilityCONFIGURATION = HostSnmpAgentCapability("CONFIGURATION")
 )
 
+func (e HostSnmpAgentCapability) String() string {
+	return string(e)
+}
+
+func ParseHostSnmpAgentCapability(s string) (HostSnmpAgentCapability, error) {
+	for _, e := range []HostSnmpAgentCapability{
+		HostSnmpAgentCapabilityCOMPLETE,
+		HostSnmpAgentCapabilityDIAGNOSTICS,
+		HostSnmpAgentCapabilityCONFIGURATION,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSnmpAgentCapability: %q", s)
+}
+
 func init() {
 	t["HostSnmpAgentCapability"] = reflect.TypeOf((*HostSnmpAgentCapability)(nil)).Elem()
 }
@@ -2201,6 +5224,24 @@ const (
 	HostStandbyModeNone     = HostStandbyMode("none")
 )
 
+func (e HostStandbyMode) String() string {
+	return string(e)
+}
+
+func ParseHostStandbyMode(s string) (HostStandbyMode, error) {
+	for _, e := range []HostStandbyMode{
+		HostStandbyModeEntering,
+		HostStandbyModeExiting,
+		HostStandbyModeIn,
+		HostStandbyModeNone,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostStandbyMode: %q", s)
+}
+
 func init() {
 	t["HostStandbyMode"] = reflect.TypeOf((*HostStandbyMode)(nil)).Elem()
 }
@@ -2212,6 +5253,22 @@ const (
 	HostStorageProtocolNvme = HostStorageProtocol("nvme")
 )
 
+func (e HostStorageProtocol) String() string {
+	return string(e)
+}
+
+func ParseHostStorageProtocol(s string) (HostStorageProtocol, error) {
+	for _, e := range []HostStorageProtocol{
+		HostStorageProtocolScsi,
+		HostStorageProtocolNvme,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostStorageProtocol: %q", s)
+}
+
 func init() {
 	t["HostStorageProtocol"] = reflect.TypeOf((*HostStorageProtocol)(nil)).Elem()
 }
@@ -2224,6 +5281,23 @@ const (
 	HostSystemConnectionStateDisconnected  = HostSystemConnectionState("disconnected")
 )
 
+func (e HostSystemConnectionState) String() string {
+	return string(e)
+}
+
+func ParseHostSystemConnectionState(s string) (HostSystemConnectionState, error) {
+	for _, e := range []HostSystemConnectionState{
+		HostSystemConnectionStateConnected,
+		HostSystemConnectionStateNotResponding,
+		HostSystemConnectionStateDisconnected,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSystemConnectionState: %q", s)
+}
+
 func init() {
 	t["HostSystemConnectionState"] = reflect.TypeOf((*HostSystemConnectionState)(nil)).Elem()
 }
@@ -2238,6 +5312,25 @@ const (
 	HostSystemIdentificationInfoIdentifierSerialNumberTag          = HostSystemIdentificationInfoIdentifier("SerialNumberTag")
 )
 
+func (e HostSystemIdentificationInfoIdentifier) String() string {
+	return string(e)
+}
+
+func ParseHostSystemIdentificationInfoIdentifier(s string) (HostSystemIdentificationInfoIdentifier, error) {
+	for _, e := range []HostSystemIdentificationInfoIdentifier{
+		HostSystemIdentificationInfoIdentifierAssetTag,
+		HostSystemIdentificationInfoIdentifierServiceTag,
+		HostSystemIdentificationInfoIdentifierOemSpecificString,
+		HostSystemIdentificationInfoIdentifierEnclosureSerialNumberTag,
+		HostSystemIdentificationInfoIdentifierSerialNumberTag,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSystemIdentificationInfoIdentifier: %q", s)
+}
+
 func init() {
 	t["HostSystemIdentificationInfoIdentifier"] = reflect.TypeOf((*HostSystemIdentificationInfoIdentifier)(nil)).Elem()
 }
@@ -2251,6 +5344,24 @@ const (
 	HostSystemPowerStateUnknown    = HostSystemPowerState("unknown")
 )
 
+func (e HostSystemPowerState) String() string {
+	return string(e)
+}
+
+func ParseHostSystemPowerState(s string) (HostSystemPowerState, error) {
+	for _, e := range []HostSystemPowerState{
+		HostSystemPowerStatePoweredOn,
+		HostSystemPowerStatePoweredOff,
+		HostSystemPowerStateStandBy,
+		HostSystemPowerStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSystemPowerState: %q", s)
+}
+
 func init() {
 	t["HostSystemPowerState"] = reflect.TypeOf((*HostSystemPowerState)(nil)).Elem()
 }
@@ -2266,6 +5377,26 @@ const (
 	HostSystemRemediationStateStateRemediationFailed           = HostSystemRemediationStateState("remediationFailed")
 )
 
+func (e HostSystemRemediationStateState) String() string {
+	return string(e)
+}
+
+func ParseHostSystemRemediationStateState(s string) (HostSystemRemediationStateState, error) {
+	for _, e := range []HostSystemRemediationStateState{
+		HostSystemRemediationStateStateRemediationReady,
+		HostSystemRemediationStateStatePrecheckRemediationRunning,
+		HostSystemRemediationStateStatePrecheckRemediationComplete,
+		HostSystemRemediationStateStatePrecheckRemediationFailed,
+		HostSystemRemediationStateStateRemediationRunning,
+		HostSystemRemediationStateStateRemediationFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostSystemRemediationStateState: %q", s)
+}
+
 func init() {
 	t["HostSystemRemediationStateState"] = reflect.TypeOf((*HostSystemRemediationStateState)(nil)).Elem()
 }
@@ -2277,6 +5408,22 @@ const (
 	HostTpmAttestationInfoAcceptanceStatusAccepted    = HostTpmAttestationInfoAcceptanceStatus("accepted")
 )
 
+func (e HostTpmAttestationInfoAcceptanceStatus) String() string {
+	return string(e)
+}
+
+func ParseHostTpmAttestationInfoAcceptanceStatus(s string) (HostTpmAttestationInfoAcceptanceStatus, error) {
+	for _, e := range []HostTpmAttestationInfoAcceptanceStatus{
+		HostTpmAttestationInfoAcceptanceStatusNotAccepted,
+		HostTpmAttestationInfoAcceptanceStatusAccepted,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostTpmAttestationInfoAcceptanceStatus: %q", s)
+}
+
 func init() {
 	t["HostTpmAttestationInfoAcceptanceStatus"] = reflect.TypeOf((*HostTpmAttestationInfoAcceptanceStatus)(nil)).Elem()
 }
@@ -2289,6 +5436,23 @@ const (
 	HostTrustAuthorityAttestationInfoAttestationStatusUnknown     = HostTrustAuthorityAttestationInfoAttestationStatus("unknown")
 )
 
+func (e HostTrustAuthorityAttestationInfoAttestationStatus) String() string {
+	return string(e)
+}
+
+func ParseHostTrustAuthorityAttestationInfoAttestationStatus(s string) (HostTrustAuthorityAttestationInfoAttestationStatus, error) {
+	for _, e := range []HostTrustAuthorityAttestationInfoAttestationStatus{
+		HostTrustAuthorityAttestationInfoAttestationStatusAttested,
+		HostTrustAuthorityAttestationInfoAttestationStatusNotAttested,
+		HostTrustAuthorityAttestationInfoAttestationStatusUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostTrustAuthorityAttestationInfoAttestationStatus: %q", s)
+}
+
 func init() {
 	t["HostTrustAuthorityAttestationInfoAttestationStatus"] = reflect.TypeOf((*HostTrustAuthorityAttestationInfoAttestationStatus)(nil)).Elem()
 }
@@ -2300,6 +5464,22 @@ const (
 	HostUnresolvedVmfsExtentUnresolvedReasonUuidConflict   = HostUnresolvedVmfsExtentUnresolvedReason("uuidConflict")
 )
 
+func (e HostUnresolvedVmfsExtentUnresolvedReason) String() string {
+	return string(e)
+}
+
+func ParseHostUnresolvedVmfsExtentUnresolvedReason(s string) (HostUnresolvedVmfsExtentUnresolvedReason, error) {
+	for _, e := range []HostUnresolvedVmfsExtentUnresolvedReason{
+		HostUnresolvedVmfsExtentUnresolvedReasonDiskIdMismatch,
+		HostUnresolvedVmfsExtentUnresolvedReasonUuidConflict,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostUnresolvedVmfsExtentUnresolvedReason: %q", s)
+}
+
 func init() {
 	t["HostUnresolvedVmfsExtentUnresolvedReason"] = reflect.TypeOf((*HostUnresolvedVmfsExtentUnresolvedReason)(nil)).Elem()
 }
@@ -2311,6 +5491,22 @@ const (
 	HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionForceMount  = HostUnresolvedVmfsResolutionSpecVmfsUuidResolution("forceMount")
 )
 
+func (e HostUnresolvedVmfsResolutionSpecVmfsUuidResolution) String() string {
+	return string(e)
+}
+
+func ParseHostUnresolvedVmfsResolutionSpecVmfsUuidResolution(s string) (HostUnresolvedVmfsResolutionSpecVmfsUuidResolution, error) {
+	for _, e := range []HostUnresolvedVmfsResolutionSpecVmfsUuidResolution{
+		HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionResignature,
+		HostUnresolvedVmfsResolutionSpecVmfsUuidResolutionForceMount,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostUnresolvedVmfsResolutionSpecVmfsUuidResolution: %q", s)
+}
+
 func init() {
 	t["HostUnresolvedVmfsResolutionSpecVmfsUuidResolution"] = reflect.TypeOf((*HostUnresolvedVmfsResolutionSpecVmfsUuidResolution)(nil)).Elem()
 }
@@ -2330,6 +5526,30 @@ const (
 	HostVirtualNicManagerNicTypePtp                   = HostVirtualNicManagerNicType("ptp")
 )
 
+func (e HostVirtualNicManagerNicType) String() string {
+	return string(e)
+}
+
+func ParseHostVirtualNicManagerNicType(s string) (HostVirtualNicManagerNicType, error) {
+	for _, e := range []HostVirtualNicManagerNicType{
+		HostVirtualNicManagerNicTypeVmotion,
+		HostVirtualNicManagerNicTypeFaultToleranceLogging,
+		HostVirtualNicManagerNicTypeVSphereReplication,
+		HostVirtualNicManagerNicTypeVSphereReplicationNFC,
+		HostVirtualNicManagerNicTypeManagement,
+		HostVirtualNicManagerNicTypeVsan,
+		HostVirtualNicManagerNicTypeVSphereProvisioning,
+		HostVirtualNicManagerNicTypeVsanWitness,
+		HostVirtualNicManagerNicTypeVSphereBackupNFC,
+		HostVirtualNicManagerNicTypePtp,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostVirtualNicManagerNicType: %q", s)
+}
+
 func init() {
 	t["HostVirtualNicManagerNicType"] = reflect.TypeOf((*HostVirtualNicManagerNicType)(nil)).Elem()
 }
@@ -2342,6 +5562,23 @@ const (
 	HostVmciAccessManagerModeRevoke  = HostVmciAccessManagerMode("revoke")
 )
 
+func (e HostVmciAccessManagerMode) String() string {
+	return string(e)
+}
+
+func ParseHostVmciAccessManagerMode(s string) (HostVmciAccessManagerMode, error) {
+	for _, e := range []HostVmciAccessManagerMode{
+		HostVmciAccessManagerModeGrant,
+		HostVmciAccessManagerModeReplace,
+		HostVmciAccessManagerModeRevoke,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostVmciAccessManagerMode: %q", s)
+}
+
 func init() {
 	t["HostVmciAccessManagerMode"] = reflect.TypeOf((*HostVmciAccessManagerMode)(nil)).Elem()
 }
@@ -2353,6 +5590,22 @@ const (
 	HostVmfsVolumeUnmapBandwidthPolicyDynamic = HostVmfsVolumeUnmapBandwidthPolicy("dynamic")
 )
 
+func (e HostVmfsVolumeUnmapBandwidthPolicy) String() string {
+	return string(e)
+}
+
+func ParseHostVmfsVolumeUnmapBandwidthPolicy(s string) (HostVmfsVolumeUnmapBandwidthPolicy, error) {
+	for _, e := range []HostVmfsVolumeUnmapBandwidthPolicy{
+		HostVmfsVolumeUnmapBandwidthPolicyFixed,
+		HostVmfsVolumeUnmapBandwidthPolicyDynamic,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostVmfsVolumeUnmapBandwidthPolicy: %q", s)
+}
+
 func init() {
 	t["HostVmfsVolumeUnmapBandwidthPolicy"] = reflect.TypeOf((*HostVmfsVolumeUnmapBandwidthPolicy)(nil)).Elem()
 }
@@ -2364,6 +5617,22 @@ const (
 	HostVmfsVolumeUnmapPriorityLow  = HostVmfsVolumeUnmapPriority("low")
 )
 
+func (e HostVmfsVolumeUnmapPriority) String() string {
+	return string(e)
+}
+
+func ParseHostVmfsVolumeUnmapPriority(s string) (HostVmfsVolumeUnmapPriority, error) {
+	for _, e := range []HostVmfsVolumeUnmapPriority{
+		HostVmfsVolumeUnmapPriorityNone,
+		HostVmfsVolumeUnmapPriorityLow,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HostVmfsVolumeUnmapPriority: %q", s)
+}
+
 func init() {
 	t["HostVmfsVolumeUnmapPriority"] = reflect.TypeOf((*HostVmfsVolumeUnmapPriority)(nil)).Elem()
 }
@@ -2375,6 +5644,22 @@ const (
 	HttpNfcLeaseManifestEntryChecksumTypeSha256 = HttpNfcLeaseManifestEntryChecksumType("sha256")
 )
 
+func (e HttpNfcLeaseManifestEntryChecksumType) String() string {
+	return string(e)
+}
+
+func ParseHttpNfcLeaseManifestEntryChecksumType(s string) (HttpNfcLeaseManifestEntryChecksumType, error) {
+	for _, e := range []HttpNfcLeaseManifestEntryChecksumType{
+		HttpNfcLeaseManifestEntryChecksumTypeSha1,
+		HttpNfcLeaseManifestEntryChecksumTypeSha256,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HttpNfcLeaseManifestEntryChecksumType: %q", s)
+}
+
 func init() {
 	t["HttpNfcLeaseManifestEntryChecksumType"] = reflect.TypeOf((*HttpNfcLeaseManifestEntryChecksumType)(nil)).Elem()
 }
@@ -2386,6 +5671,22 @@ const (
 	HttpNfcLeaseModePull      = HttpNfcLeaseMode("pull")
 )
 
+func (e HttpNfcLeaseMode) String() string {
+	return string(e)
+}
+
+func ParseHttpNfcLeaseMode(s string) (HttpNfcLeaseMode, error) {
+	for _, e := range []HttpNfcLeaseMode{
+		HttpNfcLeaseModePushOrGet,
+		HttpNfcLeaseModePull,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HttpNfcLeaseMode: %q", s)
+}
+
 func init() {
 	t["HttpNfcLeaseMode"] = reflect.TypeOf((*HttpNfcLeaseMode)(nil)).Elem()
 }
@@ -2399,6 +5700,24 @@ const (
 	HttpNfcLeaseStateError        = HttpNfcLeaseState("error")
 )
 
+func (e HttpNfcLeaseState) String() string {
+	return string(e)
+}
+
+func ParseHttpNfcLeaseState(s string) (HttpNfcLeaseState, error) {
+	for _, e := range []HttpNfcLeaseState{
+		HttpNfcLeaseStateInitializing,
+		HttpNfcLeaseStateReady,
+		HttpNfcLeaseStateDone,
+		HttpNfcLeaseStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid HttpNfcLeaseState: %q", s)
+}
+
 func init() {
 	t["HttpNfcLeaseState"] = reflect.TypeOf((*HttpNfcLeaseState)(nil)).Elem()
 }
@@ -2410,6 +5729,22 @@ const (
 	IncompatibleHostForVmReplicationIncompatibleReasonNetCompression = IncompatibleHostForVmReplicationIncompatibleReason("netCompression")
 )
 
+func (e IncompatibleHostForVmReplicationIncompatibleReason) String() string {
+	return string(e)
+}
+
+func ParseIncompatibleHostForVmReplicationIncompatibleReason(s string) (IncompatibleHostForVmReplicationIncompatibleReason, error) {
+	for _, e := range []IncompatibleHostForVmReplicationIncompatibleReason{
+		IncompatibleHostForVmReplicationIncompatibleReasonRpo,
+		IncompatibleHostForVmReplicationIncompatibleReasonNetCompression,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IncompatibleHostForVmReplicationIncompatibleReason: %q", s)
+}
+
 func init() {
 	t["IncompatibleHostForVmReplicationIncompatibleReason"] = reflect.TypeOf((*IncompatibleHostForVmReplicationIncompatibleReason)(nil)).Elem()
 }
@@ -2422,6 +5757,23 @@ const (
 	InternetScsiSnsDiscoveryMethodIsnsSlp    = InternetScsiSnsDiscoveryMethod("isnsSlp")
 )
 
+func (e InternetScsiSnsDiscoveryMethod) String() string {
+	return string(e)
+}
+
+func ParseInternetScsiSnsDiscoveryMethod(s string) (InternetScsiSnsDiscoveryMethod, error) {
+	for _, e := range []InternetScsiSnsDiscoveryMethod{
+		InternetScsiSnsDiscoveryMethodIsnsStatic,
+		InternetScsiSnsDiscoveryMethodIsnsDhcp,
+		InternetScsiSnsDiscoveryMethodIsnsSlp,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InternetScsiSnsDiscoveryMethod: %q", s)
+}
+
 func init() {
 	t["InternetScsiSnsDiscoveryMethod"] = reflect.TypeOf((*InternetScsiSnsDiscoveryMethod)(nil)).Elem()
 }
@@ -2434,6 +5786,23 @@ const (
 	InvalidDasConfigArgumentEntryForInvalidArgumentVmConfig         = InvalidDasConfigArgumentEntryForInvalidArgument("vmConfig")
 )
 
+func (e InvalidDasConfigArgumentEntryForInvalidArgument) String() string {
+	return string(e)
+}
+
+func ParseInvalidDasConfigArgumentEntryForInvalidArgument(s string) (InvalidDasConfigArgumentEntryForInvalidArgument, error) {
+	for _, e := range []InvalidDasConfigArgumentEntryForInvalidArgument{
+		InvalidDasConfigArgumentEntryForInvalidArgumentAdmissionControl,
+		InvalidDasConfigArgumentEntryForInvalidArgumentUserHeartbeatDs,
+		InvalidDasConfigArgumentEntryForInvalidArgumentVmConfig,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InvalidDasConfigArgumentEntryForInvalidArgument: %q", s)
+}
+
 func init() {
 	t["InvalidDasConfigArgumentEntryForInvalidArgument"] = reflect.TypeOf((*InvalidDasConfigArgumentEntryForInvalidArgument)(nil)).Elem()
 }
@@ -2445,6 +5814,22 @@ const (
 	InvalidProfileReferenceHostReasonMissingReferenceHost = InvalidProfileReferenceHostReason("missingReferenceHost")
 )
 
+func (e InvalidProfileReferenceHostReason) String() string {
+	return string(e)
+}
+
+func ParseInvalidProfileReferenceHostReason(s string) (InvalidProfileReferenceHostReason, error) {
+	for _, e := range []InvalidProfileReferenceHostReason{
+		InvalidProfileReferenceHostReasonIncompatibleVersion,
+		InvalidProfileReferenceHostReasonMissingReferenceHost,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid InvalidProfileReferenceHostReason: %q", s)
+}
+
 func init() {
 	t["InvalidProfileReferenceHostReason"] = reflect.TypeOf((*InvalidProfileReferenceHostReason)(nil)).Elem()
 }
@@ -2457,6 +5842,23 @@ const (
 	IoFilterOperationUpgrade   = IoFilterOperation("upgrade")
 )
 
+func (e IoFilterOperation) String() string {
+	return string(e)
+}
+
+func ParseIoFilterOperation(s string) (IoFilterOperation, error) {
+	for _, e := range []IoFilterOperation{
+		IoFilterOperationInstall,
+		IoFilterOperationUninstall,
+		IoFilterOperationUpgrade,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IoFilterOperation: %q", s)
+}
+
 func init() {
 	t["IoFilterOperation"] = reflect.TypeOf((*IoFilterOperation)(nil)).Elem()
 }
@@ -2473,6 +5875,27 @@ const (
 	IoFilterTypeDataProvider       = IoFilterType("dataProvider")
 )
 
+func (e IoFilterType) String() string {
+	return string(e)
+}
+
+func ParseIoFilterType(s string) (IoFilterType, error) {
+	for _, e := range []IoFilterType{
+		IoFilterTypeCache,
+		IoFilterTypeReplication,
+		IoFilterTypeEncryption,
+		IoFilterTypeCompression,
+		IoFilterTypeInspection,
+		IoFilterTypeDatastoreIoControl,
+		IoFilterTypeDataProvider,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IoFilterType: %q", s)
+}
+
 func init() {
 	t["IoFilterType"] = reflect.TypeOf((*IoFilterType)(nil)).Elem()
 }
@@ -2486,6 +5909,24 @@ const (
 	IscsiPortInfoPathStatusLastActive = IscsiPortInfoPathStatus("lastActive")
 )
 
+func (e IscsiPortInfoPathStatus) String() string {
+	return string(e)
+}
+
+func ParseIscsiPortInfoPathStatus(s string) (IscsiPortInfoPathStatus, error) {
+	for _, e := range []IscsiPortInfoPathStatus{
+		IscsiPortInfoPathStatusNotUsed,
+		IscsiPortInfoPathStatusActive,
+		IscsiPortInfoPathStatusStandBy,
+		IscsiPortInfoPathStatusLastActive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid IscsiPortInfoPathStatus: %q", s)
+}
+
 func init() {
 	t["IscsiPortInfoPathStatus"] = reflect.TypeOf((*IscsiPortInfoPathStatus)(nil)).Elem()
 }
@@ -2499,6 +5940,24 @@ const (
 	KmipClusterInfoKmsManagementTypeNativeProvider = KmipClusterInfoKmsManagementType("nativeProvider")
 )
 
+func (e KmipClusterInfoKmsManagementType) String() string {
+	return string(e)
+}
+
+func ParseKmipClusterInfoKmsManagementType(s string) (KmipClusterInfoKmsManagementType, error) {
+	for _, e := range []KmipClusterInfoKmsManagementType{
+		KmipClusterInfoKmsManagementTypeUnknown,
+		KmipClusterInfoKmsManagementTypeVCenter,
+		KmipClusterInfoKmsManagementTypeTrustAuthority,
+		KmipClusterInfoKmsManagementTypeNativeProvider,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid KmipClusterInfoKmsManagementType: %q", s)
+}
+
 func init() {
 	t["KmipClusterInfoKmsManagementType"] = reflect.TypeOf((*KmipClusterInfoKmsManagementType)(nil)).Elem()
 }
@@ -2513,6 +5972,25 @@ const (
 	LatencySensitivitySensitivityLevelCustom = LatencySensitivitySensitivityLevel("custom")
 )
 
+func (e LatencySensitivitySensitivityLevel) String() string {
+	return string(e)
+}
+
+func ParseLatencySensitivitySensitivityLevel(s string) (LatencySensitivitySensitivityLevel, error) {
+	for _, e := range []LatencySensitivitySensitivityLevel{
+		LatencySensitivitySensitivityLevelLow,
+		LatencySensitivitySensitivityLevelNormal,
+		LatencySensitivitySensitivityLevelMedium,
+		LatencySensitivitySensitivityLevelHigh,
+		LatencySensitivitySensitivityLevelCustom,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LatencySensitivitySensitivityLevel: %q", s)
+}
+
 func init() {
 	t["LatencySensitivitySensitivityLevel"] = reflect.TypeOf((*LatencySensitivitySensitivityLevel)(nil)).Elem()
 }
@@ -2526,6 +6004,24 @@ const (
 	LicenseAssignmentFailedReasonHostsUnmanageableByVirtualCenterWithoutLicenseServer = LicenseAssignmentFailedReason("hostsUnmanageableByVirtualCenterWithoutLicenseServer")
 )
 
+func (e LicenseAssignmentFailedReason) String() string {
+	return string(e)
+}
+
+func ParseLicenseAssignmentFailedReason(s string) (LicenseAssignmentFailedReason, error) {
+	for _, e := range []LicenseAssignmentFailedReason{
+		LicenseAssignmentFailedReasonKeyEntityMismatch,
+		LicenseAssignmentFailedReasonDowngradeDisallowed,
+		LicenseAssignmentFailedReasonInventoryNotManageableByVirtualCenter,
+		LicenseAssignmentFailedReasonHostsUnmanageableByVirtualCenterWithoutLicenseServer,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseAssignmentFailedReason: %q", s)
+}
+
 func init() {
 	t["LicenseAssignmentFailedReason"] = reflect.TypeOf((*LicenseAssignmentFailedReason)(nil)).Elem()
 }
@@ -2538,6 +6034,23 @@ const (
 	LicenseFeatureInfoSourceRestrictionFile         = LicenseFeatureInfoSourceRestriction("file")
 )
 
+func (e LicenseFeatureInfoSourceRestriction) String() string {
+	return string(e)
+}
+
+func ParseLicenseFeatureInfoSourceRestriction(s string) (LicenseFeatureInfoSourceRestriction, error) {
+	for _, e := range []LicenseFeatureInfoSourceRestriction{
+		LicenseFeatureInfoSourceRestrictionUnrestricted,
+		LicenseFeatureInfoSourceRestrictionServed,
+		LicenseFeatureInfoSourceRestrictionFile,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseFeatureInfoSourceRestriction: %q", s)
+}
+
 func init() {
 	t["LicenseFeatureInfoSourceRestriction"] = reflect.TypeOf((*LicenseFeatureInfoSourceRestriction)(nil)).Elem()
 }
@@ -2550,6 +6063,23 @@ const (
 	LicenseFeatureInfoStateOptional = LicenseFeatureInfoState("optional")
 )
 
+func (e LicenseFeatureInfoState) String() string {
+	return string(e)
+}
+
+func ParseLicenseFeatureInfoState(s string) (LicenseFeatureInfoState, error) {
+	for _, e := range []LicenseFeatureInfoState{
+		LicenseFeatureInfoStateEnabled,
+		LicenseFeatureInfoStateDisabled,
+		LicenseFeatureInfoStateOptional,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseFeatureInfoState: %q", s)
+}
+
 func init() {
 	t["LicenseFeatureInfoState"] = reflect.TypeOf((*LicenseFeatureInfoState)(nil)).Elem()
 }
@@ -2564,6 +6094,25 @@ const (
 	LicenseFeatureInfoUnitVm         = LicenseFeatureInfoUnit("vm")
 )
 
+func (e LicenseFeatureInfoUnit) String() string {
+	return string(e)
+}
+
+func ParseLicenseFeatureInfoUnit(s string) (LicenseFeatureInfoUnit, error) {
+	for _, e := range []LicenseFeatureInfoUnit{
+		LicenseFeatureInfoUnitHost,
+		LicenseFeatureInfoUnitCpuCore,
+		LicenseFeatureInfoUnitCpuPackage,
+		LicenseFeatureInfoUnitServer,
+		LicenseFeatureInfoUnitVm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseFeatureInfoUnit: %q", s)
+}
+
 func init() {
 	t["LicenseFeatureInfoUnit"] = reflect.TypeOf((*LicenseFeatureInfoUnit)(nil)).Elem()
 }
@@ -2590,6 +6139,37 @@ const (
 	LicenseManagerLicenseKeyDas        = LicenseManagerLicenseKey("das")
 )
 
+func (e LicenseManagerLicenseKey) String() string {
+	return string(e)
+}
+
+func ParseLicenseManagerLicenseKey(s string) (LicenseManagerLicenseKey, error) {
+	for _, e := range []LicenseManagerLicenseKey{
+		LicenseManagerLicenseKeyEsxFull,
+		LicenseManagerLicenseKeyEsxVmtn,
+		LicenseManagerLicenseKeyEsxExpress,
+		LicenseManagerLicenseKeySan,
+		LicenseManagerLicenseKeyIscsi,
+		LicenseManagerLicenseKeyNas,
+		LicenseManagerLicenseKeyVsmp,
+		LicenseManagerLicenseKeyBackup,
+		LicenseManagerLicenseKeyVc,
+		LicenseManagerLicenseKeyVcExpress,
+		LicenseManagerLicenseKeyEsxHost,
+		LicenseManagerLicenseKeyGsxHost,
+		LicenseManagerLicenseKeyServerHost,
+		LicenseManagerLicenseKeyDrsPower,
+		LicenseManagerLicenseKeyVmotion,
+		LicenseManagerLicenseKeyDrs,
+		LicenseManagerLicenseKeyDas,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseManagerLicenseKey: %q", s)
+}
+
 func init() {
 	t["LicenseManagerLicenseKey"] = reflect.TypeOf((*LicenseManagerLicenseKey)(nil)).Elem()
 }
@@ -2603,6 +6183,24 @@ const (
 	LicenseManagerStateFault        = LicenseManagerState("fault")
 )
 
+func (e LicenseManagerState) String() string {
+	return string(e)
+}
+
+func ParseLicenseManagerState(s string) (LicenseManagerState, error) {
+	for _, e := range []LicenseManagerState{
+		LicenseManagerStateInitializing,
+		LicenseManagerStateNormal,
+		LicenseManagerStateMarginal,
+		LicenseManagerStateFault,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseManagerState: %q", s)
+}
+
 func init() {
 	t["LicenseManagerState"] = reflect.TypeOf((*LicenseManagerState)(nil)).Elem()
 }
@@ -2616,6 +6214,24 @@ const (
 	LicenseReservationInfoStateLicensed      = LicenseReservationInfoState("licensed")
 )
 
+func (e LicenseReservationInfoState) String() string {
+	return string(e)
+}
+
+func ParseLicenseReservationInfoState(s string) (LicenseReservationInfoState, error) {
+	for _, e := range []LicenseReservationInfoState{
+		LicenseReservationInfoStateNotUsed,
+		LicenseReservationInfoStateNoLicense,
+		LicenseReservationInfoStateUnlicensedUse,
+		LicenseReservationInfoStateLicensed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LicenseReservationInfoState: %q", s)
+}
+
 func init() {
 	t["LicenseReservationInfoState"] = reflect.TypeOf((*LicenseReservationInfoState)(nil)).Elem()
 }
@@ -2629,6 +6245,24 @@ const (
 	LinkDiscoveryProtocolConfigOperationTypeBoth      = LinkDiscoveryProtocolConfigOperationType("both")
 )
 
+func (e LinkDiscoveryProtocolConfigOperationType) String() string {
+	return string(e)
+}
+
+func ParseLinkDiscoveryProtocolConfigOperationType(s string) (LinkDiscoveryProtocolConfigOperationType, error) {
+	for _, e := range []LinkDiscoveryProtocolConfigOperationType{
+		LinkDiscoveryProtocolConfigOperationTypeNone,
+		LinkDiscoveryProtocolConfigOperationTypeListen,
+		LinkDiscoveryProtocolConfigOperationTypeAdvertise,
+		LinkDiscoveryProtocolConfigOperationTypeBoth,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LinkDiscoveryProtocolConfigOperationType: %q", s)
+}
+
 func init() {
 	t["LinkDiscoveryProtocolConfigOperationType"] = reflect.TypeOf((*LinkDiscoveryProtocolConfigOperationType)(nil)).Elem()
 }
@@ -2640,6 +6274,22 @@ const (
 	LinkDiscoveryProtocolConfigProtocolTypeLldp = LinkDiscoveryProtocolConfigProtocolType("lldp")
 )
 
+func (e LinkDiscoveryProtocolConfigProtocolType) String() string {
+	return string(e)
+}
+
+func ParseLinkDiscoveryProtocolConfigProtocolType(s string) (LinkDiscoveryProtocolConfigProtocolType, error) {
+	for _, e := range []LinkDiscoveryProtocolConfigProtocolType{
+		LinkDiscoveryProtocolConfigProtocolTypeCdp,
+		LinkDiscoveryProtocolConfigProtocolTypeLldp,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid LinkDiscoveryProtocolConfigProtocolType: %q", s)
+}
+
 func init() {
 	t["LinkDiscoveryProtocolConfigProtocolType"] = reflect.TypeOf((*LinkDiscoveryProtocolConfigProtocolType)(nil)).Elem()
 }
@@ -2653,6 +6303,24 @@ const (
 	ManagedEntityStatusRed    = ManagedEntityStatus("red")
 )
 
+func (e ManagedEntityStatus) String() string {
+	return string(e)
+}
+
+func ParseManagedEntityStatus(s string) (ManagedEntityStatus, error) {
+	for _, e := range []ManagedEntityStatus{
+		ManagedEntityStatusGray,
+		ManagedEntityStatusGreen,
+		ManagedEntityStatusYellow,
+		ManagedEntityStatusRed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ManagedEntityStatus: %q", s)
+}
+
 func init() {
 	t["ManagedEntityStatus"] = reflect.TypeOf((*ManagedEntityStatus)(nil)).Elem()
 }
@@ -2664,6 +6332,22 @@ const (
 	MetricAlarmOperatorIsBelow = MetricAlarmOperator("isBelow")
 )
 
+func (e MetricAlarmOperator) String() string {
+	return string(e)
+}
+
+func ParseMetricAlarmOperator(s string) (MetricAlarmOperator, error) {
+	for _, e := range []MetricAlarmOperator{
+		MetricAlarmOperatorIsAbove,
+		MetricAlarmOperatorIsBelow,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MetricAlarmOperator: %q", s)
+}
+
 func init() {
 	t["MetricAlarmOperator"] = reflect.TypeOf((*MetricAlarmOperator)(nil)).Elem()
 }
@@ -2678,6 +6362,25 @@ const (
 	MultipathStateUnknown  = MultipathState("unknown")
 )
 
+func (e MultipathState) String() string {
+	return string(e)
+}
+
+func ParseMultipathState(s string) (MultipathState, error) {
+	for _, e := range []MultipathState{
+		MultipathStateStandby,
+		MultipathStateActive,
+		MultipathStateDisabled,
+		MultipathStateDead,
+		MultipathStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid MultipathState: %q", s)
+}
+
 func init() {
 	t["MultipathState"] = reflect.TypeOf((*MultipathState)(nil)).Elem()
 }
@@ -2691,6 +6394,24 @@ const (
 	NetBIOSConfigInfoModeEnabledViaDHCP = NetBIOSConfigInfoMode("enabledViaDHCP")
 )
 
+func (e NetBIOSConfigInfoMode) String() string {
+	return string(e)
+}
+
+func ParseNetBIOSConfigInfoMode(s string) (NetBIOSConfigInfoMode, error) {
+	for _, e := range []NetBIOSConfigInfoMode{
+		NetBIOSConfigInfoModeUnknown,
+		NetBIOSConfigInfoModeEnabled,
+		NetBIOSConfigInfoModeDisabled,
+		NetBIOSConfigInfoModeEnabledViaDHCP,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetBIOSConfigInfoMode: %q", s)
+}
+
 func init() {
 	t["NetBIOSConfigInfoMode"] = reflect.TypeOf((*NetBIOSConfigInfoMode)(nil)).Elem()
 }
@@ -2705,6 +6426,25 @@ const (
 	NetIpConfigInfoIpAddressOriginRandom    = NetIpConfigInfoIpAddressOrigin("random")
 )
 
+func (e NetIpConfigInfoIpAddressOrigin) String() string {
+	return string(e)
+}
+
+func ParseNetIpConfigInfoIpAddressOrigin(s string) (NetIpConfigInfoIpAddressOrigin, error) {
+	for _, e := range []NetIpConfigInfoIpAddressOrigin{
+		NetIpConfigInfoIpAddressOriginOther,
+		NetIpConfigInfoIpAddressOriginManual,
+		NetIpConfigInfoIpAddressOriginDhcp,
+		NetIpConfigInfoIpAddressOriginLinklayer,
+		NetIpConfigInfoIpAddressOriginRandom,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetIpConfigInfoIpAddressOrigin: %q", s)
+}
+
 func init() {
 	t["NetIpConfigInfoIpAddressOrigin"] = reflect.TypeOf((*NetIpConfigInfoIpAddressOrigin)(nil)).Elem()
 }
@@ -2721,6 +6461,27 @@ const (
 	NetIpConfigInfoIpAddressStatusDuplicate    = NetIpConfigInfoIpAddressStatus("duplicate")
 )
 
+func (e NetIpConfigInfoIpAddressStatus) String() string {
+	return string(e)
+}
+
+func ParseNetIpConfigInfoIpAddressStatus(s string) (NetIpConfigInfoIpAddressStatus, error) {
+	for _, e := range []NetIpConfigInfoIpAddressStatus{
+		NetIpConfigInfoIpAddressStatusPreferred,
+		NetIpConfigInfoIpAddressStatusDeprecated,
+		NetIpConfigInfoIpAddressStatusInvalid,
+		NetIpConfigInfoIpAddressStatusInaccessible,
+		NetIpConfigInfoIpAddressStatusUnknown,
+		NetIpConfigInfoIpAddressStatusTentative,
+		NetIpConfigInfoIpAddressStatusDuplicate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetIpConfigInfoIpAddressStatus: %q", s)
+}
+
 func init() {
 	t["NetIpConfigInfoIpAddressStatus"] = reflect.TypeOf((*NetIpConfigInfoIpAddressStatus)(nil)).Elem()
 }
@@ -2734,6 +6495,24 @@ const (
 	NetIpStackInfoEntryTypeManual  = NetIpStackInfoEntryType("manual")
 )
 
+func (e NetIpStackInfoEntryType) String() string {
+	return string(e)
+}
+
+func ParseNetIpStackInfoEntryType(s string) (NetIpStackInfoEntryType, error) {
+	for _, e := range []NetIpStackInfoEntryType{
+		NetIpStackInfoEntryTypeOther,
+		NetIpStackInfoEntryTypeInvalid,
+		NetIpStackInfoEntryTypeDynamic,
+		NetIpStackInfoEntryTypeManual,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetIpStackInfoEntryType: %q", s)
+}
+
 func init() {
 	t["NetIpStackInfoEntryType"] = reflect.TypeOf((*NetIpStackInfoEntryType)(nil)).Elem()
 }
@@ -2747,6 +6526,24 @@ const (
 	NetIpStackInfoPreferenceHigh     = NetIpStackInfoPreference("high")
 )
 
+func (e NetIpStackInfoPreference) String() string {
+	return string(e)
+}
+
+func ParseNetIpStackInfoPreference(s string) (NetIpStackInfoPreference, error) {
+	for _, e := range []NetIpStackInfoPreference{
+		NetIpStackInfoPreferenceReserved,
+		NetIpStackInfoPreferenceLow,
+		NetIpStackInfoPreferenceMedium,
+		NetIpStackInfoPreferenceHigh,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NetIpStackInfoPreference: %q", s)
+}
+
 func init() {
 	t["NetIpStackInfoPreference"] = reflect.TypeOf((*NetIpStackInfoPreference)(nil)).Elem()
 }
@@ -2758,6 +6555,22 @@ const (
 	NotSupportedDeviceForFTDeviceTypeParaVirtualSCSIController = NotSupportedDeviceForFTDeviceType("paraVirtualSCSIController")
 )
 
+func (e NotSupportedDeviceForFTDeviceType) String() string {
+	return string(e)
+}
+
+func ParseNotSupportedDeviceForFTDeviceType(s string) (NotSupportedDeviceForFTDeviceType, error) {
+	for _, e := range []NotSupportedDeviceForFTDeviceType{
+		NotSupportedDeviceForFTDeviceTypeVirtualVmxnet3,
+		NotSupportedDeviceForFTDeviceTypeParaVirtualSCSIController,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NotSupportedDeviceForFTDeviceType: %q", s)
+}
+
 func init() {
 	t["NotSupportedDeviceForFTDeviceType"] = reflect.TypeOf((*NotSupportedDeviceForFTDeviceType)(nil)).Elem()
 }
@@ -2769,6 +6582,22 @@ const (
 	NumVirtualCpusIncompatibleReasonFaultTolerance = NumVirtualCpusIncompatibleReason("faultTolerance")
 )
 
+func (e NumVirtualCpusIncompatibleReason) String() string {
+	return string(e)
+}
+
+func ParseNumVirtualCpusIncompatibleReason(s string) (NumVirtualCpusIncompatibleReason, error) {
+	for _, e := range []NumVirtualCpusIncompatibleReason{
+		NumVirtualCpusIncompatibleReasonRecordReplay,
+		NumVirtualCpusIncompatibleReasonFaultTolerance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NumVirtualCpusIncompatibleReason: %q", s)
+}
+
 func init() {
 	t["NumVirtualCpusIncompatibleReason"] = reflect.TypeOf((*NumVirtualCpusIncompatibleReason)(nil)).Elem()
 }
@@ -2780,6 +6609,22 @@ const (
 	NvdimmInterleaveSetStateActive  = NvdimmInterleaveSetState("active")
 )
 
+func (e NvdimmInterleaveSetState) String() string {
+	return string(e)
+}
+
+func ParseNvdimmInterleaveSetState(s string) (NvdimmInterleaveSetState, error) {
+	for _, e := range []NvdimmInterleaveSetState{
+		NvdimmInterleaveSetStateInvalid,
+		NvdimmInterleaveSetStateActive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmInterleaveSetState: %q", s)
+}
+
 func init() {
 	t["NvdimmInterleaveSetState"] = reflect.TypeOf((*NvdimmInterleaveSetState)(nil)).Elem()
 }
@@ -2794,6 +6639,25 @@ const (
 	NvdimmNamespaceDetailsHealthStatusLabelInconsistent = NvdimmNamespaceDetailsHealthStatus("labelInconsistent")
 )
 
+func (e NvdimmNamespaceDetailsHealthStatus) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNamespaceDetailsHealthStatus(s string) (NvdimmNamespaceDetailsHealthStatus, error) {
+	for _, e := range []NvdimmNamespaceDetailsHealthStatus{
+		NvdimmNamespaceDetailsHealthStatusNormal,
+		NvdimmNamespaceDetailsHealthStatusMissing,
+		NvdimmNamespaceDetailsHealthStatusLabelMissing,
+		NvdimmNamespaceDetailsHealthStatusInterleaveBroken,
+		NvdimmNamespaceDetailsHealthStatusLabelInconsistent,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNamespaceDetailsHealthStatus: %q", s)
+}
+
 func init() {
 	t["NvdimmNamespaceDetailsHealthStatus"] = reflect.TypeOf((*NvdimmNamespaceDetailsHealthStatus)(nil)).Elem()
 }
@@ -2806,6 +6670,23 @@ const (
 	NvdimmNamespaceDetailsStateInUse    = NvdimmNamespaceDetailsState("inUse")
 )
 
+func (e NvdimmNamespaceDetailsState) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNamespaceDetailsState(s string) (NvdimmNamespaceDetailsState, error) {
+	for _, e := range []NvdimmNamespaceDetailsState{
+		NvdimmNamespaceDetailsStateInvalid,
+		NvdimmNamespaceDetailsStateNotInUse,
+		NvdimmNamespaceDetailsStateInUse,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNamespaceDetailsState: %q", s)
+}
+
 func init() {
 	t["NvdimmNamespaceDetailsState"] = reflect.TypeOf((*NvdimmNamespaceDetailsState)(nil)).Elem()
 }
@@ -2822,6 +6703,27 @@ const (
 	NvdimmNamespaceHealthStatusBadBlockSize      = NvdimmNamespaceHealthStatus("badBlockSize")
 )
 
+func (e NvdimmNamespaceHealthStatus) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNamespaceHealthStatus(s string) (NvdimmNamespaceHealthStatus, error) {
+	for _, e := range []NvdimmNamespaceHealthStatus{
+		NvdimmNamespaceHealthStatusNormal,
+		NvdimmNamespaceHealthStatusMissing,
+		NvdimmNamespaceHealthStatusLabelMissing,
+		NvdimmNamespaceHealthStatusInterleaveBroken,
+		NvdimmNamespaceHealthStatusLabelInconsistent,
+		NvdimmNamespaceHealthStatusBttCorrupt,
+		NvdimmNamespaceHealthStatusBadBlockSize,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNamespaceHealthStatus: %q", s)
+}
+
 func init() {
 	t["NvdimmNamespaceHealthStatus"] = reflect.TypeOf((*NvdimmNamespaceHealthStatus)(nil)).Elem()
 }
@@ -2834,6 +6736,23 @@ const (
 	NvdimmNamespaceStateInUse    = NvdimmNamespaceState("inUse")
 )
 
+func (e NvdimmNamespaceState) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNamespaceState(s string) (NvdimmNamespaceState, error) {
+	for _, e := range []NvdimmNamespaceState{
+		NvdimmNamespaceStateInvalid,
+		NvdimmNamespaceStateNotInUse,
+		NvdimmNamespaceStateInUse,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNamespaceState: %q", s)
+}
+
 func init() {
 	t["NvdimmNamespaceState"] = reflect.TypeOf((*NvdimmNamespaceState)(nil)).Elem()
 }
@@ -2845,6 +6764,22 @@ const (
 	NvdimmNamespaceTypePersistentNamespace = NvdimmNamespaceType("persistentNamespace")
 )
 
+func (e NvdimmNamespaceType) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNamespaceType(s string) (NvdimmNamespaceType, error) {
+	for _, e := range []NvdimmNamespaceType{
+		NvdimmNamespaceTypeBlockNamespace,
+		NvdimmNamespaceTypePersistentNamespace,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNamespaceType: %q", s)
+}
+
 func init() {
 	t["NvdimmNamespaceType"] = reflect.TypeOf((*NvdimmNamespaceType)(nil)).Elem()
 }
@@ -2856,6 +6791,22 @@ const (
 	NvdimmNvdimmHealthInfoStateError  = NvdimmNvdimmHealthInfoState("error")
 )
 
+func (e NvdimmNvdimmHealthInfoState) String() string {
+	return string(e)
+}
+
+func ParseNvdimmNvdimmHealthInfoState(s string) (NvdimmNvdimmHealthInfoState, error) {
+	for _, e := range []NvdimmNvdimmHealthInfoState{
+		NvdimmNvdimmHealthInfoStateNormal,
+		NvdimmNvdimmHealthInfoStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmNvdimmHealthInfoState: %q", s)
+}
+
 func init() {
 	t["NvdimmNvdimmHealthInfoState"] = reflect.TypeOf((*NvdimmNvdimmHealthInfoState)(nil)).Elem()
 }
@@ -2873,6 +6824,28 @@ const (
 	NvdimmRangeTypePersistentVirtualCDRange   = NvdimmRangeType("persistentVirtualCDRange")
 )
 
+func (e NvdimmRangeType) String() string {
+	return string(e)
+}
+
+func ParseNvdimmRangeType(s string) (NvdimmRangeType, error) {
+	for _, e := range []NvdimmRangeType{
+		NvdimmRangeTypeVolatileRange,
+		NvdimmRangeTypePersistentRange,
+		NvdimmRangeTypeControlRange,
+		NvdimmRangeTypeBlockRange,
+		NvdimmRangeTypeVolatileVirtualDiskRange,
+		NvdimmRangeTypeVolatileVirtualCDRange,
+		NvdimmRangeTypePersistentVirtualDiskRange,
+		NvdimmRangeTypePersistentVirtualCDRange,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid NvdimmRangeType: %q", s)
+}
+
 func init() {
 	t["NvdimmRangeType"] = reflect.TypeOf((*NvdimmRangeType)(nil)).Elem()
 }
@@ -2885,6 +6858,23 @@ const (
 	ObjectUpdateKindLeave  = ObjectUpdateKind("leave")
 )
 
+func (e ObjectUpdateKind) String() string {
+	return string(e)
+}
+
+func ParseObjectUpdateKind(s string) (ObjectUpdateKind, error) {
+	for _, e := range []ObjectUpdateKind{
+		ObjectUpdateKindModify,
+		ObjectUpdateKindEnter,
+		ObjectUpdateKindLeave,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ObjectUpdateKind: %q", s)
+}
+
 func init() {
 	t["ObjectUpdateKind"] = reflect.TypeOf((*ObjectUpdateKind)(nil)).Elem()
 }
@@ -2897,6 +6887,23 @@ const (
 	OvfConsumerOstNodeTypeVirtualSystemCollection = OvfConsumerOstNodeType("virtualSystemCollection")
 )
 
+func (e OvfConsumerOstNodeType) String() string {
+	return string(e)
+}
+
+func ParseOvfConsumerOstNodeType(s string) (OvfConsumerOstNodeType, error) {
+	for _, e := range []OvfConsumerOstNodeType{
+		OvfConsumerOstNodeTypeEnvelope,
+		OvfConsumerOstNodeTypeVirtualSystem,
+		OvfConsumerOstNodeTypeVirtualSystemCollection,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OvfConsumerOstNodeType: %q", s)
+}
+
 func init() {
 	t["OvfConsumerOstNodeType"] = reflect.TypeOf((*OvfConsumerOstNodeType)(nil)).Elem()
 }
@@ -2916,6 +6923,30 @@ const (
 	OvfCreateImportSpecParamsDiskProvisioningTypeFlat                 = OvfCreateImportSpecParamsDiskProvisioningType("flat")
 )
 
+func (e OvfCreateImportSpecParamsDiskProvisioningType) String() string {
+	return string(e)
+}
+
+func ParseOvfCreateImportSpecParamsDiskProvisioningType(s string) (OvfCreateImportSpecParamsDiskProvisioningType, error) {
+	for _, e := range []OvfCreateImportSpecParamsDiskProvisioningType{
+		OvfCreateImportSpecParamsDiskProvisioningTypeMonolithicSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeMonolithicFlat,
+		OvfCreateImportSpecParamsDiskProvisioningTypeTwoGbMaxExtentSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeTwoGbMaxExtentFlat,
+		OvfCreateImportSpecParamsDiskProvisioningTypeThin,
+		OvfCreateImportSpecParamsDiskProvisioningTypeThick,
+		OvfCreateImportSpecParamsDiskProvisioningTypeSeSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeEagerZeroedThick,
+		OvfCreateImportSpecParamsDiskProvisioningTypeSparse,
+		OvfCreateImportSpecParamsDiskProvisioningTypeFlat,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid OvfCreateImportSpecParamsDiskProvisioningType: %q", s)
+}
+
 func init() {
 	t["OvfCreateImportSpecParamsDiskProvisioningType"] = reflect.TypeOf((*OvfCreateImportSpecParamsDiskProvisioningType)(nil)).Elem()
 }
@@ -2927,6 +6958,22 @@ const (
 	PerfFormatCsv    = PerfFormat("csv")
 )
 
+func (e PerfFormat) String() string {
+	return string(e)
+}
+
+func ParsePerfFormat(s string) (PerfFormat, error) {
+	for _, e := range []PerfFormat{
+		PerfFormatNormal,
+		PerfFormatCsv,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PerfFormat: %q", s)
+}
+
 func init() {
 	t["PerfFormat"] = reflect.TypeOf((*PerfFormat)(nil)).Elem()
 }
@@ -2939,6 +6986,23 @@ const (
 	PerfStatsTypeRate     = PerfStatsType("rate")
 )
 
+func (e PerfStatsType) String() string {
+	return string(e)
+}
+
+func ParsePerfStatsType(s string) (PerfStatsType, error) {
+	for _, e := range []PerfStatsType{
+		PerfStatsTypeAbsolute,
+		PerfStatsTypeDelta,
+		PerfStatsTypeRate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PerfStatsType: %q", s)
+}
+
 func init() {
 	t["PerfStatsType"] = reflect.TypeOf((*PerfStatsType)(nil)).Elem()
 }
@@ -2954,6 +7018,26 @@ const (
 	PerfSummaryTypeNone      = PerfSummaryType("none")
 )
 
+func (e PerfSummaryType) String() string {
+	return string(e)
+}
+
+func ParsePerfSummaryType(s string) (PerfSummaryType, error) {
+	for _, e := range []PerfSummaryType{
+		PerfSummaryTypeAverage,
+		PerfSummaryTypeMaximum,
+		PerfSummaryTypeMinimum,
+		PerfSummaryTypeLatest,
+		PerfSummaryTypeSummation,
+		PerfSummaryTypeNone,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PerfSummaryType: %q", s)
+}
+
 func init() {
 	t["PerfSummaryType"] = reflect.TypeOf((*PerfSummaryType)(nil)).Elem()
 }
@@ -2977,6 +7061,34 @@ const (
 	PerformanceManagerUnitCelsius            = PerformanceManagerUnit("celsius")
 )
 
+func (e PerformanceManagerUnit) String() string {
+	return string(e)
+}
+
+func ParsePerformanceManagerUnit(s string) (PerformanceManagerUnit, error) {
+	for _, e := range []PerformanceManagerUnit{
+		PerformanceManagerUnitPercent,
+		PerformanceManagerUnitKiloBytes,
+		PerformanceManagerUnitMegaBytes,
+		PerformanceManagerUnitMegaHertz,
+		PerformanceManagerUnitNumber,
+		PerformanceManagerUnitMicrosecond,
+		PerformanceManagerUnitMillisecond,
+		PerformanceManagerUnitSecond,
+		PerformanceManagerUnitKiloBytesPerSecond,
+		PerformanceManagerUnitMegaBytesPerSecond,
+		PerformanceManagerUnitWatt,
+		PerformanceManagerUnitJoule,
+		PerformanceManagerUnitTeraBytes,
+		PerformanceManagerUnitCelsius,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PerformanceManagerUnit: %q", s)
+}
+
 func init() {
 	t["PerformanceManagerUnit"] = reflect.TypeOf((*PerformanceManagerUnit)(nil)).Elem()
 }
@@ -2988,6 +7100,22 @@ const (
 	PhysicalNicResourcePoolSchedulerDisallowedReasonHardwareUnsupported = PhysicalNicResourcePoolSchedulerDisallowedReason("hardwareUnsupported")
 )
 
+func (e PhysicalNicResourcePoolSchedulerDisallowedReason) String() string {
+	return string(e)
+}
+
+func ParsePhysicalNicResourcePoolSchedulerDisallowedReason(s string) (PhysicalNicResourcePoolSchedulerDisallowedReason, error) {
+	for _, e := range []PhysicalNicResourcePoolSchedulerDisallowedReason{
+		PhysicalNicResourcePoolSchedulerDisallowedReasonUserOptOut,
+		PhysicalNicResourcePoolSchedulerDisallowedReasonHardwareUnsupported,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PhysicalNicResourcePoolSchedulerDisallowedReason: %q", s)
+}
+
 func init() {
 	t["PhysicalNicResourcePoolSchedulerDisallowedReason"] = reflect.TypeOf((*PhysicalNicResourcePoolSchedulerDisallowedReason)(nil)).Elem()
 }
@@ -2998,6 +7126,21 @@ const (
 	PhysicalNicVmDirectPathGen2SupportedModeUpt = PhysicalNicVmDirectPathGen2SupportedMode("upt")
 )
 
+func (e PhysicalNicVmDirectPathGen2SupportedMode) String() string {
+	return string(e)
+}
+
+func ParsePhysicalNicVmDirectPathGen2SupportedMode(s string) (PhysicalNicVmDirectPathGen2SupportedMode, error) {
+	for _, e := range []PhysicalNicVmDirectPathGen2SupportedMode{
+		PhysicalNicVmDirectPathGen2SupportedModeUpt,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PhysicalNicVmDirectPathGen2SupportedMode: %q", s)
+}
+
 func init() {
 	t["PhysicalNicVmDirectPathGen2SupportedMode"] = reflect.TypeOf((*PhysicalNicVmDirectPathGen2SupportedMode)(nil)).Elem()
 }
@@ -3011,6 +7154,24 @@ const (
 	PlacementAffinityRuleRuleScopeDatastore  = PlacementAffinityRuleRuleScope("datastore")
 )
 
+func (e PlacementAffinityRuleRuleScope) String() string {
+	return string(e)
+}
+
+func ParsePlacementAffinityRuleRuleScope(s string) (PlacementAffinityRuleRuleScope, error) {
+	for _, e := range []PlacementAffinityRuleRuleScope{
+		PlacementAffinityRuleRuleScopeCluster,
+		PlacementAffinityRuleRuleScopeHost,
+		PlacementAffinityRuleRuleScopeStoragePod,
+		PlacementAffinityRuleRuleScopeDatastore,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlacementAffinityRuleRuleScope: %q", s)
+}
+
 func init() {
 	t["PlacementAffinityRuleRuleScope"] = reflect.TypeOf((*PlacementAffinityRuleRuleScope)(nil)).Elem()
 }
@@ -3024,6 +7185,24 @@ const (
 	PlacementAffinityRuleRuleTypeSoftAntiAffinity = PlacementAffinityRuleRuleType("softAntiAffinity")
 )
 
+func (e PlacementAffinityRuleRuleType) String() string {
+	return string(e)
+}
+
+func ParsePlacementAffinityRuleRuleType(s string) (PlacementAffinityRuleRuleType, error) {
+	for _, e := range []PlacementAffinityRuleRuleType{
+		PlacementAffinityRuleRuleTypeAffinity,
+		PlacementAffinityRuleRuleTypeAntiAffinity,
+		PlacementAffinityRuleRuleTypeSoftAffinity,
+		PlacementAffinityRuleRuleTypeSoftAntiAffinity,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlacementAffinityRuleRuleType: %q", s)
+}
+
 func init() {
 	t["PlacementAffinityRuleRuleType"] = reflect.TypeOf((*PlacementAffinityRuleRuleType)(nil)).Elem()
 }
@@ -3037,6 +7216,24 @@ const (
 	PlacementSpecPlacementTypeClone       = PlacementSpecPlacementType("clone")
 )
 
+func (e PlacementSpecPlacementType) String() string {
+	return string(e)
+}
+
+func ParsePlacementSpecPlacementType(s string) (PlacementSpecPlacementType, error) {
+	for _, e := range []PlacementSpecPlacementType{
+		PlacementSpecPlacementTypeCreate,
+		PlacementSpecPlacementTypeReconfigure,
+		PlacementSpecPlacementTypeRelocate,
+		PlacementSpecPlacementTypeClone,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PlacementSpecPlacementType: %q", s)
+}
+
 func init() {
 	t["PlacementSpecPlacementType"] = reflect.TypeOf((*PlacementSpecPlacementType)(nil)).Elem()
 }
@@ -3050,6 +7247,24 @@ const (
 	PortGroupConnecteeTypeUnknown          = PortGroupConnecteeType("unknown")
 )
 
+func (e PortGroupConnecteeType) String() string {
+	return string(e)
+}
+
+func ParsePortGroupConnecteeType(s string) (PortGroupConnecteeType, error) {
+	for _, e := range []PortGroupConnecteeType{
+		PortGroupConnecteeTypeVirtualMachine,
+		PortGroupConnecteeTypeSystemManagement,
+		PortGroupConnecteeTypeHost,
+		PortGroupConnecteeTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PortGroupConnecteeType: %q", s)
+}
+
 func init() {
 	t["PortGroupConnecteeType"] = reflect.TypeOf((*PortGroupConnecteeType)(nil)).Elem()
 }
@@ -3062,6 +7277,23 @@ const (
 	ProfileExecuteResultStatusError     = ProfileExecuteResultStatus("error")
 )
 
+func (e ProfileExecuteResultStatus) String() string {
+	return string(e)
+}
+
+func ParseProfileExecuteResultStatus(s string) (ProfileExecuteResultStatus, error) {
+	for _, e := range []ProfileExecuteResultStatus{
+		ProfileExecuteResultStatusSuccess,
+		ProfileExecuteResultStatusNeedInput,
+		ProfileExecuteResultStatusError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProfileExecuteResultStatus: %q", s)
+}
+
 func init() {
 	t["ProfileExecuteResultStatus"] = reflect.TypeOf((*ProfileExecuteResultStatus)(nil)).Elem()
 }
@@ -3077,6 +7309,26 @@ const (
 	ProfileNumericComparatorGreaterThan      = ProfileNumericComparator("greaterThan")
 )
 
+func (e ProfileNumericComparator) String() string {
+	return string(e)
+}
+
+func ParseProfileNumericComparator(s string) (ProfileNumericComparator, error) {
+	for _, e := range []ProfileNumericComparator{
+		ProfileNumericComparatorLessThan,
+		ProfileNumericComparatorLessThanEqual,
+		ProfileNumericComparatorEqual,
+		ProfileNumericComparatorNotEqual,
+		ProfileNumericComparatorGreaterThanEqual,
+		ProfileNumericComparatorGreaterThan,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProfileNumericComparator: %q", s)
+}
+
 func init() {
 	t["ProfileNumericComparator"] = reflect.TypeOf((*ProfileNumericComparator)(nil)).Elem()
 }
@@ -3091,6 +7343,25 @@ const (
 	ProfileParameterMetadataRelationTypeValidation_relation  = ProfileParameterMetadataRelationType("validation_relation")
 )
 
+func (e ProfileParameterMetadataRelationType) String() string {
+	return string(e)
+}
+
+func ParseProfileParameterMetadataRelationType(s string) (ProfileParameterMetadataRelationType, error) {
+	for _, e := range []ProfileParameterMetadataRelationType{
+		ProfileParameterMetadataRelationTypeDynamic_relation,
+		ProfileParameterMetadataRelationTypeExtensible_relation,
+		ProfileParameterMetadataRelationTypeLocalizable_relation,
+		ProfileParameterMetadataRelationTypeStatic_relation,
+		ProfileParameterMetadataRelationTypeValidation_relation,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ProfileParameterMetadataRelationType: %q", s)
+}
+
 func init() {
 	t["ProfileParameterMetadataRelationType"] = reflect.TypeOf((*ProfileParameterMetadataRelationType)(nil)).Elem()
 }
@@ -3104,6 +7375,24 @@ const (
 	PropertyChangeOpIndirectRemove = PropertyChangeOp("indirectRemove")
 )
 
+func (e PropertyChangeOp) String() string {
+	return string(e)
+}
+
+func ParsePropertyChangeOp(s string) (PropertyChangeOp, error) {
+	for _, e := range []PropertyChangeOp{
+		PropertyChangeOpAdd,
+		PropertyChangeOpRemove,
+		PropertyChangeOpAssign,
+		PropertyChangeOpIndirectRemove,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid PropertyChangeOp: %q", s)
+}
+
 func init() {
 	t["PropertyChangeOp"] = reflect.TypeOf((*PropertyChangeOp)(nil)).Elem()
 }
@@ -3116,6 +7405,23 @@ const (
 	QuarantineModeFaultFaultTypeCorrectionImpact               = QuarantineModeFaultFaultType("CorrectionImpact")
 )
 
+func (e QuarantineModeFaultFaultType) String() string {
+	return string(e)
+}
+
+func ParseQuarantineModeFaultFaultType(s string) (QuarantineModeFaultFaultType, error) {
+	for _, e := range []QuarantineModeFaultFaultType{
+		QuarantineModeFaultFaultTypeNoCompatibleNonQuarantinedHost,
+		QuarantineModeFaultFaultTypeCorrectionDisallowed,
+		QuarantineModeFaultFaultTypeCorrectionImpact,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid QuarantineModeFaultFaultType: %q", s)
+}
+
 func init() {
 	t["QuarantineModeFaultFaultType"] = reflect.TypeOf((*QuarantineModeFaultFaultType)(nil)).Elem()
 }
@@ -3128,6 +7434,23 @@ const (
 	QuiesceModeNone        = QuiesceMode("none")
 )
 
+func (e QuiesceMode) String() string {
+	return string(e)
+}
+
+func ParseQuiesceMode(s string) (QuiesceMode, error) {
+	for _, e := range []QuiesceMode{
+		QuiesceModeApplication,
+		QuiesceModeFilesystem,
+		QuiesceModeNone,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid QuiesceMode: %q", s)
+}
+
 func init() {
 	t["QuiesceMode"] = reflect.TypeOf((*QuiesceMode)(nil)).Elem()
 }
@@ -3171,6 +7494,54 @@ const (
 	RecommendationReasonCodeBalanceVsanUsage                = RecommendationReasonCode("balanceVsanUsage")
 )
 
+func (e RecommendationReasonCode) String() string {
+	return string(e)
+}
+
+func ParseRecommendationReasonCode(s string) (RecommendationReasonCode, error) {
+	for _, e := range []RecommendationReasonCode{
+		RecommendationReasonCodeFairnessCpuAvg,
+		RecommendationReasonCodeFairnessMemAvg,
+		RecommendationReasonCodeJointAffin,
+		RecommendationReasonCodeAntiAffin,
+		RecommendationReasonCodeHostMaint,
+		RecommendationReasonCodeEnterStandby,
+		RecommendationReasonCodeReservationCpu,
+		RecommendationReasonCodeReservationMem,
+		RecommendationReasonCodePowerOnVm,
+		RecommendationReasonCodePowerSaving,
+		RecommendationReasonCodeIncreaseCapacity,
+		RecommendationReasonCodeCheckResource,
+		RecommendationReasonCodeUnreservedCapacity,
+		RecommendationReasonCodeVmHostHardAffinity,
+		RecommendationReasonCodeVmHostSoftAffinity,
+		RecommendationReasonCodeBalanceDatastoreSpaceUsage,
+		RecommendationReasonCodeBalanceDatastoreIOLoad,
+		RecommendationReasonCodeBalanceDatastoreIOPSReservation,
+		RecommendationReasonCodeDatastoreMaint,
+		RecommendationReasonCodeVirtualDiskJointAffin,
+		RecommendationReasonCodeVirtualDiskAntiAffin,
+		RecommendationReasonCodeDatastoreSpaceOutage,
+		RecommendationReasonCodeStoragePlacement,
+		RecommendationReasonCodeIolbDisabledInternal,
+		RecommendationReasonCodeXvmotionPlacement,
+		RecommendationReasonCodeNetworkBandwidthReservation,
+		RecommendationReasonCodeHostInDegradation,
+		RecommendationReasonCodeHostExitDegradation,
+		RecommendationReasonCodeMaxVmsConstraint,
+		RecommendationReasonCodeFtConstraints,
+		RecommendationReasonCodeVmHostAffinityPolicy,
+		RecommendationReasonCodeVmHostAntiAffinityPolicy,
+		RecommendationReasonCodeVmAntiAffinityPolicy,
+		RecommendationReasonCodeBalanceVsanUsage,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RecommendationReasonCode: %q", s)
+}
+
 func init() {
 	t["RecommendationReasonCode"] = reflect.TypeOf((*RecommendationReasonCode)(nil)).Elem()
 }
@@ -3181,6 +7552,21 @@ const (
 	RecommendationTypeV1 = RecommendationType("V1")
 )
 
+func (e RecommendationType) String() string {
+	return string(e)
+}
+
+func ParseRecommendationType(s string) (RecommendationType, error) {
+	for _, e := range []RecommendationType{
+		RecommendationTypeV1,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid RecommendationType: %q", s)
+}
+
 func init() {
 	t["RecommendationType"] = reflect.TypeOf((*RecommendationType)(nil)).Elem()
 }
@@ -3197,6 +7583,27 @@ const (
 	ReplicationDiskConfigFaultReasonForFaultReconfigureDiskReplicationIdNotAllowed = ReplicationDiskConfigFaultReasonForFault("reconfigureDiskReplicationIdNotAllowed")
 )
 
+func (e ReplicationDiskConfigFaultReasonForFault) String() string {
+	return string(e)
+}
+
+func ParseReplicationDiskConfigFaultReasonForFault(s string) (ReplicationDiskConfigFaultReasonForFault, error) {
+	for _, e := range []ReplicationDiskConfigFaultReasonForFault{
+		ReplicationDiskConfigFaultReasonForFaultDiskNotFound,
+		ReplicationDiskConfigFaultReasonForFaultDiskTypeNotSupported,
+		ReplicationDiskConfigFaultReasonForFaultInvalidDiskKey,
+		ReplicationDiskConfigFaultReasonForFaultInvalidDiskReplicationId,
+		ReplicationDiskConfigFaultReasonForFaultDuplicateDiskReplicationId,
+		ReplicationDiskConfigFaultReasonForFaultInvalidPersistentFilePath,
+		ReplicationDiskConfigFaultReasonForFaultReconfigureDiskReplicationIdNotAllowed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationDiskConfigFaultReasonForFault: %q", s)
+}
+
 func init() {
 	t["ReplicationDiskConfigFaultReasonForFault"] = reflect.TypeOf((*ReplicationDiskConfigFaultReasonForFault)(nil)).Elem()
 }
@@ -3223,6 +7630,37 @@ const (
 	ReplicationVmConfigFaultReasonForFaultIncompatibleDevice                       = ReplicationVmConfigFaultReasonForFault("incompatibleDevice")
 )
 
+func (e ReplicationVmConfigFaultReasonForFault) String() string {
+	return string(e)
+}
+
+func ParseReplicationVmConfigFaultReasonForFault(s string) (ReplicationVmConfigFaultReasonForFault, error) {
+	for _, e := range []ReplicationVmConfigFaultReasonForFault{
+		ReplicationVmConfigFaultReasonForFaultIncompatibleHwVersion,
+		ReplicationVmConfigFaultReasonForFaultInvalidVmReplicationId,
+		ReplicationVmConfigFaultReasonForFaultInvalidGenerationNumber,
+		ReplicationVmConfigFaultReasonForFaultOutOfBoundsRpoValue,
+		ReplicationVmConfigFaultReasonForFaultInvalidDestinationIpAddress,
+		ReplicationVmConfigFaultReasonForFaultInvalidDestinationPort,
+		ReplicationVmConfigFaultReasonForFaultInvalidExtraVmOptions,
+		ReplicationVmConfigFaultReasonForFaultStaleGenerationNumber,
+		ReplicationVmConfigFaultReasonForFaultReconfigureVmReplicationIdNotAllowed,
+		ReplicationVmConfigFaultReasonForFaultCannotRetrieveVmReplicationConfiguration,
+		ReplicationVmConfigFaultReasonForFaultReplicationAlreadyEnabled,
+		ReplicationVmConfigFaultReasonForFaultInvalidPriorConfiguration,
+		ReplicationVmConfigFaultReasonForFaultReplicationNotEnabled,
+		ReplicationVmConfigFaultReasonForFaultReplicationConfigurationFailed,
+		ReplicationVmConfigFaultReasonForFaultEncryptedVm,
+		ReplicationVmConfigFaultReasonForFaultInvalidThumbprint,
+		ReplicationVmConfigFaultReasonForFaultIncompatibleDevice,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationVmConfigFaultReasonForFault: %q", s)
+}
+
 func init() {
 	t["ReplicationVmConfigFaultReasonForFault"] = reflect.TypeOf((*ReplicationVmConfigFaultReasonForFault)(nil)).Elem()
 }
@@ -3241,6 +7679,29 @@ const (
 	ReplicationVmFaultReasonForFaultGroupExist         = ReplicationVmFaultReasonForFault("groupExist")
 )
 
+func (e ReplicationVmFaultReasonForFault) String() string {
+	return string(e)
+}
+
+func ParseReplicationVmFaultReasonForFault(s string) (ReplicationVmFaultReasonForFault, error) {
+	for _, e := range []ReplicationVmFaultReasonForFault{
+		ReplicationVmFaultReasonForFaultNotConfigured,
+		ReplicationVmFaultReasonForFaultPoweredOff,
+		ReplicationVmFaultReasonForFaultSuspended,
+		ReplicationVmFaultReasonForFaultPoweredOn,
+		ReplicationVmFaultReasonForFaultOfflineReplicating,
+		ReplicationVmFaultReasonForFaultInvalidState,
+		ReplicationVmFaultReasonForFaultInvalidInstanceId,
+		ReplicationVmFaultReasonForFaultCloseDiskError,
+		ReplicationVmFaultReasonForFaultGroupExist,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationVmFaultReasonForFault: %q", s)
+}
+
 func init() {
 	t["ReplicationVmFaultReasonForFault"] = reflect.TypeOf((*ReplicationVmFaultReasonForFault)(nil)).Elem()
 }
@@ -3252,6 +7713,22 @@ const (
 	ReplicationVmInProgressFaultActivityDelta    = ReplicationVmInProgressFaultActivity("delta")
 )
 
+func (e ReplicationVmInProgressFaultActivity) String() string {
+	return string(e)
+}
+
+func ParseReplicationVmInProgressFaultActivity(s string) (ReplicationVmInProgressFaultActivity, error) {
+	for _, e := range []ReplicationVmInProgressFaultActivity{
+		ReplicationVmInProgressFaultActivityFullSync,
+		ReplicationVmInProgressFaultActivityDelta,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationVmInProgressFaultActivity: %q", s)
+}
+
 func init() {
 	t["ReplicationVmInProgressFaultActivity"] = reflect.TypeOf((*ReplicationVmInProgressFaultActivity)(nil)).Elem()
 }
@@ -3267,6 +7744,26 @@ const (
 	ReplicationVmStateError   = ReplicationVmState("error")
 )
 
+func (e ReplicationVmState) String() string {
+	return string(e)
+}
+
+func ParseReplicationVmState(s string) (ReplicationVmState, error) {
+	for _, e := range []ReplicationVmState{
+		ReplicationVmStateNone,
+		ReplicationVmStatePaused,
+		ReplicationVmStateSyncing,
+		ReplicationVmStateIdle,
+		ReplicationVmStateActive,
+		ReplicationVmStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ReplicationVmState: %q", s)
+}
+
 func init() {
 	t["ReplicationVmState"] = reflect.TypeOf((*ReplicationVmState)(nil)).Elem()
 }
@@ -3278,6 +7775,22 @@ const (
 	ResourceConfigSpecScaleSharesBehaviorScaleCpuAndMemoryShares = ResourceConfigSpecScaleSharesBehavior("scaleCpuAndMemoryShares")
 )
 
+func (e ResourceConfigSpecScaleSharesBehavior) String() string {
+	return string(e)
+}
+
+func ParseResourceConfigSpecScaleSharesBehavior(s string) (ResourceConfigSpecScaleSharesBehavior, error) {
+	for _, e := range []ResourceConfigSpecScaleSharesBehavior{
+		ResourceConfigSpecScaleSharesBehaviorDisabled,
+		ResourceConfigSpecScaleSharesBehaviorScaleCpuAndMemoryShares,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ResourceConfigSpecScaleSharesBehavior: %q", s)
+}
+
 func init() {
 	t["ResourceConfigSpecScaleSharesBehavior"] = reflect.TypeOf((*ResourceConfigSpecScaleSharesBehavior)(nil)).Elem()
 }
@@ -3290,6 +7803,23 @@ const (
 	ScheduledHardwareUpgradeInfoHardwareUpgradePolicyAlways         = ScheduledHardwareUpgradeInfoHardwareUpgradePolicy("always")
 )
 
+func (e ScheduledHardwareUpgradeInfoHardwareUpgradePolicy) String() string {
+	return string(e)
+}
+
+func ParseScheduledHardwareUpgradeInfoHardwareUpgradePolicy(s string) (ScheduledHardwareUpgradeInfoHardwareUpgradePolicy, error) {
+	for _, e := range []ScheduledHardwareUpgradeInfoHardwareUpgradePolicy{
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyNever,
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyOnSoftPowerOff,
+		ScheduledHardwareUpgradeInfoHardwareUpgradePolicyAlways,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScheduledHardwareUpgradeInfoHardwareUpgradePolicy: %q", s)
+}
+
 func init() {
 	t["ScheduledHardwareUpgradeInfoHardwareUpgradePolicy"] = reflect.TypeOf((*ScheduledHardwareUpgradeInfoHardwareUpgradePolicy)(nil)).Elem()
 }
@@ -3303,6 +7833,24 @@ const (
 	ScheduledHardwareUpgradeInfoHardwareUpgradeStatusFailed  = ScheduledHardwareUpgradeInfoHardwareUpgradeStatus("failed")
 )
 
+func (e ScheduledHardwareUpgradeInfoHardwareUpgradeStatus) String() string {
+	return string(e)
+}
+
+func ParseScheduledHardwareUpgradeInfoHardwareUpgradeStatus(s string) (ScheduledHardwareUpgradeInfoHardwareUpgradeStatus, error) {
+	for _, e := range []ScheduledHardwareUpgradeInfoHardwareUpgradeStatus{
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusNone,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusPending,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusSuccess,
+		ScheduledHardwareUpgradeInfoHardwareUpgradeStatusFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScheduledHardwareUpgradeInfoHardwareUpgradeStatus: %q", s)
+}
+
 func init() {
 	t["ScheduledHardwareUpgradeInfoHardwareUpgradeStatus"] = reflect.TypeOf((*ScheduledHardwareUpgradeInfoHardwareUpgradeStatus)(nil)).Elem()
 }
@@ -3317,6 +7865,25 @@ const (
 	ScsiDiskTypeUnknown            = ScsiDiskType("unknown")
 )
 
+func (e ScsiDiskType) String() string {
+	return string(e)
+}
+
+func ParseScsiDiskType(s string) (ScsiDiskType, error) {
+	for _, e := range []ScsiDiskType{
+		ScsiDiskTypeNative512,
+		ScsiDiskTypeEmulated512,
+		ScsiDiskTypeNative4k,
+		ScsiDiskTypeSoftwareEmulated4k,
+		ScsiDiskTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScsiDiskType: %q", s)
+}
+
 func init() {
 	t["ScsiDiskType"] = reflect.TypeOf((*ScsiDiskType)(nil)).Elem()
 }
@@ -3330,6 +7897,24 @@ const (
 	ScsiLunDescriptorQualityUnknownQuality = ScsiLunDescriptorQuality("unknownQuality")
 )
 
+func (e ScsiLunDescriptorQuality) String() string {
+	return string(e)
+}
+
+func ParseScsiLunDescriptorQuality(s string) (ScsiLunDescriptorQuality, error) {
+	for _, e := range []ScsiLunDescriptorQuality{
+		ScsiLunDescriptorQualityHighQuality,
+		ScsiLunDescriptorQualityMediumQuality,
+		ScsiLunDescriptorQualityLowQuality,
+		ScsiLunDescriptorQualityUnknownQuality,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScsiLunDescriptorQuality: %q", s)
+}
+
 func init() {
 	t["ScsiLunDescriptorQuality"] = reflect.TypeOf((*ScsiLunDescriptorQuality)(nil)).Elem()
 }
@@ -3347,6 +7932,28 @@ const (
 	ScsiLunStateTimeout           = ScsiLunState("timeout")
 )
 
+func (e ScsiLunState) String() string {
+	return string(e)
+}
+
+func ParseScsiLunState(s string) (ScsiLunState, error) {
+	for _, e := range []ScsiLunState{
+		ScsiLunStateUnknownState,
+		ScsiLunStateOk,
+		ScsiLunStateError,
+		ScsiLunStateOff,
+		ScsiLunStateQuiesced,
+		ScsiLunStateDegraded,
+		ScsiLunStateLostCommunication,
+		ScsiLunStateTimeout,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScsiLunState: %q", s)
+}
+
 func init() {
 	t["ScsiLunState"] = reflect.TypeOf((*ScsiLunState)(nil)).Elem()
 }
@@ -3369,6 +7976,33 @@ const (
 	ScsiLunTypeUnknown                = ScsiLunType("unknown")
 )
 
+func (e ScsiLunType) String() string {
+	return string(e)
+}
+
+func ParseScsiLunType(s string) (ScsiLunType, error) {
+	for _, e := range []ScsiLunType{
+		ScsiLunTypeDisk,
+		ScsiLunTypeTape,
+		ScsiLunTypePrinter,
+		ScsiLunTypeProcessor,
+		ScsiLunTypeWorm,
+		ScsiLunTypeCdrom,
+		ScsiLunTypeScanner,
+		ScsiLunTypeOpticalDevice,
+		ScsiLunTypeMediaChanger,
+		ScsiLunTypeCommunications,
+		ScsiLunTypeStorageArrayController,
+		ScsiLunTypeEnclosure,
+		ScsiLunTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScsiLunType: %q", s)
+}
+
 func init() {
 	t["ScsiLunType"] = reflect.TypeOf((*ScsiLunType)(nil)).Elem()
 }
@@ -3381,6 +8015,23 @@ const (
 	ScsiLunVStorageSupportStatusVStorageUnknown     = ScsiLunVStorageSupportStatus("vStorageUnknown")
 )
 
+func (e ScsiLunVStorageSupportStatus) String() string {
+	return string(e)
+}
+
+func ParseScsiLunVStorageSupportStatus(s string) (ScsiLunVStorageSupportStatus, error) {
+	for _, e := range []ScsiLunVStorageSupportStatus{
+		ScsiLunVStorageSupportStatusVStorageSupported,
+		ScsiLunVStorageSupportStatusVStorageUnsupported,
+		ScsiLunVStorageSupportStatusVStorageUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ScsiLunVStorageSupportStatus: %q", s)
+}
+
 func init() {
 	t["ScsiLunVStorageSupportStatus"] = reflect.TypeOf((*ScsiLunVStorageSupportStatus)(nil)).Elem()
 }
@@ -3393,6 +8044,23 @@ const (
 	SessionManagerGenericServiceTicketTicketTypeVcServiceTicket      = SessionManagerGenericServiceTicketTicketType("VcServiceTicket")
 )
 
+func (e SessionManagerGenericServiceTicketTicketType) String() string {
+	return string(e)
+}
+
+func ParseSessionManagerGenericServiceTicketTicketType(s string) (SessionManagerGenericServiceTicketTicketType, error) {
+	for _, e := range []SessionManagerGenericServiceTicketTicketType{
+		SessionManagerGenericServiceTicketTicketTypeHttpNfcServiceTicket,
+		SessionManagerGenericServiceTicketTicketTypeHostServiceTicket,
+		SessionManagerGenericServiceTicketTicketTypeVcServiceTicket,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SessionManagerGenericServiceTicketTicketType: %q", s)
+}
+
 func init() {
 	t["SessionManagerGenericServiceTicketTicketType"] = reflect.TypeOf((*SessionManagerGenericServiceTicketTicketType)(nil)).Elem()
 }
@@ -3410,6 +8078,28 @@ const (
 	SessionManagerHttpServiceRequestSpecMethodHttpConnect = SessionManagerHttpServiceRequestSpecMethod("httpConnect")
 )
 
+func (e SessionManagerHttpServiceRequestSpecMethod) String() string {
+	return string(e)
+}
+
+func ParseSessionManagerHttpServiceRequestSpecMethod(s string) (SessionManagerHttpServiceRequestSpecMethod, error) {
+	for _, e := range []SessionManagerHttpServiceRequestSpecMethod{
+		SessionManagerHttpServiceRequestSpecMethodHttpOptions,
+		SessionManagerHttpServiceRequestSpecMethodHttpGet,
+		SessionManagerHttpServiceRequestSpecMethodHttpHead,
+		SessionManagerHttpServiceRequestSpecMethodHttpPost,
+		SessionManagerHttpServiceRequestSpecMethodHttpPut,
+		SessionManagerHttpServiceRequestSpecMethodHttpDelete,
+		SessionManagerHttpServiceRequestSpecMethodHttpTrace,
+		SessionManagerHttpServiceRequestSpecMethodHttpConnect,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SessionManagerHttpServiceRequestSpecMethod: %q", s)
+}
+
 func init() {
 	t["SessionManagerHttpServiceRequestSpecMethod"] = reflect.TypeOf((*SessionManagerHttpServiceRequestSpecMethod)(nil)).Elem()
 }
@@ -3423,6 +8113,24 @@ const (
 	SharesLevelCustom = SharesLevel("custom")
 )
 
+func (e SharesLevel) String() string {
+	return string(e)
+}
+
+func ParseSharesLevel(s string) (SharesLevel, error) {
+	for _, e := range []SharesLevel{
+		SharesLevelLow,
+		SharesLevelNormal,
+		SharesLevelHigh,
+		SharesLevelCustom,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SharesLevel: %q", s)
+}
+
 func init() {
 	t["SharesLevel"] = reflect.TypeOf((*SharesLevel)(nil)).Elem()
 }
@@ -3435,6 +8143,23 @@ const (
 	SimpleCommandEncodingSTRING = SimpleCommandEncoding("STRING")
 )
 
+func (e SimpleCommandEncoding) String() string {
+	return string(e)
+}
+
+func ParseSimpleCommandEncoding(s string) (SimpleCommandEncoding, error) {
+	for _, e := range []SimpleCommandEncoding{
+		SimpleCommandEncodingCSV,
+		SimpleCommandEncodingHEX,
+		SimpleCommandEncodingSTRING,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SimpleCommandEncoding: %q", s)
+}
+
 func init() {
 	t["SimpleCommandEncoding"] = reflect.TypeOf((*SimpleCommandEncoding)(nil)).Elem()
 }
@@ -3448,6 +8173,24 @@ const (
 	SlpDiscoveryMethodSlpManual        = SlpDiscoveryMethod("slpManual")
 )
 
+func (e SlpDiscoveryMethod) String() string {
+	return string(e)
+}
+
+func ParseSlpDiscoveryMethod(s string) (SlpDiscoveryMethod, error) {
+	for _, e := range []SlpDiscoveryMethod{
+		SlpDiscoveryMethodSlpDhcp,
+		SlpDiscoveryMethodSlpAutoUnicast,
+		SlpDiscoveryMethodSlpAutoMulticast,
+		SlpDiscoveryMethodSlpManual,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SlpDiscoveryMethod: %q", s)
+}
+
 func init() {
 	t["SlpDiscoveryMethod"] = reflect.TypeOf((*SlpDiscoveryMethod)(nil)).Elem()
 }
@@ -3462,6 +8205,25 @@ const (
 	SoftwarePackageConstraintGreaterThan      = SoftwarePackageConstraint("greaterThan")
 )
 
+func (e SoftwarePackageConstraint) String() string {
+	return string(e)
+}
+
+func ParseSoftwarePackageConstraint(s string) (SoftwarePackageConstraint, error) {
+	for _, e := range []SoftwarePackageConstraint{
+		SoftwarePackageConstraintEquals,
+		SoftwarePackageConstraintLessThan,
+		SoftwarePackageConstraintLessThanEqual,
+		SoftwarePackageConstraintGreaterThanEqual,
+		SoftwarePackageConstraintGreaterThan,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SoftwarePackageConstraint: %q", s)
+}
+
 func init() {
 	t["SoftwarePackageConstraint"] = reflect.TypeOf((*SoftwarePackageConstraint)(nil)).Elem()
 }
@@ -3474,6 +8236,23 @@ const (
 	SoftwarePackageVibTypeMeta     = SoftwarePackageVibType("meta")
 )
 
+func (e SoftwarePackageVibType) String() string {
+	return string(e)
+}
+
+func ParseSoftwarePackageVibType(s string) (SoftwarePackageVibType, error) {
+	for _, e := range []SoftwarePackageVibType{
+		SoftwarePackageVibTypeBootbank,
+		SoftwarePackageVibTypeTools,
+		SoftwarePackageVibTypeMeta,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid SoftwarePackageVibType: %q", s)
+}
+
 func init() {
 	t["SoftwarePackageVibType"] = reflect.TypeOf((*SoftwarePackageVibType)(nil)).Elem()
 }
@@ -3485,6 +8264,22 @@ const (
 	StateAlarmOperatorIsUnequal = StateAlarmOperator("isUnequal")
 )
 
+func (e StateAlarmOperator) String() string {
+	return string(e)
+}
+
+func ParseStateAlarmOperator(s string) (StateAlarmOperator, error) {
+	for _, e := range []StateAlarmOperator{
+		StateAlarmOperatorIsEqual,
+		StateAlarmOperatorIsUnequal,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StateAlarmOperator: %q", s)
+}
+
 func init() {
 	t["StateAlarmOperator"] = reflect.TypeOf((*StateAlarmOperator)(nil)).Elem()
 }
@@ -3496,6 +8291,22 @@ const (
 	StorageDrsPodConfigInfoBehaviorAutomated = StorageDrsPodConfigInfoBehavior("automated")
 )
 
+func (e StorageDrsPodConfigInfoBehavior) String() string {
+	return string(e)
+}
+
+func ParseStorageDrsPodConfigInfoBehavior(s string) (StorageDrsPodConfigInfoBehavior, error) {
+	for _, e := range []StorageDrsPodConfigInfoBehavior{
+		StorageDrsPodConfigInfoBehaviorManual,
+		StorageDrsPodConfigInfoBehaviorAutomated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StorageDrsPodConfigInfoBehavior: %q", s)
+}
+
 func init() {
 	t["StorageDrsPodConfigInfoBehavior"] = reflect.TypeOf((*StorageDrsPodConfigInfoBehavior)(nil)).Elem()
 }
@@ -3507,6 +8318,22 @@ const (
 	StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeFreeSpace   = StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode("freeSpace")
 )
 
+func (e StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode) String() string {
+	return string(e)
+}
+
+func ParseStorageDrsSpaceLoadBalanceConfigSpaceThresholdMode(s string) (StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode, error) {
+	for _, e := range []StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode{
+		StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeUtilization,
+		StorageDrsSpaceLoadBalanceConfigSpaceThresholdModeFreeSpace,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode: %q", s)
+}
+
 func init() {
 	t["StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode"] = reflect.TypeOf((*StorageDrsSpaceLoadBalanceConfigSpaceThresholdMode)(nil)).Elem()
 }
@@ -3518,6 +8345,22 @@ const (
 	StorageIORMThresholdModeManual    = StorageIORMThresholdMode("manual")
 )
 
+func (e StorageIORMThresholdMode) String() string {
+	return string(e)
+}
+
+func ParseStorageIORMThresholdMode(s string) (StorageIORMThresholdMode, error) {
+	for _, e := range []StorageIORMThresholdMode{
+		StorageIORMThresholdModeAutomatic,
+		StorageIORMThresholdModeManual,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StorageIORMThresholdMode: %q", s)
+}
+
 func init() {
 	t["StorageIORMThresholdMode"] = reflect.TypeOf((*StorageIORMThresholdMode)(nil)).Elem()
 }
@@ -3531,6 +8374,24 @@ const (
 	StoragePlacementSpecPlacementTypeClone       = StoragePlacementSpecPlacementType("clone")
 )
 
+func (e StoragePlacementSpecPlacementType) String() string {
+	return string(e)
+}
+
+func ParseStoragePlacementSpecPlacementType(s string) (StoragePlacementSpecPlacementType, error) {
+	for _, e := range []StoragePlacementSpecPlacementType{
+		StoragePlacementSpecPlacementTypeCreate,
+		StoragePlacementSpecPlacementTypeReconfigure,
+		StoragePlacementSpecPlacementTypeRelocate,
+		StoragePlacementSpecPlacementTypeClone,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid StoragePlacementSpecPlacementType: %q", s)
+}
+
 func init() {
 	t["StoragePlacementSpecPlacementType"] = reflect.TypeOf((*StoragePlacementSpecPlacementType)(nil)).Elem()
 }
@@ -3543,6 +8404,23 @@ const (
 	TaskFilterSpecRecursionOptionAll      = TaskFilterSpecRecursionOption("all")
 )
 
+func (e TaskFilterSpecRecursionOption) String() string {
+	return string(e)
+}
+
+func ParseTaskFilterSpecRecursionOption(s string) (TaskFilterSpecRecursionOption, error) {
+	for _, e := range []TaskFilterSpecRecursionOption{
+		TaskFilterSpecRecursionOptionSelf,
+		TaskFilterSpecRecursionOptionChildren,
+		TaskFilterSpecRecursionOptionAll,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TaskFilterSpecRecursionOption: %q", s)
+}
+
 func init() {
 	t["TaskFilterSpecRecursionOption"] = reflect.TypeOf((*TaskFilterSpecRecursionOption)(nil)).Elem()
 }
@@ -3555,6 +8433,23 @@ const (
 	TaskFilterSpecTimeOptionCompletedTime = TaskFilterSpecTimeOption("completedTime")
 )
 
+func (e TaskFilterSpecTimeOption) String() string {
+	return string(e)
+}
+
+func ParseTaskFilterSpecTimeOption(s string) (TaskFilterSpecTimeOption, error) {
+	for _, e := range []TaskFilterSpecTimeOption{
+		TaskFilterSpecTimeOptionQueuedTime,
+		TaskFilterSpecTimeOptionStartedTime,
+		TaskFilterSpecTimeOptionCompletedTime,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TaskFilterSpecTimeOption: %q", s)
+}
+
 func init() {
 	t["TaskFilterSpecTimeOption"] = reflect.TypeOf((*TaskFilterSpecTimeOption)(nil)).Elem()
 }
@@ -3568,6 +8463,24 @@ const (
 	TaskInfoStateError   = TaskInfoState("error")
 )
 
+func (e TaskInfoState) String() string {
+	return string(e)
+}
+
+func ParseTaskInfoState(s string) (TaskInfoState, error) {
+	for _, e := range []TaskInfoState{
+		TaskInfoStateQueued,
+		TaskInfoStateRunning,
+		TaskInfoStateSuccess,
+		TaskInfoStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid TaskInfoState: %q", s)
+}
+
 func init() {
 	t["TaskInfoState"] = reflect.TypeOf((*TaskInfoState)(nil)).Elem()
 }
@@ -3579,6 +8492,22 @@ const (
 	ThirdPartyLicenseAssignmentFailedReasonModuleNotInstalled      = ThirdPartyLicenseAssignmentFailedReason("moduleNotInstalled")
 )
 
+func (e ThirdPartyLicenseAssignmentFailedReason) String() string {
+	return string(e)
+}
+
+func ParseThirdPartyLicenseAssignmentFailedReason(s string) (ThirdPartyLicenseAssignmentFailedReason, error) {
+	for _, e := range []ThirdPartyLicenseAssignmentFailedReason{
+		ThirdPartyLicenseAssignmentFailedReasonLicenseAssignmentFailed,
+		ThirdPartyLicenseAssignmentFailedReasonModuleNotInstalled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ThirdPartyLicenseAssignmentFailedReason: %q", s)
+}
+
 func init() {
 	t["ThirdPartyLicenseAssignmentFailedReason"] = reflect.TypeOf((*ThirdPartyLicenseAssignmentFailedReason)(nil)).Elem()
 }
@@ -3590,6 +8519,22 @@ const (
 	UpgradePolicyUpgradeAtPowerCycle = UpgradePolicy("upgradeAtPowerCycle")
 )
 
+func (e UpgradePolicy) String() string {
+	return string(e)
+}
+
+func ParseUpgradePolicy(s string) (UpgradePolicy, error) {
+	for _, e := range []UpgradePolicy{
+		UpgradePolicyManual,
+		UpgradePolicyUpgradeAtPowerCycle,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid UpgradePolicy: %q", s)
+}
+
 func init() {
 	t["UpgradePolicy"] = reflect.TypeOf((*UpgradePolicy)(nil)).Elem()
 }
@@ -3604,6 +8549,25 @@ const (
 	VAppAutoStartActionSuspend       = VAppAutoStartAction("suspend")
 )
 
+func (e VAppAutoStartAction) String() string {
+	return string(e)
+}
+
+func ParseVAppAutoStartAction(s string) (VAppAutoStartAction, error) {
+	for _, e := range []VAppAutoStartAction{
+		VAppAutoStartActionNone,
+		VAppAutoStartActionPowerOn,
+		VAppAutoStartActionPowerOff,
+		VAppAutoStartActionGuestShutdown,
+		VAppAutoStartActionSuspend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VAppAutoStartAction: %q", s)
+}
+
 func init() {
 	t["VAppAutoStartAction"] = reflect.TypeOf((*VAppAutoStartAction)(nil)).Elem()
 }
@@ -3616,6 +8580,23 @@ const (
 	VAppCloneSpecProvisioningTypeThick        = VAppCloneSpecProvisioningType("thick")
 )
 
+func (e VAppCloneSpecProvisioningType) String() string {
+	return string(e)
+}
+
+func ParseVAppCloneSpecProvisioningType(s string) (VAppCloneSpecProvisioningType, error) {
+	for _, e := range []VAppCloneSpecProvisioningType{
+		VAppCloneSpecProvisioningTypeSameAsSource,
+		VAppCloneSpecProvisioningTypeThin,
+		VAppCloneSpecProvisioningTypeThick,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VAppCloneSpecProvisioningType: %q", s)
+}
+
 func init() {
 	t["VAppCloneSpecProvisioningType"] = reflect.TypeOf((*VAppCloneSpecProvisioningType)(nil)).Elem()
 }
@@ -3627,6 +8608,22 @@ const (
 	VAppIPAssignmentInfoAllocationSchemesOvfenv = VAppIPAssignmentInfoAllocationSchemes("ovfenv")
 )
 
+func (e VAppIPAssignmentInfoAllocationSchemes) String() string {
+	return string(e)
+}
+
+func ParseVAppIPAssignmentInfoAllocationSchemes(s string) (VAppIPAssignmentInfoAllocationSchemes, error) {
+	for _, e := range []VAppIPAssignmentInfoAllocationSchemes{
+		VAppIPAssignmentInfoAllocationSchemesDhcp,
+		VAppIPAssignmentInfoAllocationSchemesOvfenv,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VAppIPAssignmentInfoAllocationSchemes: %q", s)
+}
+
 func init() {
 	t["VAppIPAssignmentInfoAllocationSchemes"] = reflect.TypeOf((*VAppIPAssignmentInfoAllocationSchemes)(nil)).Elem()
 }
@@ -3640,6 +8637,24 @@ const (
 	VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy = VAppIPAssignmentInfoIpAllocationPolicy("fixedAllocatedPolicy")
 )
 
+func (e VAppIPAssignmentInfoIpAllocationPolicy) String() string {
+	return string(e)
+}
+
+func ParseVAppIPAssignmentInfoIpAllocationPolicy(s string) (VAppIPAssignmentInfoIpAllocationPolicy, error) {
+	for _, e := range []VAppIPAssignmentInfoIpAllocationPolicy{
+		VAppIPAssignmentInfoIpAllocationPolicyDhcpPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyTransientPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyFixedPolicy,
+		VAppIPAssignmentInfoIpAllocationPolicyFixedAllocatedPolicy,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VAppIPAssignmentInfoIpAllocationPolicy: %q", s)
+}
+
 func init() {
 	t["VAppIPAssignmentInfoIpAllocationPolicy"] = reflect.TypeOf((*VAppIPAssignmentInfoIpAllocationPolicy)(nil)).Elem()
 }
@@ -3651,6 +8666,22 @@ const (
 	VAppIPAssignmentInfoProtocolsIPv6 = VAppIPAssignmentInfoProtocols("IPv6")
 )
 
+func (e VAppIPAssignmentInfoProtocols) String() string {
+	return string(e)
+}
+
+func ParseVAppIPAssignmentInfoProtocols(s string) (VAppIPAssignmentInfoProtocols, error) {
+	for _, e := range []VAppIPAssignmentInfoProtocols{
+		VAppIPAssignmentInfoProtocolsIPv4,
+		VAppIPAssignmentInfoProtocolsIPv6,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VAppIPAssignmentInfoProtocols: %q", s)
+}
+
 func init() {
 	t["VAppIPAssignmentInfoProtocols"] = reflect.TypeOf((*VAppIPAssignmentInfoProtocols)(nil)).Elem()
 }
@@ -3665,6 +8696,25 @@ const (
 	VFlashModuleNotSupportedReasonDiskSizeNotSupported             = VFlashModuleNotSupportedReason("DiskSizeNotSupported")
 )
 
+func (e VFlashModuleNotSupportedReason) String() string {
+	return string(e)
+}
+
+func ParseVFlashModuleNotSupportedReason(s string) (VFlashModuleNotSupportedReason, error) {
+	for _, e := range []VFlashModuleNotSupportedReason{
+		VFlashModuleNotSupportedReasonCacheModeNotSupported,
+		VFlashModuleNotSupportedReasonCacheConsistencyTypeNotSupported,
+		VFlashModuleNotSupportedReasonCacheBlockSizeNotSupported,
+		VFlashModuleNotSupportedReasonCacheReservationNotSupported,
+		VFlashModuleNotSupportedReasonDiskSizeNotSupported,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VFlashModuleNotSupportedReason: %q", s)
+}
+
 func init() {
 	t["VFlashModuleNotSupportedReason"] = reflect.TypeOf((*VFlashModuleNotSupportedReason)(nil)).Elem()
 }
@@ -3676,6 +8726,22 @@ const (
 	VMotionCompatibilityTypeSoftware = VMotionCompatibilityType("software")
 )
 
+func (e VMotionCompatibilityType) String() string {
+	return string(e)
+}
+
+func ParseVMotionCompatibilityType(s string) (VMotionCompatibilityType, error) {
+	for _, e := range []VMotionCompatibilityType{
+		VMotionCompatibilityTypeCpu,
+		VMotionCompatibilityTypeSoftware,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMotionCompatibilityType: %q", s)
+}
+
 func init() {
 	t["VMotionCompatibilityType"] = reflect.TypeOf((*VMotionCompatibilityType)(nil)).Elem()
 }
@@ -3689,6 +8755,24 @@ const (
 	VMwareDVSTeamingMatchStatusNonIphashMismatch = VMwareDVSTeamingMatchStatus("nonIphashMismatch")
 )
 
+func (e VMwareDVSTeamingMatchStatus) String() string {
+	return string(e)
+}
+
+func ParseVMwareDVSTeamingMatchStatus(s string) (VMwareDVSTeamingMatchStatus, error) {
+	for _, e := range []VMwareDVSTeamingMatchStatus{
+		VMwareDVSTeamingMatchStatusIphashMatch,
+		VMwareDVSTeamingMatchStatusNonIphashMatch,
+		VMwareDVSTeamingMatchStatusIphashMismatch,
+		VMwareDVSTeamingMatchStatusNonIphashMismatch,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDVSTeamingMatchStatus: %q", s)
+}
+
 func init() {
 	t["VMwareDVSTeamingMatchStatus"] = reflect.TypeOf((*VMwareDVSTeamingMatchStatus)(nil)).Elem()
 }
@@ -3701,6 +8785,23 @@ const (
 	VMwareDVSVspanSessionEncapTypeErspan3 = VMwareDVSVspanSessionEncapType("erspan3")
 )
 
+func (e VMwareDVSVspanSessionEncapType) String() string {
+	return string(e)
+}
+
+func ParseVMwareDVSVspanSessionEncapType(s string) (VMwareDVSVspanSessionEncapType, error) {
+	for _, e := range []VMwareDVSVspanSessionEncapType{
+		VMwareDVSVspanSessionEncapTypeGre,
+		VMwareDVSVspanSessionEncapTypeErspan2,
+		VMwareDVSVspanSessionEncapTypeErspan3,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDVSVspanSessionEncapType: %q", s)
+}
+
 func init() {
 	t["VMwareDVSVspanSessionEncapType"] = reflect.TypeOf((*VMwareDVSVspanSessionEncapType)(nil)).Elem()
 }
@@ -3715,6 +8816,25 @@ const (
 	VMwareDVSVspanSessionTypeEncapsulatedRemoteMirrorSource = VMwareDVSVspanSessionType("encapsulatedRemoteMirrorSource")
 )
 
+func (e VMwareDVSVspanSessionType) String() string {
+	return string(e)
+}
+
+func ParseVMwareDVSVspanSessionType(s string) (VMwareDVSVspanSessionType, error) {
+	for _, e := range []VMwareDVSVspanSessionType{
+		VMwareDVSVspanSessionTypeMixedDestMirror,
+		VMwareDVSVspanSessionTypeDvPortMirror,
+		VMwareDVSVspanSessionTypeRemoteMirrorSource,
+		VMwareDVSVspanSessionTypeRemoteMirrorDest,
+		VMwareDVSVspanSessionTypeEncapsulatedRemoteMirrorSource,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDVSVspanSessionType: %q", s)
+}
+
 func init() {
 	t["VMwareDVSVspanSessionType"] = reflect.TypeOf((*VMwareDVSVspanSessionType)(nil)).Elem()
 }
@@ -3726,6 +8846,22 @@ const (
 	VMwareDvsLacpApiVersionMultipleLag = VMwareDvsLacpApiVersion("multipleLag")
 )
 
+func (e VMwareDvsLacpApiVersion) String() string {
+	return string(e)
+}
+
+func ParseVMwareDvsLacpApiVersion(s string) (VMwareDvsLacpApiVersion, error) {
+	for _, e := range []VMwareDvsLacpApiVersion{
+		VMwareDvsLacpApiVersionSingleLag,
+		VMwareDvsLacpApiVersionMultipleLag,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDvsLacpApiVersion: %q", s)
+}
+
 func init() {
 	t["VMwareDvsLacpApiVersion"] = reflect.TypeOf((*VMwareDvsLacpApiVersion)(nil)).Elem()
 }
@@ -3755,6 +8891,40 @@ const (
 	VMwareDvsLacpLoadBalanceAlgorithmSrcPortId               = VMwareDvsLacpLoadBalanceAlgorithm("srcPortId")
 )
 
+func (e VMwareDvsLacpLoadBalanceAlgorithm) String() string {
+	return string(e)
+}
+
+func ParseVMwareDvsLacpLoadBalanceAlgorithm(s string) (VMwareDvsLacpLoadBalanceAlgorithm, error) {
+	for _, e := range []VMwareDvsLacpLoadBalanceAlgorithm{
+		VMwareDvsLacpLoadBalanceAlgorithmSrcMac,
+		VMwareDvsLacpLoadBalanceAlgorithmDestMac,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestMac,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmDestTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpTcpUdpPort,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIpTcpUdpPortVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmDestIp,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcIp,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcDestIp,
+		VMwareDvsLacpLoadBalanceAlgorithmVlan,
+		VMwareDvsLacpLoadBalanceAlgorithmSrcPortId,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDvsLacpLoadBalanceAlgorithm: %q", s)
+}
+
 func init() {
 	t["VMwareDvsLacpLoadBalanceAlgorithm"] = reflect.TypeOf((*VMwareDvsLacpLoadBalanceAlgorithm)(nil)).Elem()
 }
@@ -3766,6 +8936,22 @@ const (
 	VMwareDvsMulticastFilteringModeSnooping        = VMwareDvsMulticastFilteringMode("snooping")
 )
 
+func (e VMwareDvsMulticastFilteringMode) String() string {
+	return string(e)
+}
+
+func ParseVMwareDvsMulticastFilteringMode(s string) (VMwareDvsMulticastFilteringMode, error) {
+	for _, e := range []VMwareDvsMulticastFilteringMode{
+		VMwareDvsMulticastFilteringModeLegacyFiltering,
+		VMwareDvsMulticastFilteringModeSnooping,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareDvsMulticastFilteringMode: %q", s)
+}
+
 func init() {
 	t["VMwareDvsMulticastFilteringMode"] = reflect.TypeOf((*VMwareDvsMulticastFilteringMode)(nil)).Elem()
 }
@@ -3777,6 +8963,22 @@ const (
 	VMwareUplinkLacpModePassive = VMwareUplinkLacpMode("passive")
 )
 
+func (e VMwareUplinkLacpMode) String() string {
+	return string(e)
+}
+
+func ParseVMwareUplinkLacpMode(s string) (VMwareUplinkLacpMode, error) {
+	for _, e := range []VMwareUplinkLacpMode{
+		VMwareUplinkLacpModeActive,
+		VMwareUplinkLacpModePassive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareUplinkLacpMode: %q", s)
+}
+
 func init() {
 	t["VMwareUplinkLacpMode"] = reflect.TypeOf((*VMwareUplinkLacpMode)(nil)).Elem()
 }
@@ -3788,6 +8990,22 @@ const (
 	VMwareUplinkLacpTimeoutModeSlow = VMwareUplinkLacpTimeoutMode("slow")
 )
 
+func (e VMwareUplinkLacpTimeoutMode) String() string {
+	return string(e)
+}
+
+func ParseVMwareUplinkLacpTimeoutMode(s string) (VMwareUplinkLacpTimeoutMode, error) {
+	for _, e := range []VMwareUplinkLacpTimeoutMode{
+		VMwareUplinkLacpTimeoutModeFast,
+		VMwareUplinkLacpTimeoutModeSlow,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VMwareUplinkLacpTimeoutMode: %q", s)
+}
+
 func init() {
 	t["VMwareUplinkLacpTimeoutMode"] = reflect.TypeOf((*VMwareUplinkLacpTimeoutMode)(nil)).Elem()
 }
@@ -3798,6 +9016,21 @@ const (
 	VStorageObjectConsumptionTypeDisk = VStorageObjectConsumptionType("disk")
 )
 
+func (e VStorageObjectConsumptionType) String() string {
+	return string(e)
+}
+
+func ParseVStorageObjectConsumptionType(s string) (VStorageObjectConsumptionType, error) {
+	for _, e := range []VStorageObjectConsumptionType{
+		VStorageObjectConsumptionTypeDisk,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VStorageObjectConsumptionType: %q", s)
+}
+
 func init() {
 	t["VStorageObjectConsumptionType"] = reflect.TypeOf((*VStorageObjectConsumptionType)(nil)).Elem()
 }
@@ -3811,6 +9044,24 @@ const (
 	ValidateMigrationTestTypeResourceTests          = ValidateMigrationTestType("resourceTests")
 )
 
+func (e ValidateMigrationTestType) String() string {
+	return string(e)
+}
+
+func ParseValidateMigrationTestType(s string) (ValidateMigrationTestType, error) {
+	for _, e := range []ValidateMigrationTestType{
+		ValidateMigrationTestTypeSourceTests,
+		ValidateMigrationTestTypeCompatibilityTests,
+		ValidateMigrationTestTypeDiskAccessibilityTests,
+		ValidateMigrationTestTypeResourceTests,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid ValidateMigrationTestType: %q", s)
+}
+
 func init() {
 	t["ValidateMigrationTestType"] = reflect.TypeOf((*ValidateMigrationTestType)(nil)).Elem()
 }
@@ -3823,6 +9074,23 @@ const (
 	VchaClusterModeMaintenance = VchaClusterMode("maintenance")
 )
 
+func (e VchaClusterMode) String() string {
+	return string(e)
+}
+
+func ParseVchaClusterMode(s string) (VchaClusterMode, error) {
+	for _, e := range []VchaClusterMode{
+		VchaClusterModeEnabled,
+		VchaClusterModeDisabled,
+		VchaClusterModeMaintenance,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VchaClusterMode: %q", s)
+}
+
 func init() {
 	t["VchaClusterMode"] = reflect.TypeOf((*VchaClusterMode)(nil)).Elem()
 }
@@ -3835,6 +9103,23 @@ const (
 	VchaClusterStateIsolated = VchaClusterState("isolated")
 )
 
+func (e VchaClusterState) String() string {
+	return string(e)
+}
+
+func ParseVchaClusterState(s string) (VchaClusterState, error) {
+	for _, e := range []VchaClusterState{
+		VchaClusterStateHealthy,
+		VchaClusterStateDegraded,
+		VchaClusterStateIsolated,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VchaClusterState: %q", s)
+}
+
 func init() {
 	t["VchaClusterState"] = reflect.TypeOf((*VchaClusterState)(nil)).Elem()
 }
@@ -3847,6 +9132,23 @@ const (
 	VchaNodeRoleWitness = VchaNodeRole("witness")
 )
 
+func (e VchaNodeRole) String() string {
+	return string(e)
+}
+
+func ParseVchaNodeRole(s string) (VchaNodeRole, error) {
+	for _, e := range []VchaNodeRole{
+		VchaNodeRoleActive,
+		VchaNodeRolePassive,
+		VchaNodeRoleWitness,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VchaNodeRole: %q", s)
+}
+
 func init() {
 	t["VchaNodeRole"] = reflect.TypeOf((*VchaNodeRole)(nil)).Elem()
 }
@@ -3858,6 +9160,22 @@ const (
 	VchaNodeStateDown = VchaNodeState("down")
 )
 
+func (e VchaNodeState) String() string {
+	return string(e)
+}
+
+func ParseVchaNodeState(s string) (VchaNodeState, error) {
+	for _, e := range []VchaNodeState{
+		VchaNodeStateUp,
+		VchaNodeStateDown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VchaNodeState: %q", s)
+}
+
 func init() {
 	t["VchaNodeState"] = reflect.TypeOf((*VchaNodeState)(nil)).Elem()
 }
@@ -3871,6 +9189,24 @@ const (
 	VchaStatePrepared      = VchaState("prepared")
 )
 
+func (e VchaState) String() string {
+	return string(e)
+}
+
+func ParseVchaState(s string) (VchaState, error) {
+	for _, e := range []VchaState{
+		VchaStateConfigured,
+		VchaStateNotConfigured,
+		VchaStateInvalid,
+		VchaStatePrepared,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VchaState: %q", s)
+}
+
 func init() {
 	t["VchaState"] = reflect.TypeOf((*VchaState)(nil)).Elem()
 }
@@ -3884,6 +9220,24 @@ const (
 	VirtualAppVAppStateStopping = VirtualAppVAppState("stopping")
 )
 
+func (e VirtualAppVAppState) String() string {
+	return string(e)
+}
+
+func ParseVirtualAppVAppState(s string) (VirtualAppVAppState, error) {
+	for _, e := range []VirtualAppVAppState{
+		VirtualAppVAppStateStarted,
+		VirtualAppVAppStateStopped,
+		VirtualAppVAppStateStarting,
+		VirtualAppVAppStateStopping,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualAppVAppState: %q", s)
+}
+
 func init() {
 	t["VirtualAppVAppState"] = reflect.TypeOf((*VirtualAppVAppState)(nil)).Elem()
 }
@@ -3896,6 +9250,23 @@ const (
 	VirtualDeviceConfigSpecFileOperationReplace = VirtualDeviceConfigSpecFileOperation("replace")
 )
 
+func (e VirtualDeviceConfigSpecFileOperation) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceConfigSpecFileOperation(s string) (VirtualDeviceConfigSpecFileOperation, error) {
+	for _, e := range []VirtualDeviceConfigSpecFileOperation{
+		VirtualDeviceConfigSpecFileOperationCreate,
+		VirtualDeviceConfigSpecFileOperationDestroy,
+		VirtualDeviceConfigSpecFileOperationReplace,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceConfigSpecFileOperation: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceConfigSpecFileOperation"] = reflect.TypeOf((*VirtualDeviceConfigSpecFileOperation)(nil)).Elem()
 }
@@ -3908,6 +9279,23 @@ const (
 	VirtualDeviceConfigSpecOperationEdit   = VirtualDeviceConfigSpecOperation("edit")
 )
 
+func (e VirtualDeviceConfigSpecOperation) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceConfigSpecOperation(s string) (VirtualDeviceConfigSpecOperation, error) {
+	for _, e := range []VirtualDeviceConfigSpecOperation{
+		VirtualDeviceConfigSpecOperationAdd,
+		VirtualDeviceConfigSpecOperationRemove,
+		VirtualDeviceConfigSpecOperationEdit,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceConfigSpecOperation: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceConfigSpecOperation"] = reflect.TypeOf((*VirtualDeviceConfigSpecOperation)(nil)).Elem()
 }
@@ -3920,6 +9308,23 @@ const (
 	VirtualDeviceConnectInfoMigrateConnectOpUnset      = VirtualDeviceConnectInfoMigrateConnectOp("unset")
 )
 
+func (e VirtualDeviceConnectInfoMigrateConnectOp) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceConnectInfoMigrateConnectOp(s string) (VirtualDeviceConnectInfoMigrateConnectOp, error) {
+	for _, e := range []VirtualDeviceConnectInfoMigrateConnectOp{
+		VirtualDeviceConnectInfoMigrateConnectOpConnect,
+		VirtualDeviceConnectInfoMigrateConnectOpDisconnect,
+		VirtualDeviceConnectInfoMigrateConnectOpUnset,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceConnectInfoMigrateConnectOp: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceConnectInfoMigrateConnectOp"] = reflect.TypeOf((*VirtualDeviceConnectInfoMigrateConnectOp)(nil)).Elem()
 }
@@ -3933,6 +9338,24 @@ const (
 	VirtualDeviceConnectInfoStatusUntried            = VirtualDeviceConnectInfoStatus("untried")
 )
 
+func (e VirtualDeviceConnectInfoStatus) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceConnectInfoStatus(s string) (VirtualDeviceConnectInfoStatus, error) {
+	for _, e := range []VirtualDeviceConnectInfoStatus{
+		VirtualDeviceConnectInfoStatusOk,
+		VirtualDeviceConnectInfoStatusRecoverableError,
+		VirtualDeviceConnectInfoStatusUnrecoverableError,
+		VirtualDeviceConnectInfoStatusUntried,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceConnectInfoStatus: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceConnectInfoStatus"] = reflect.TypeOf((*VirtualDeviceConnectInfoStatus)(nil)).Elem()
 }
@@ -3947,6 +9370,25 @@ const (
 	VirtualDeviceFileExtensionRdm  = VirtualDeviceFileExtension("rdm")
 )
 
+func (e VirtualDeviceFileExtension) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceFileExtension(s string) (VirtualDeviceFileExtension, error) {
+	for _, e := range []VirtualDeviceFileExtension{
+		VirtualDeviceFileExtensionIso,
+		VirtualDeviceFileExtensionFlp,
+		VirtualDeviceFileExtensionVmdk,
+		VirtualDeviceFileExtensionDsk,
+		VirtualDeviceFileExtensionRdm,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceFileExtension: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceFileExtension"] = reflect.TypeOf((*VirtualDeviceFileExtension)(nil)).Elem()
 }
@@ -3958,6 +9400,22 @@ const (
 	VirtualDeviceURIBackingOptionDirectionClient = VirtualDeviceURIBackingOptionDirection("client")
 )
 
+func (e VirtualDeviceURIBackingOptionDirection) String() string {
+	return string(e)
+}
+
+func ParseVirtualDeviceURIBackingOptionDirection(s string) (VirtualDeviceURIBackingOptionDirection, error) {
+	for _, e := range []VirtualDeviceURIBackingOptionDirection{
+		VirtualDeviceURIBackingOptionDirectionServer,
+		VirtualDeviceURIBackingOptionDirectionClient,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDeviceURIBackingOptionDirection: %q", s)
+}
+
 func init() {
 	t["VirtualDeviceURIBackingOptionDirection"] = reflect.TypeOf((*VirtualDeviceURIBackingOptionDirection)(nil)).Elem()
 }
@@ -3970,6 +9428,23 @@ const (
 	VirtualDiskAdapterTypeLsiLogic = VirtualDiskAdapterType("lsiLogic")
 )
 
+func (e VirtualDiskAdapterType) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskAdapterType(s string) (VirtualDiskAdapterType, error) {
+	for _, e := range []VirtualDiskAdapterType{
+		VirtualDiskAdapterTypeIde,
+		VirtualDiskAdapterTypeBusLogic,
+		VirtualDiskAdapterTypeLsiLogic,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskAdapterType: %q", s)
+}
+
 func init() {
 	t["VirtualDiskAdapterType"] = reflect.TypeOf((*VirtualDiskAdapterType)(nil)).Elem()
 }
@@ -3981,6 +9456,22 @@ const (
 	VirtualDiskCompatibilityModePhysicalMode = VirtualDiskCompatibilityMode("physicalMode")
 )
 
+func (e VirtualDiskCompatibilityMode) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskCompatibilityMode(s string) (VirtualDiskCompatibilityMode, error) {
+	for _, e := range []VirtualDiskCompatibilityMode{
+		VirtualDiskCompatibilityModeVirtualMode,
+		VirtualDiskCompatibilityModePhysicalMode,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskCompatibilityMode: %q", s)
+}
+
 func init() {
 	t["VirtualDiskCompatibilityMode"] = reflect.TypeOf((*VirtualDiskCompatibilityMode)(nil)).Elem()
 }
@@ -3993,6 +9484,23 @@ const (
 	VirtualDiskDeltaDiskFormatSeSparseFormat = VirtualDiskDeltaDiskFormat("seSparseFormat")
 )
 
+func (e VirtualDiskDeltaDiskFormat) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskDeltaDiskFormat(s string) (VirtualDiskDeltaDiskFormat, error) {
+	for _, e := range []VirtualDiskDeltaDiskFormat{
+		VirtualDiskDeltaDiskFormatRedoLogFormat,
+		VirtualDiskDeltaDiskFormatNativeFormat,
+		VirtualDiskDeltaDiskFormatSeSparseFormat,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskDeltaDiskFormat: %q", s)
+}
+
 func init() {
 	t["VirtualDiskDeltaDiskFormat"] = reflect.TypeOf((*VirtualDiskDeltaDiskFormat)(nil)).Elem()
 }
@@ -4004,6 +9512,22 @@ const (
 	VirtualDiskDeltaDiskFormatVariantVsanSparseVariant = VirtualDiskDeltaDiskFormatVariant("vsanSparseVariant")
 )
 
+func (e VirtualDiskDeltaDiskFormatVariant) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskDeltaDiskFormatVariant(s string) (VirtualDiskDeltaDiskFormatVariant, error) {
+	for _, e := range []VirtualDiskDeltaDiskFormatVariant{
+		VirtualDiskDeltaDiskFormatVariantVmfsSparseVariant,
+		VirtualDiskDeltaDiskFormatVariantVsanSparseVariant,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskDeltaDiskFormatVariant: %q", s)
+}
+
 func init() {
 	t["VirtualDiskDeltaDiskFormatVariant"] = reflect.TypeOf((*VirtualDiskDeltaDiskFormatVariant)(nil)).Elem()
 }
@@ -4019,6 +9543,26 @@ const (
 	VirtualDiskModeAppend                    = VirtualDiskMode("append")
 )
 
+func (e VirtualDiskMode) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskMode(s string) (VirtualDiskMode, error) {
+	for _, e := range []VirtualDiskMode{
+		VirtualDiskModePersistent,
+		VirtualDiskModeNonpersistent,
+		VirtualDiskModeUndoable,
+		VirtualDiskModeIndependent_persistent,
+		VirtualDiskModeIndependent_nonpersistent,
+		VirtualDiskModeAppend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskMode: %q", s)
+}
+
 func init() {
 	t["VirtualDiskMode"] = reflect.TypeOf((*VirtualDiskMode)(nil)).Elem()
 }
@@ -4031,6 +9575,23 @@ const (
 	VirtualDiskRuleSpecRuleTypeDisabled     = VirtualDiskRuleSpecRuleType("disabled")
 )
 
+func (e VirtualDiskRuleSpecRuleType) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskRuleSpecRuleType(s string) (VirtualDiskRuleSpecRuleType, error) {
+	for _, e := range []VirtualDiskRuleSpecRuleType{
+		VirtualDiskRuleSpecRuleTypeAffinity,
+		VirtualDiskRuleSpecRuleTypeAntiAffinity,
+		VirtualDiskRuleSpecRuleTypeDisabled,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskRuleSpecRuleType: %q", s)
+}
+
 func init() {
 	t["VirtualDiskRuleSpecRuleType"] = reflect.TypeOf((*VirtualDiskRuleSpecRuleType)(nil)).Elem()
 }
@@ -4042,6 +9603,22 @@ const (
 	VirtualDiskSharingSharingMultiWriter = VirtualDiskSharing("sharingMultiWriter")
 )
 
+func (e VirtualDiskSharing) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskSharing(s string) (VirtualDiskSharing, error) {
+	for _, e := range []VirtualDiskSharing{
+		VirtualDiskSharingSharingNone,
+		VirtualDiskSharingSharingMultiWriter,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskSharing: %q", s)
+}
+
 func init() {
 	t["VirtualDiskSharing"] = reflect.TypeOf((*VirtualDiskSharing)(nil)).Elem()
 }
@@ -4064,6 +9641,33 @@ const (
 	VirtualDiskTypeThick            = VirtualDiskType("thick")
 )
 
+func (e VirtualDiskType) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskType(s string) (VirtualDiskType, error) {
+	for _, e := range []VirtualDiskType{
+		VirtualDiskTypePreallocated,
+		VirtualDiskTypeThin,
+		VirtualDiskTypeSeSparse,
+		VirtualDiskTypeRdm,
+		VirtualDiskTypeRdmp,
+		VirtualDiskTypeRaw,
+		VirtualDiskTypeDelta,
+		VirtualDiskTypeSparse2Gb,
+		VirtualDiskTypeThick2Gb,
+		VirtualDiskTypeEagerZeroedThick,
+		VirtualDiskTypeSparseMonolithic,
+		VirtualDiskTypeFlatMonolithic,
+		VirtualDiskTypeThick,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskType: %q", s)
+}
+
 func init() {
 	t["VirtualDiskType"] = reflect.TypeOf((*VirtualDiskType)(nil)).Elem()
 }
@@ -4075,6 +9679,22 @@ const (
 	VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeWeak   = VirtualDiskVFlashCacheConfigInfoCacheConsistencyType("weak")
 )
 
+func (e VirtualDiskVFlashCacheConfigInfoCacheConsistencyType) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskVFlashCacheConfigInfoCacheConsistencyType(s string) (VirtualDiskVFlashCacheConfigInfoCacheConsistencyType, error) {
+	for _, e := range []VirtualDiskVFlashCacheConfigInfoCacheConsistencyType{
+		VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeStrong,
+		VirtualDiskVFlashCacheConfigInfoCacheConsistencyTypeWeak,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskVFlashCacheConfigInfoCacheConsistencyType: %q", s)
+}
+
 func init() {
 	t["VirtualDiskVFlashCacheConfigInfoCacheConsistencyType"] = reflect.TypeOf((*VirtualDiskVFlashCacheConfigInfoCacheConsistencyType)(nil)).Elem()
 }
@@ -4086,6 +9706,22 @@ const (
 	VirtualDiskVFlashCacheConfigInfoCacheModeWrite_back = VirtualDiskVFlashCacheConfigInfoCacheMode("write_back")
 )
 
+func (e VirtualDiskVFlashCacheConfigInfoCacheMode) String() string {
+	return string(e)
+}
+
+func ParseVirtualDiskVFlashCacheConfigInfoCacheMode(s string) (VirtualDiskVFlashCacheConfigInfoCacheMode, error) {
+	for _, e := range []VirtualDiskVFlashCacheConfigInfoCacheMode{
+		VirtualDiskVFlashCacheConfigInfoCacheModeWrite_thru,
+		VirtualDiskVFlashCacheConfigInfoCacheModeWrite_back,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualDiskVFlashCacheConfigInfoCacheMode: %q", s)
+}
+
 func init() {
 	t["VirtualDiskVFlashCacheConfigInfoCacheMode"] = reflect.TypeOf((*VirtualDiskVFlashCacheConfigInfoCacheMode)(nil)).Elem()
 }
@@ -4098,6 +9734,23 @@ const (
 	VirtualEthernetCardLegacyNetworkDeviceNameHostonly = VirtualEthernetCardLegacyNetworkDeviceName("hostonly")
 )
 
+func (e VirtualEthernetCardLegacyNetworkDeviceName) String() string {
+	return string(e)
+}
+
+func ParseVirtualEthernetCardLegacyNetworkDeviceName(s string) (VirtualEthernetCardLegacyNetworkDeviceName, error) {
+	for _, e := range []VirtualEthernetCardLegacyNetworkDeviceName{
+		VirtualEthernetCardLegacyNetworkDeviceNameBridged,
+		VirtualEthernetCardLegacyNetworkDeviceNameNat,
+		VirtualEthernetCardLegacyNetworkDeviceNameHostonly,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualEthernetCardLegacyNetworkDeviceName: %q", s)
+}
+
 func init() {
 	t["VirtualEthernetCardLegacyNetworkDeviceName"] = reflect.TypeOf((*VirtualEthernetCardLegacyNetworkDeviceName)(nil)).Elem()
 }
@@ -4110,6 +9763,23 @@ const (
 	VirtualEthernetCardMacTypeAssigned  = VirtualEthernetCardMacType("assigned")
 )
 
+func (e VirtualEthernetCardMacType) String() string {
+	return string(e)
+}
+
+func ParseVirtualEthernetCardMacType(s string) (VirtualEthernetCardMacType, error) {
+	for _, e := range []VirtualEthernetCardMacType{
+		VirtualEthernetCardMacTypeManual,
+		VirtualEthernetCardMacTypeGenerated,
+		VirtualEthernetCardMacTypeAssigned,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualEthernetCardMacType: %q", s)
+}
+
 func init() {
 	t["VirtualEthernetCardMacType"] = reflect.TypeOf((*VirtualEthernetCardMacType)(nil)).Elem()
 }
@@ -4122,6 +9792,23 @@ const (
 	VirtualMachineAppHeartbeatStatusTypeAppStatusRed   = VirtualMachineAppHeartbeatStatusType("appStatusRed")
 )
 
+func (e VirtualMachineAppHeartbeatStatusType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineAppHeartbeatStatusType(s string) (VirtualMachineAppHeartbeatStatusType, error) {
+	for _, e := range []VirtualMachineAppHeartbeatStatusType{
+		VirtualMachineAppHeartbeatStatusTypeAppStatusGray,
+		VirtualMachineAppHeartbeatStatusTypeAppStatusGreen,
+		VirtualMachineAppHeartbeatStatusTypeAppStatusRed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineAppHeartbeatStatusType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineAppHeartbeatStatusType"] = reflect.TypeOf((*VirtualMachineAppHeartbeatStatusType)(nil)).Elem()
 }
@@ -4133,6 +9820,22 @@ const (
 	VirtualMachineBootOptionsNetworkBootProtocolTypeIpv6 = VirtualMachineBootOptionsNetworkBootProtocolType("ipv6")
 )
 
+func (e VirtualMachineBootOptionsNetworkBootProtocolType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineBootOptionsNetworkBootProtocolType(s string) (VirtualMachineBootOptionsNetworkBootProtocolType, error) {
+	for _, e := range []VirtualMachineBootOptionsNetworkBootProtocolType{
+		VirtualMachineBootOptionsNetworkBootProtocolTypeIpv4,
+		VirtualMachineBootOptionsNetworkBootProtocolTypeIpv6,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineBootOptionsNetworkBootProtocolType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineBootOptionsNetworkBootProtocolType"] = reflect.TypeOf((*VirtualMachineBootOptionsNetworkBootProtocolType)(nil)).Elem()
 }
@@ -4145,6 +9848,23 @@ const (
 	VirtualMachineConfigInfoNpivWwnTypeExternal = VirtualMachineConfigInfoNpivWwnType("external")
 )
 
+func (e VirtualMachineConfigInfoNpivWwnType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConfigInfoNpivWwnType(s string) (VirtualMachineConfigInfoNpivWwnType, error) {
+	for _, e := range []VirtualMachineConfigInfoNpivWwnType{
+		VirtualMachineConfigInfoNpivWwnTypeVc,
+		VirtualMachineConfigInfoNpivWwnTypeHost,
+		VirtualMachineConfigInfoNpivWwnTypeExternal,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConfigInfoNpivWwnType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConfigInfoNpivWwnType"] = reflect.TypeOf((*VirtualMachineConfigInfoNpivWwnType)(nil)).Elem()
 }
@@ -4157,6 +9877,23 @@ const (
 	VirtualMachineConfigInfoSwapPlacementTypeHostLocal   = VirtualMachineConfigInfoSwapPlacementType("hostLocal")
 )
 
+func (e VirtualMachineConfigInfoSwapPlacementType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConfigInfoSwapPlacementType(s string) (VirtualMachineConfigInfoSwapPlacementType, error) {
+	for _, e := range []VirtualMachineConfigInfoSwapPlacementType{
+		VirtualMachineConfigInfoSwapPlacementTypeInherit,
+		VirtualMachineConfigInfoSwapPlacementTypeVmDirectory,
+		VirtualMachineConfigInfoSwapPlacementTypeHostLocal,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConfigInfoSwapPlacementType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConfigInfoSwapPlacementType"] = reflect.TypeOf((*VirtualMachineConfigInfoSwapPlacementType)(nil)).Elem()
 }
@@ -4169,6 +9906,23 @@ const (
 	VirtualMachineConfigSpecEncryptedFtModesFtEncryptionRequired      = VirtualMachineConfigSpecEncryptedFtModes("ftEncryptionRequired")
 )
 
+func (e VirtualMachineConfigSpecEncryptedFtModes) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConfigSpecEncryptedFtModes(s string) (VirtualMachineConfigSpecEncryptedFtModes, error) {
+	for _, e := range []VirtualMachineConfigSpecEncryptedFtModes{
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionDisabled,
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionOpportunistic,
+		VirtualMachineConfigSpecEncryptedFtModesFtEncryptionRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConfigSpecEncryptedFtModes: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConfigSpecEncryptedFtModes"] = reflect.TypeOf((*VirtualMachineConfigSpecEncryptedFtModes)(nil)).Elem()
 }
@@ -4181,6 +9935,23 @@ const (
 	VirtualMachineConfigSpecEncryptedVMotionModesRequired      = VirtualMachineConfigSpecEncryptedVMotionModes("required")
 )
 
+func (e VirtualMachineConfigSpecEncryptedVMotionModes) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConfigSpecEncryptedVMotionModes(s string) (VirtualMachineConfigSpecEncryptedVMotionModes, error) {
+	for _, e := range []VirtualMachineConfigSpecEncryptedVMotionModes{
+		VirtualMachineConfigSpecEncryptedVMotionModesDisabled,
+		VirtualMachineConfigSpecEncryptedVMotionModesOpportunistic,
+		VirtualMachineConfigSpecEncryptedVMotionModesRequired,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConfigSpecEncryptedVMotionModes: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConfigSpecEncryptedVMotionModes"] = reflect.TypeOf((*VirtualMachineConfigSpecEncryptedVMotionModes)(nil)).Elem()
 }
@@ -4194,6 +9965,24 @@ const (
 	VirtualMachineConfigSpecNpivWwnOpExtend   = VirtualMachineConfigSpecNpivWwnOp("extend")
 )
 
+func (e VirtualMachineConfigSpecNpivWwnOp) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConfigSpecNpivWwnOp(s string) (VirtualMachineConfigSpecNpivWwnOp, error) {
+	for _, e := range []VirtualMachineConfigSpecNpivWwnOp{
+		VirtualMachineConfigSpecNpivWwnOpGenerate,
+		VirtualMachineConfigSpecNpivWwnOpSet,
+		VirtualMachineConfigSpecNpivWwnOpRemove,
+		VirtualMachineConfigSpecNpivWwnOpExtend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConfigSpecNpivWwnOp: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConfigSpecNpivWwnOp"] = reflect.TypeOf((*VirtualMachineConfigSpecNpivWwnOp)(nil)).Elem()
 }
@@ -4208,6 +9997,25 @@ const (
 	VirtualMachineConnectionStateInvalid      = VirtualMachineConnectionState("invalid")
 )
 
+func (e VirtualMachineConnectionState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineConnectionState(s string) (VirtualMachineConnectionState, error) {
+	for _, e := range []VirtualMachineConnectionState{
+		VirtualMachineConnectionStateConnected,
+		VirtualMachineConnectionStateDisconnected,
+		VirtualMachineConnectionStateOrphaned,
+		VirtualMachineConnectionStateInaccessible,
+		VirtualMachineConnectionStateInvalid,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineConnectionState: %q", s)
+}
+
 func init() {
 	t["VirtualMachineConnectionState"] = reflect.TypeOf((*VirtualMachineConnectionState)(nil)).Elem()
 }
@@ -4219,6 +10027,22 @@ const (
 	VirtualMachineCryptoStateLocked   = VirtualMachineCryptoState("locked")
 )
 
+func (e VirtualMachineCryptoState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineCryptoState(s string) (VirtualMachineCryptoState, error) {
+	for _, e := range []VirtualMachineCryptoState{
+		VirtualMachineCryptoStateUnlocked,
+		VirtualMachineCryptoStateLocked,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineCryptoState: %q", s)
+}
+
 func init() {
 	t["VirtualMachineCryptoState"] = reflect.TypeOf((*VirtualMachineCryptoState)(nil)).Elem()
 }
@@ -4230,6 +10054,22 @@ const (
 	VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleNetwork = VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther("vmNptIncompatibleNetwork")
 )
 
+func (e VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther(s string) (VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther, error) {
+	for _, e := range []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther{
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleHost,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOtherVmNptIncompatibleNetwork,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther: %q", s)
+}
+
 func init() {
 	t["VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther"] = reflect.TypeOf((*VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonOther)(nil)).Elem()
 }
@@ -4253,6 +10093,34 @@ const (
 	VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptVMCIActive                             = VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm("vmNptVMCIActive")
 )
 
+func (e VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm(s string) (VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm, error) {
+	for _, e := range []VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm{
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleGuest,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleGuestDriver,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleAdapterType,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptDisabledOrDisconnectedAdapter,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleAdapterFeatures,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptIncompatibleBackingType,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptInsufficientMemoryReservation,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptFaultToleranceOrRecordReplayConfigured,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptConflictingIOChainConfigured,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptMonitorBlocks,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptConflictingOperationInProgress,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptRuntimeError,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptOutOfIntrVector,
+		VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVmVmNptVMCIActive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm: %q", s)
+}
+
 func init() {
 	t["VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm"] = reflect.TypeOf((*VirtualMachineDeviceRuntimeInfoVirtualEthernetCardRuntimeStateVmDirectPathGen2InactiveReasonVm)(nil)).Elem()
 }
@@ -4268,6 +10136,26 @@ const (
 	VirtualMachineFaultToleranceStateRunning       = VirtualMachineFaultToleranceState("running")
 )
 
+func (e VirtualMachineFaultToleranceState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFaultToleranceState(s string) (VirtualMachineFaultToleranceState, error) {
+	for _, e := range []VirtualMachineFaultToleranceState{
+		VirtualMachineFaultToleranceStateNotConfigured,
+		VirtualMachineFaultToleranceStateDisabled,
+		VirtualMachineFaultToleranceStateEnabled,
+		VirtualMachineFaultToleranceStateNeedSecondary,
+		VirtualMachineFaultToleranceStateStarting,
+		VirtualMachineFaultToleranceStateRunning,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFaultToleranceState: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFaultToleranceState"] = reflect.TypeOf((*VirtualMachineFaultToleranceState)(nil)).Elem()
 }
@@ -4280,6 +10168,23 @@ const (
 	VirtualMachineFaultToleranceTypeCheckpointing = VirtualMachineFaultToleranceType("checkpointing")
 )
 
+func (e VirtualMachineFaultToleranceType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFaultToleranceType(s string) (VirtualMachineFaultToleranceType, error) {
+	for _, e := range []VirtualMachineFaultToleranceType{
+		VirtualMachineFaultToleranceTypeUnset,
+		VirtualMachineFaultToleranceTypeRecordReplay,
+		VirtualMachineFaultToleranceTypeCheckpointing,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFaultToleranceType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFaultToleranceType"] = reflect.TypeOf((*VirtualMachineFaultToleranceType)(nil)).Elem()
 }
@@ -4312,6 +10217,43 @@ const (
 	VirtualMachineFileLayoutExFileTypeGuestCustomization   = VirtualMachineFileLayoutExFileType("guestCustomization")
 )
 
+func (e VirtualMachineFileLayoutExFileType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFileLayoutExFileType(s string) (VirtualMachineFileLayoutExFileType, error) {
+	for _, e := range []VirtualMachineFileLayoutExFileType{
+		VirtualMachineFileLayoutExFileTypeConfig,
+		VirtualMachineFileLayoutExFileTypeExtendedConfig,
+		VirtualMachineFileLayoutExFileTypeDiskDescriptor,
+		VirtualMachineFileLayoutExFileTypeDiskExtent,
+		VirtualMachineFileLayoutExFileTypeDigestDescriptor,
+		VirtualMachineFileLayoutExFileTypeDigestExtent,
+		VirtualMachineFileLayoutExFileTypeDiskReplicationState,
+		VirtualMachineFileLayoutExFileTypeLog,
+		VirtualMachineFileLayoutExFileTypeStat,
+		VirtualMachineFileLayoutExFileTypeNamespaceData,
+		VirtualMachineFileLayoutExFileTypeNvram,
+		VirtualMachineFileLayoutExFileTypeSnapshotData,
+		VirtualMachineFileLayoutExFileTypeSnapshotMemory,
+		VirtualMachineFileLayoutExFileTypeSnapshotList,
+		VirtualMachineFileLayoutExFileTypeSnapshotManifestList,
+		VirtualMachineFileLayoutExFileTypeSuspend,
+		VirtualMachineFileLayoutExFileTypeSuspendMemory,
+		VirtualMachineFileLayoutExFileTypeSwap,
+		VirtualMachineFileLayoutExFileTypeUwswap,
+		VirtualMachineFileLayoutExFileTypeCore,
+		VirtualMachineFileLayoutExFileTypeScreenshot,
+		VirtualMachineFileLayoutExFileTypeFtMetadata,
+		VirtualMachineFileLayoutExFileTypeGuestCustomization,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFileLayoutExFileType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFileLayoutExFileType"] = reflect.TypeOf((*VirtualMachineFileLayoutExFileType)(nil)).Elem()
 }
@@ -4324,6 +10266,23 @@ const (
 	VirtualMachineFlagInfoMonitorTypeStats   = VirtualMachineFlagInfoMonitorType("stats")
 )
 
+func (e VirtualMachineFlagInfoMonitorType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFlagInfoMonitorType(s string) (VirtualMachineFlagInfoMonitorType, error) {
+	for _, e := range []VirtualMachineFlagInfoMonitorType{
+		VirtualMachineFlagInfoMonitorTypeRelease,
+		VirtualMachineFlagInfoMonitorTypeDebug,
+		VirtualMachineFlagInfoMonitorTypeStats,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFlagInfoMonitorType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFlagInfoMonitorType"] = reflect.TypeOf((*VirtualMachineFlagInfoMonitorType)(nil)).Elem()
 }
@@ -4336,6 +10295,23 @@ const (
 	VirtualMachineFlagInfoVirtualExecUsageHvOff  = VirtualMachineFlagInfoVirtualExecUsage("hvOff")
 )
 
+func (e VirtualMachineFlagInfoVirtualExecUsage) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFlagInfoVirtualExecUsage(s string) (VirtualMachineFlagInfoVirtualExecUsage, error) {
+	for _, e := range []VirtualMachineFlagInfoVirtualExecUsage{
+		VirtualMachineFlagInfoVirtualExecUsageHvAuto,
+		VirtualMachineFlagInfoVirtualExecUsageHvOn,
+		VirtualMachineFlagInfoVirtualExecUsageHvOff,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFlagInfoVirtualExecUsage: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFlagInfoVirtualExecUsage"] = reflect.TypeOf((*VirtualMachineFlagInfoVirtualExecUsage)(nil)).Elem()
 }
@@ -4348,6 +10324,23 @@ const (
 	VirtualMachineFlagInfoVirtualMmuUsageOff       = VirtualMachineFlagInfoVirtualMmuUsage("off")
 )
 
+func (e VirtualMachineFlagInfoVirtualMmuUsage) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineFlagInfoVirtualMmuUsage(s string) (VirtualMachineFlagInfoVirtualMmuUsage, error) {
+	for _, e := range []VirtualMachineFlagInfoVirtualMmuUsage{
+		VirtualMachineFlagInfoVirtualMmuUsageAutomatic,
+		VirtualMachineFlagInfoVirtualMmuUsageOn,
+		VirtualMachineFlagInfoVirtualMmuUsageOff,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineFlagInfoVirtualMmuUsage: %q", s)
+}
+
 func init() {
 	t["VirtualMachineFlagInfoVirtualMmuUsage"] = reflect.TypeOf((*VirtualMachineFlagInfoVirtualMmuUsage)(nil)).Elem()
 }
@@ -4360,6 +10353,23 @@ const (
 	VirtualMachineForkConfigInfoChildTypeNonpersistent = VirtualMachineForkConfigInfoChildType("nonpersistent")
 )
 
+func (e VirtualMachineForkConfigInfoChildType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineForkConfigInfoChildType(s string) (VirtualMachineForkConfigInfoChildType, error) {
+	for _, e := range []VirtualMachineForkConfigInfoChildType{
+		VirtualMachineForkConfigInfoChildTypeNone,
+		VirtualMachineForkConfigInfoChildTypePersistent,
+		VirtualMachineForkConfigInfoChildTypeNonpersistent,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineForkConfigInfoChildType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineForkConfigInfoChildType"] = reflect.TypeOf((*VirtualMachineForkConfigInfoChildType)(nil)).Elem()
 }
@@ -4375,6 +10385,26 @@ const (
 	VirtualMachineGuestOsFamilyOtherGuestFamily  = VirtualMachineGuestOsFamily("otherGuestFamily")
 )
 
+func (e VirtualMachineGuestOsFamily) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineGuestOsFamily(s string) (VirtualMachineGuestOsFamily, error) {
+	for _, e := range []VirtualMachineGuestOsFamily{
+		VirtualMachineGuestOsFamilyWindowsGuest,
+		VirtualMachineGuestOsFamilyLinuxGuest,
+		VirtualMachineGuestOsFamilyNetwareGuest,
+		VirtualMachineGuestOsFamilySolarisGuest,
+		VirtualMachineGuestOsFamilyDarwinGuestFamily,
+		VirtualMachineGuestOsFamilyOtherGuestFamily,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineGuestOsFamily: %q", s)
+}
+
 func init() {
 	t["VirtualMachineGuestOsFamily"] = reflect.TypeOf((*VirtualMachineGuestOsFamily)(nil)).Elem()
 }
@@ -4565,6 +10595,201 @@ const (
 	VirtualMachineGuestOsIdentifierOtherGuest64               = VirtualMachineGuestOsIdentifier("otherGuest64")
 )
 
+func (e VirtualMachineGuestOsIdentifier) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineGuestOsIdentifier(s string) (VirtualMachineGuestOsIdentifier, error) {
+	for _, e := range []VirtualMachineGuestOsIdentifier{
+		VirtualMachineGuestOsIdentifierDosGuest,
+		VirtualMachineGuestOsIdentifierWin31Guest,
+		VirtualMachineGuestOsIdentifierWin95Guest,
+		VirtualMachineGuestOsIdentifierWin98Guest,
+		VirtualMachineGuestOsIdentifierWinMeGuest,
+		VirtualMachineGuestOsIdentifierWinNTGuest,
+		VirtualMachineGuestOsIdentifierWin2000ProGuest,
+		VirtualMachineGuestOsIdentifierWin2000ServGuest,
+		VirtualMachineGuestOsIdentifierWin2000AdvServGuest,
+		VirtualMachineGuestOsIdentifierWinXPHomeGuest,
+		VirtualMachineGuestOsIdentifierWinXPProGuest,
+		VirtualMachineGuestOsIdentifierWinXPPro64Guest,
+		VirtualMachineGuestOsIdentifierWinNetWebGuest,
+		VirtualMachineGuestOsIdentifierWinNetStandardGuest,
+		VirtualMachineGuestOsIdentifierWinNetEnterpriseGuest,
+		VirtualMachineGuestOsIdentifierWinNetDatacenterGuest,
+		VirtualMachineGuestOsIdentifierWinNetBusinessGuest,
+		VirtualMachineGuestOsIdentifierWinNetStandard64Guest,
+		VirtualMachineGuestOsIdentifierWinNetEnterprise64Guest,
+		VirtualMachineGuestOsIdentifierWinLonghornGuest,
+		VirtualMachineGuestOsIdentifierWinLonghorn64Guest,
+		VirtualMachineGuestOsIdentifierWinNetDatacenter64Guest,
+		VirtualMachineGuestOsIdentifierWinVistaGuest,
+		VirtualMachineGuestOsIdentifierWinVista64Guest,
+		VirtualMachineGuestOsIdentifierWindows7Guest,
+		VirtualMachineGuestOsIdentifierWindows7_64Guest,
+		VirtualMachineGuestOsIdentifierWindows7Server64Guest,
+		VirtualMachineGuestOsIdentifierWindows8Guest,
+		VirtualMachineGuestOsIdentifierWindows8_64Guest,
+		VirtualMachineGuestOsIdentifierWindows8Server64Guest,
+		VirtualMachineGuestOsIdentifierWindows9Guest,
+		VirtualMachineGuestOsIdentifierWindows9_64Guest,
+		VirtualMachineGuestOsIdentifierWindows9Server64Guest,
+		VirtualMachineGuestOsIdentifierWindowsHyperVGuest,
+		VirtualMachineGuestOsIdentifierWindows2019srv_64Guest,
+		VirtualMachineGuestOsIdentifierWindows2019srvNext_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsdGuest,
+		VirtualMachineGuestOsIdentifierFreebsd64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd11Guest,
+		VirtualMachineGuestOsIdentifierFreebsd11_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd12Guest,
+		VirtualMachineGuestOsIdentifierFreebsd12_64Guest,
+		VirtualMachineGuestOsIdentifierFreebsd13Guest,
+		VirtualMachineGuestOsIdentifierFreebsd13_64Guest,
+		VirtualMachineGuestOsIdentifierRedhatGuest,
+		VirtualMachineGuestOsIdentifierRhel2Guest,
+		VirtualMachineGuestOsIdentifierRhel3Guest,
+		VirtualMachineGuestOsIdentifierRhel3_64Guest,
+		VirtualMachineGuestOsIdentifierRhel4Guest,
+		VirtualMachineGuestOsIdentifierRhel4_64Guest,
+		VirtualMachineGuestOsIdentifierRhel5Guest,
+		VirtualMachineGuestOsIdentifierRhel5_64Guest,
+		VirtualMachineGuestOsIdentifierRhel6Guest,
+		VirtualMachineGuestOsIdentifierRhel6_64Guest,
+		VirtualMachineGuestOsIdentifierRhel7Guest,
+		VirtualMachineGuestOsIdentifierRhel7_64Guest,
+		VirtualMachineGuestOsIdentifierRhel8_64Guest,
+		VirtualMachineGuestOsIdentifierRhel9_64Guest,
+		VirtualMachineGuestOsIdentifierCentosGuest,
+		VirtualMachineGuestOsIdentifierCentos64Guest,
+		VirtualMachineGuestOsIdentifierCentos6Guest,
+		VirtualMachineGuestOsIdentifierCentos6_64Guest,
+		VirtualMachineGuestOsIdentifierCentos7Guest,
+		VirtualMachineGuestOsIdentifierCentos7_64Guest,
+		VirtualMachineGuestOsIdentifierCentos8_64Guest,
+		VirtualMachineGuestOsIdentifierCentos9_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinuxGuest,
+		VirtualMachineGuestOsIdentifierOracleLinux64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux6Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux6_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux7Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux7_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux8_64Guest,
+		VirtualMachineGuestOsIdentifierOracleLinux9_64Guest,
+		VirtualMachineGuestOsIdentifierSuseGuest,
+		VirtualMachineGuestOsIdentifierSuse64Guest,
+		VirtualMachineGuestOsIdentifierSlesGuest,
+		VirtualMachineGuestOsIdentifierSles64Guest,
+		VirtualMachineGuestOsIdentifierSles10Guest,
+		VirtualMachineGuestOsIdentifierSles10_64Guest,
+		VirtualMachineGuestOsIdentifierSles11Guest,
+		VirtualMachineGuestOsIdentifierSles11_64Guest,
+		VirtualMachineGuestOsIdentifierSles12Guest,
+		VirtualMachineGuestOsIdentifierSles12_64Guest,
+		VirtualMachineGuestOsIdentifierSles15_64Guest,
+		VirtualMachineGuestOsIdentifierSles16_64Guest,
+		VirtualMachineGuestOsIdentifierNld9Guest,
+		VirtualMachineGuestOsIdentifierOesGuest,
+		VirtualMachineGuestOsIdentifierSjdsGuest,
+		VirtualMachineGuestOsIdentifierMandrakeGuest,
+		VirtualMachineGuestOsIdentifierMandrivaGuest,
+		VirtualMachineGuestOsIdentifierMandriva64Guest,
+		VirtualMachineGuestOsIdentifierTurboLinuxGuest,
+		VirtualMachineGuestOsIdentifierTurboLinux64Guest,
+		VirtualMachineGuestOsIdentifierUbuntuGuest,
+		VirtualMachineGuestOsIdentifierUbuntu64Guest,
+		VirtualMachineGuestOsIdentifierDebian4Guest,
+		VirtualMachineGuestOsIdentifierDebian4_64Guest,
+		VirtualMachineGuestOsIdentifierDebian5Guest,
+		VirtualMachineGuestOsIdentifierDebian5_64Guest,
+		VirtualMachineGuestOsIdentifierDebian6Guest,
+		VirtualMachineGuestOsIdentifierDebian6_64Guest,
+		VirtualMachineGuestOsIdentifierDebian7Guest,
+		VirtualMachineGuestOsIdentifierDebian7_64Guest,
+		VirtualMachineGuestOsIdentifierDebian8Guest,
+		VirtualMachineGuestOsIdentifierDebian8_64Guest,
+		VirtualMachineGuestOsIdentifierDebian9Guest,
+		VirtualMachineGuestOsIdentifierDebian9_64Guest,
+		VirtualMachineGuestOsIdentifierDebian10Guest,
+		VirtualMachineGuestOsIdentifierDebian10_64Guest,
+		VirtualMachineGuestOsIdentifierDebian11Guest,
+		VirtualMachineGuestOsIdentifierDebian11_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux3Guest,
+		VirtualMachineGuestOsIdentifierAsianux3_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux4Guest,
+		VirtualMachineGuestOsIdentifierAsianux4_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux5_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux7_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux8_64Guest,
+		VirtualMachineGuestOsIdentifierAsianux9_64Guest,
+		VirtualMachineGuestOsIdentifierOpensuseGuest,
+		VirtualMachineGuestOsIdentifierOpensuse64Guest,
+		VirtualMachineGuestOsIdentifierFedoraGuest,
+		VirtualMachineGuestOsIdentifierFedora64Guest,
+		VirtualMachineGuestOsIdentifierCoreos64Guest,
+		VirtualMachineGuestOsIdentifierVmwarePhoton64Guest,
+		VirtualMachineGuestOsIdentifierOther24xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther26xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOtherLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther3xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther4xLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther5xLinuxGuest,
+		VirtualMachineGuestOsIdentifierGenericLinuxGuest,
+		VirtualMachineGuestOsIdentifierOther24xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther26xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther3xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther4xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOther5xLinux64Guest,
+		VirtualMachineGuestOsIdentifierOtherLinux64Guest,
+		VirtualMachineGuestOsIdentifierSolaris6Guest,
+		VirtualMachineGuestOsIdentifierSolaris7Guest,
+		VirtualMachineGuestOsIdentifierSolaris8Guest,
+		VirtualMachineGuestOsIdentifierSolaris9Guest,
+		VirtualMachineGuestOsIdentifierSolaris10Guest,
+		VirtualMachineGuestOsIdentifierSolaris10_64Guest,
+		VirtualMachineGuestOsIdentifierSolaris11_64Guest,
+		VirtualMachineGuestOsIdentifierOs2Guest,
+		VirtualMachineGuestOsIdentifierEComStationGuest,
+		VirtualMachineGuestOsIdentifierEComStation2Guest,
+		VirtualMachineGuestOsIdentifierNetware4Guest,
+		VirtualMachineGuestOsIdentifierNetware5Guest,
+		VirtualMachineGuestOsIdentifierNetware6Guest,
+		VirtualMachineGuestOsIdentifierOpenServer5Guest,
+		VirtualMachineGuestOsIdentifierOpenServer6Guest,
+		VirtualMachineGuestOsIdentifierUnixWare7Guest,
+		VirtualMachineGuestOsIdentifierDarwinGuest,
+		VirtualMachineGuestOsIdentifierDarwin64Guest,
+		VirtualMachineGuestOsIdentifierDarwin10Guest,
+		VirtualMachineGuestOsIdentifierDarwin10_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin11Guest,
+		VirtualMachineGuestOsIdentifierDarwin11_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin12_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin13_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin14_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin15_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin16_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin17_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin18_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin19_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin20_64Guest,
+		VirtualMachineGuestOsIdentifierDarwin21_64Guest,
+		VirtualMachineGuestOsIdentifierVmkernelGuest,
+		VirtualMachineGuestOsIdentifierVmkernel5Guest,
+		VirtualMachineGuestOsIdentifierVmkernel6Guest,
+		VirtualMachineGuestOsIdentifierVmkernel65Guest,
+		VirtualMachineGuestOsIdentifierVmkernel7Guest,
+		VirtualMachineGuestOsIdentifierAmazonlinux2_64Guest,
+		VirtualMachineGuestOsIdentifierAmazonlinux3_64Guest,
+		VirtualMachineGuestOsIdentifierCrxPod1Guest,
+		VirtualMachineGuestOsIdentifierOtherGuest,
+		VirtualMachineGuestOsIdentifierOtherGuest64,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineGuestOsIdentifier: %q", s)
+}
+
 func init() {
 	t["VirtualMachineGuestOsIdentifier"] = reflect.TypeOf((*VirtualMachineGuestOsIdentifier)(nil)).Elem()
 }
@@ -4580,6 +10805,26 @@ const (
 	VirtualMachineGuestStateUnknown      = VirtualMachineGuestState("unknown")
 )
 
+func (e VirtualMachineGuestState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineGuestState(s string) (VirtualMachineGuestState, error) {
+	for _, e := range []VirtualMachineGuestState{
+		VirtualMachineGuestStateRunning,
+		VirtualMachineGuestStateShuttingDown,
+		VirtualMachineGuestStateResetting,
+		VirtualMachineGuestStateStandby,
+		VirtualMachineGuestStateNotRunning,
+		VirtualMachineGuestStateUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineGuestState: %q", s)
+}
+
 func init() {
 	t["VirtualMachineGuestState"] = reflect.TypeOf((*VirtualMachineGuestState)(nil)).Elem()
 }
@@ -4592,6 +10837,23 @@ const (
 	VirtualMachineHtSharingInternal = VirtualMachineHtSharing("internal")
 )
 
+func (e VirtualMachineHtSharing) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineHtSharing(s string) (VirtualMachineHtSharing, error) {
+	for _, e := range []VirtualMachineHtSharing{
+		VirtualMachineHtSharingAny,
+		VirtualMachineHtSharingNone,
+		VirtualMachineHtSharingInternal,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineHtSharing: %q", s)
+}
+
 func init() {
 	t["VirtualMachineHtSharing"] = reflect.TypeOf((*VirtualMachineHtSharing)(nil)).Elem()
 }
@@ -4604,6 +10866,23 @@ const (
 	VirtualMachineMemoryAllocationPolicySwapMost = VirtualMachineMemoryAllocationPolicy("swapMost")
 )
 
+func (e VirtualMachineMemoryAllocationPolicy) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineMemoryAllocationPolicy(s string) (VirtualMachineMemoryAllocationPolicy, error) {
+	for _, e := range []VirtualMachineMemoryAllocationPolicy{
+		VirtualMachineMemoryAllocationPolicySwapNone,
+		VirtualMachineMemoryAllocationPolicySwapSome,
+		VirtualMachineMemoryAllocationPolicySwapMost,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineMemoryAllocationPolicy: %q", s)
+}
+
 func init() {
 	t["VirtualMachineMemoryAllocationPolicy"] = reflect.TypeOf((*VirtualMachineMemoryAllocationPolicy)(nil)).Elem()
 }
@@ -4615,6 +10894,22 @@ const (
 	VirtualMachineMetadataManagerVmMetadataOpRemove = VirtualMachineMetadataManagerVmMetadataOp("Remove")
 )
 
+func (e VirtualMachineMetadataManagerVmMetadataOp) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineMetadataManagerVmMetadataOp(s string) (VirtualMachineMetadataManagerVmMetadataOp, error) {
+	for _, e := range []VirtualMachineMetadataManagerVmMetadataOp{
+		VirtualMachineMetadataManagerVmMetadataOpUpdate,
+		VirtualMachineMetadataManagerVmMetadataOpRemove,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineMetadataManagerVmMetadataOp: %q", s)
+}
+
 func init() {
 	t["VirtualMachineMetadataManagerVmMetadataOp"] = reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataOp)(nil)).Elem()
 }
@@ -4625,6 +10920,21 @@ const (
 	VirtualMachineMetadataManagerVmMetadataOwnerOwnerComVmwareVsphereHA = VirtualMachineMetadataManagerVmMetadataOwnerOwner("ComVmwareVsphereHA")
 )
 
+func (e VirtualMachineMetadataManagerVmMetadataOwnerOwner) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineMetadataManagerVmMetadataOwnerOwner(s string) (VirtualMachineMetadataManagerVmMetadataOwnerOwner, error) {
+	for _, e := range []VirtualMachineMetadataManagerVmMetadataOwnerOwner{
+		VirtualMachineMetadataManagerVmMetadataOwnerOwnerComVmwareVsphereHA,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineMetadataManagerVmMetadataOwnerOwner: %q", s)
+}
+
 func init() {
 	t["VirtualMachineMetadataManagerVmMetadataOwnerOwner"] = reflect.TypeOf((*VirtualMachineMetadataManagerVmMetadataOwnerOwner)(nil)).Elem()
 }
@@ -4637,6 +10947,23 @@ const (
 	VirtualMachineMovePriorityDefaultPriority = VirtualMachineMovePriority("defaultPriority")
 )
 
+func (e VirtualMachineMovePriority) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineMovePriority(s string) (VirtualMachineMovePriority, error) {
+	for _, e := range []VirtualMachineMovePriority{
+		VirtualMachineMovePriorityLowPriority,
+		VirtualMachineMovePriorityHighPriority,
+		VirtualMachineMovePriorityDefaultPriority,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineMovePriority: %q", s)
+}
+
 func init() {
 	t["VirtualMachineMovePriority"] = reflect.TypeOf((*VirtualMachineMovePriority)(nil)).Elem()
 }
@@ -4653,6 +10980,27 @@ const (
 	VirtualMachineNeedSecondaryReasonOther                  = VirtualMachineNeedSecondaryReason("other")
 )
 
+func (e VirtualMachineNeedSecondaryReason) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineNeedSecondaryReason(s string) (VirtualMachineNeedSecondaryReason, error) {
+	for _, e := range []VirtualMachineNeedSecondaryReason{
+		VirtualMachineNeedSecondaryReasonInitializing,
+		VirtualMachineNeedSecondaryReasonDivergence,
+		VirtualMachineNeedSecondaryReasonLostConnection,
+		VirtualMachineNeedSecondaryReasonPartialHardwareFailure,
+		VirtualMachineNeedSecondaryReasonUserAction,
+		VirtualMachineNeedSecondaryReasonCheckpointError,
+		VirtualMachineNeedSecondaryReasonOther,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineNeedSecondaryReason: %q", s)
+}
+
 func init() {
 	t["VirtualMachineNeedSecondaryReason"] = reflect.TypeOf((*VirtualMachineNeedSecondaryReason)(nil)).Elem()
 }
@@ -4666,6 +11014,24 @@ const (
 	VirtualMachinePowerOffBehaviorTake     = VirtualMachinePowerOffBehavior("take")
 )
 
+func (e VirtualMachinePowerOffBehavior) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachinePowerOffBehavior(s string) (VirtualMachinePowerOffBehavior, error) {
+	for _, e := range []VirtualMachinePowerOffBehavior{
+		VirtualMachinePowerOffBehaviorPowerOff,
+		VirtualMachinePowerOffBehaviorRevert,
+		VirtualMachinePowerOffBehaviorPrompt,
+		VirtualMachinePowerOffBehaviorTake,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachinePowerOffBehavior: %q", s)
+}
+
 func init() {
 	t["VirtualMachinePowerOffBehavior"] = reflect.TypeOf((*VirtualMachinePowerOffBehavior)(nil)).Elem()
 }
@@ -4678,6 +11044,23 @@ const (
 	VirtualMachinePowerOpTypePreset = VirtualMachinePowerOpType("preset")
 )
 
+func (e VirtualMachinePowerOpType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachinePowerOpType(s string) (VirtualMachinePowerOpType, error) {
+	for _, e := range []VirtualMachinePowerOpType{
+		VirtualMachinePowerOpTypeSoft,
+		VirtualMachinePowerOpTypeHard,
+		VirtualMachinePowerOpTypePreset,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachinePowerOpType: %q", s)
+}
+
 func init() {
 	t["VirtualMachinePowerOpType"] = reflect.TypeOf((*VirtualMachinePowerOpType)(nil)).Elem()
 }
@@ -4690,6 +11073,23 @@ const (
 	VirtualMachinePowerStateSuspended  = VirtualMachinePowerState("suspended")
 )
 
+func (e VirtualMachinePowerState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachinePowerState(s string) (VirtualMachinePowerState, error) {
+	for _, e := range []VirtualMachinePowerState{
+		VirtualMachinePowerStatePoweredOff,
+		VirtualMachinePowerStatePoweredOn,
+		VirtualMachinePowerStateSuspended,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachinePowerState: %q", s)
+}
+
 func init() {
 	t["VirtualMachinePowerState"] = reflect.TypeOf((*VirtualMachinePowerState)(nil)).Elem()
 }
@@ -4702,6 +11102,23 @@ const (
 	VirtualMachineRecordReplayStateInactive  = VirtualMachineRecordReplayState("inactive")
 )
 
+func (e VirtualMachineRecordReplayState) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineRecordReplayState(s string) (VirtualMachineRecordReplayState, error) {
+	for _, e := range []VirtualMachineRecordReplayState{
+		VirtualMachineRecordReplayStateRecording,
+		VirtualMachineRecordReplayStateReplaying,
+		VirtualMachineRecordReplayStateInactive,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineRecordReplayState: %q", s)
+}
+
 func init() {
 	t["VirtualMachineRecordReplayState"] = reflect.TypeOf((*VirtualMachineRecordReplayState)(nil)).Elem()
 }
@@ -4716,6 +11133,25 @@ const (
 	VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndConsolidate     = VirtualMachineRelocateDiskMoveOptions("moveAllDiskBackingsAndConsolidate")
 )
 
+func (e VirtualMachineRelocateDiskMoveOptions) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineRelocateDiskMoveOptions(s string) (VirtualMachineRelocateDiskMoveOptions, error) {
+	for _, e := range []VirtualMachineRelocateDiskMoveOptions{
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndAllowSharing,
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndDisallowSharing,
+		VirtualMachineRelocateDiskMoveOptionsMoveChildMostDiskBacking,
+		VirtualMachineRelocateDiskMoveOptionsCreateNewChildDiskBacking,
+		VirtualMachineRelocateDiskMoveOptionsMoveAllDiskBackingsAndConsolidate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineRelocateDiskMoveOptions: %q", s)
+}
+
 func init() {
 	t["VirtualMachineRelocateDiskMoveOptions"] = reflect.TypeOf((*VirtualMachineRelocateDiskMoveOptions)(nil)).Elem()
 }
@@ -4727,6 +11163,22 @@ const (
 	VirtualMachineRelocateTransformationSparse = VirtualMachineRelocateTransformation("sparse")
 )
 
+func (e VirtualMachineRelocateTransformation) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineRelocateTransformation(s string) (VirtualMachineRelocateTransformation, error) {
+	for _, e := range []VirtualMachineRelocateTransformation{
+		VirtualMachineRelocateTransformationFlat,
+		VirtualMachineRelocateTransformationSparse,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineRelocateTransformation: %q", s)
+}
+
 func init() {
 	t["VirtualMachineRelocateTransformation"] = reflect.TypeOf((*VirtualMachineRelocateTransformation)(nil)).Elem()
 }
@@ -4748,6 +11200,32 @@ const (
 	VirtualMachineScsiPassthroughTypeUnknown   = VirtualMachineScsiPassthroughType("unknown")
 )
 
+func (e VirtualMachineScsiPassthroughType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineScsiPassthroughType(s string) (VirtualMachineScsiPassthroughType, error) {
+	for _, e := range []VirtualMachineScsiPassthroughType{
+		VirtualMachineScsiPassthroughTypeDisk,
+		VirtualMachineScsiPassthroughTypeTape,
+		VirtualMachineScsiPassthroughTypePrinter,
+		VirtualMachineScsiPassthroughTypeProcessor,
+		VirtualMachineScsiPassthroughTypeWorm,
+		VirtualMachineScsiPassthroughTypeCdrom,
+		VirtualMachineScsiPassthroughTypeScanner,
+		VirtualMachineScsiPassthroughTypeOptical,
+		VirtualMachineScsiPassthroughTypeMedia,
+		VirtualMachineScsiPassthroughTypeCom,
+		VirtualMachineScsiPassthroughTypeRaid,
+		VirtualMachineScsiPassthroughTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineScsiPassthroughType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineScsiPassthroughType"] = reflect.TypeOf((*VirtualMachineScsiPassthroughType)(nil)).Elem()
 }
@@ -4759,6 +11237,22 @@ const (
 	VirtualMachineSgxInfoFlcModesUnlocked = VirtualMachineSgxInfoFlcModes("unlocked")
 )
 
+func (e VirtualMachineSgxInfoFlcModes) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineSgxInfoFlcModes(s string) (VirtualMachineSgxInfoFlcModes, error) {
+	for _, e := range []VirtualMachineSgxInfoFlcModes{
+		VirtualMachineSgxInfoFlcModesLocked,
+		VirtualMachineSgxInfoFlcModesUnlocked,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineSgxInfoFlcModes: %q", s)
+}
+
 func init() {
 	t["VirtualMachineSgxInfoFlcModes"] = reflect.TypeOf((*VirtualMachineSgxInfoFlcModes)(nil)).Elem()
 }
@@ -4770,6 +11264,22 @@ const (
 	VirtualMachineStandbyActionTypePowerOnSuspend = VirtualMachineStandbyActionType("powerOnSuspend")
 )
 
+func (e VirtualMachineStandbyActionType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineStandbyActionType(s string) (VirtualMachineStandbyActionType, error) {
+	for _, e := range []VirtualMachineStandbyActionType{
+		VirtualMachineStandbyActionTypeCheckpoint,
+		VirtualMachineStandbyActionTypePowerOnSuspend,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineStandbyActionType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineStandbyActionType"] = reflect.TypeOf((*VirtualMachineStandbyActionType)(nil)).Elem()
 }
@@ -4781,6 +11291,22 @@ const (
 	VirtualMachineTargetInfoConfigurationTagClusterWide = VirtualMachineTargetInfoConfigurationTag("clusterWide")
 )
 
+func (e VirtualMachineTargetInfoConfigurationTag) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineTargetInfoConfigurationTag(s string) (VirtualMachineTargetInfoConfigurationTag, error) {
+	for _, e := range []VirtualMachineTargetInfoConfigurationTag{
+		VirtualMachineTargetInfoConfigurationTagCompliant,
+		VirtualMachineTargetInfoConfigurationTagClusterWide,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineTargetInfoConfigurationTag: %q", s)
+}
+
 func init() {
 	t["VirtualMachineTargetInfoConfigurationTag"] = reflect.TypeOf((*VirtualMachineTargetInfoConfigurationTag)(nil)).Elem()
 }
@@ -4796,6 +11322,26 @@ const (
 	VirtualMachineTicketTypeWebRemoteDevice = VirtualMachineTicketType("webRemoteDevice")
 )
 
+func (e VirtualMachineTicketType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineTicketType(s string) (VirtualMachineTicketType, error) {
+	for _, e := range []VirtualMachineTicketType{
+		VirtualMachineTicketTypeMks,
+		VirtualMachineTicketTypeDevice,
+		VirtualMachineTicketTypeGuestControl,
+		VirtualMachineTicketTypeWebmks,
+		VirtualMachineTicketTypeGuestIntegrity,
+		VirtualMachineTicketTypeWebRemoteDevice,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineTicketType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineTicketType"] = reflect.TypeOf((*VirtualMachineTicketType)(nil)).Elem()
 }
@@ -4810,6 +11356,25 @@ const (
 	VirtualMachineToolsInstallTypeGuestToolsTypeOpenVMTools = VirtualMachineToolsInstallType("guestToolsTypeOpenVMTools")
 )
 
+func (e VirtualMachineToolsInstallType) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineToolsInstallType(s string) (VirtualMachineToolsInstallType, error) {
+	for _, e := range []VirtualMachineToolsInstallType{
+		VirtualMachineToolsInstallTypeGuestToolsTypeUnknown,
+		VirtualMachineToolsInstallTypeGuestToolsTypeMSI,
+		VirtualMachineToolsInstallTypeGuestToolsTypeTar,
+		VirtualMachineToolsInstallTypeGuestToolsTypeOSP,
+		VirtualMachineToolsInstallTypeGuestToolsTypeOpenVMTools,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineToolsInstallType: %q", s)
+}
+
 func init() {
 	t["VirtualMachineToolsInstallType"] = reflect.TypeOf((*VirtualMachineToolsInstallType)(nil)).Elem()
 }
@@ -4822,6 +11387,23 @@ const (
 	VirtualMachineToolsRunningStatusGuestToolsExecutingScripts = VirtualMachineToolsRunningStatus("guestToolsExecutingScripts")
 )
 
+func (e VirtualMachineToolsRunningStatus) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineToolsRunningStatus(s string) (VirtualMachineToolsRunningStatus, error) {
+	for _, e := range []VirtualMachineToolsRunningStatus{
+		VirtualMachineToolsRunningStatusGuestToolsNotRunning,
+		VirtualMachineToolsRunningStatusGuestToolsRunning,
+		VirtualMachineToolsRunningStatusGuestToolsExecutingScripts,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineToolsRunningStatus: %q", s)
+}
+
 func init() {
 	t["VirtualMachineToolsRunningStatus"] = reflect.TypeOf((*VirtualMachineToolsRunningStatus)(nil)).Elem()
 }
@@ -4835,6 +11417,24 @@ const (
 	VirtualMachineToolsStatusToolsOk           = VirtualMachineToolsStatus("toolsOk")
 )
 
+func (e VirtualMachineToolsStatus) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineToolsStatus(s string) (VirtualMachineToolsStatus, error) {
+	for _, e := range []VirtualMachineToolsStatus{
+		VirtualMachineToolsStatusToolsNotInstalled,
+		VirtualMachineToolsStatusToolsNotRunning,
+		VirtualMachineToolsStatusToolsOld,
+		VirtualMachineToolsStatusToolsOk,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineToolsStatus: %q", s)
+}
+
 func init() {
 	t["VirtualMachineToolsStatus"] = reflect.TypeOf((*VirtualMachineToolsStatus)(nil)).Elem()
 }
@@ -4853,6 +11453,29 @@ const (
 	VirtualMachineToolsVersionStatusGuestToolsBlacklisted  = VirtualMachineToolsVersionStatus("guestToolsBlacklisted")
 )
 
+func (e VirtualMachineToolsVersionStatus) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineToolsVersionStatus(s string) (VirtualMachineToolsVersionStatus, error) {
+	for _, e := range []VirtualMachineToolsVersionStatus{
+		VirtualMachineToolsVersionStatusGuestToolsNotInstalled,
+		VirtualMachineToolsVersionStatusGuestToolsNeedUpgrade,
+		VirtualMachineToolsVersionStatusGuestToolsCurrent,
+		VirtualMachineToolsVersionStatusGuestToolsUnmanaged,
+		VirtualMachineToolsVersionStatusGuestToolsTooOld,
+		VirtualMachineToolsVersionStatusGuestToolsSupportedOld,
+		VirtualMachineToolsVersionStatusGuestToolsSupportedNew,
+		VirtualMachineToolsVersionStatusGuestToolsTooNew,
+		VirtualMachineToolsVersionStatusGuestToolsBlacklisted,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineToolsVersionStatus: %q", s)
+}
+
 func init() {
 	t["VirtualMachineToolsVersionStatus"] = reflect.TypeOf((*VirtualMachineToolsVersionStatus)(nil)).Elem()
 }
@@ -4881,6 +11504,39 @@ const (
 	VirtualMachineUsbInfoFamilyUnknownFamily   = VirtualMachineUsbInfoFamily("unknownFamily")
 )
 
+func (e VirtualMachineUsbInfoFamily) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineUsbInfoFamily(s string) (VirtualMachineUsbInfoFamily, error) {
+	for _, e := range []VirtualMachineUsbInfoFamily{
+		VirtualMachineUsbInfoFamilyAudio,
+		VirtualMachineUsbInfoFamilyHid,
+		VirtualMachineUsbInfoFamilyHid_bootable,
+		VirtualMachineUsbInfoFamilyPhysical,
+		VirtualMachineUsbInfoFamilyCommunication,
+		VirtualMachineUsbInfoFamilyImaging,
+		VirtualMachineUsbInfoFamilyPrinter,
+		VirtualMachineUsbInfoFamilyStorage,
+		VirtualMachineUsbInfoFamilyHub,
+		VirtualMachineUsbInfoFamilySmart_card,
+		VirtualMachineUsbInfoFamilySecurity,
+		VirtualMachineUsbInfoFamilyVideo,
+		VirtualMachineUsbInfoFamilyWireless,
+		VirtualMachineUsbInfoFamilyBluetooth,
+		VirtualMachineUsbInfoFamilyWusb,
+		VirtualMachineUsbInfoFamilyPda,
+		VirtualMachineUsbInfoFamilyVendor_specific,
+		VirtualMachineUsbInfoFamilyOther,
+		VirtualMachineUsbInfoFamilyUnknownFamily,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineUsbInfoFamily: %q", s)
+}
+
 func init() {
 	t["VirtualMachineUsbInfoFamily"] = reflect.TypeOf((*VirtualMachineUsbInfoFamily)(nil)).Elem()
 }
@@ -4896,6 +11552,26 @@ const (
 	VirtualMachineUsbInfoSpeedUnknownSpeed   = VirtualMachineUsbInfoSpeed("unknownSpeed")
 )
 
+func (e VirtualMachineUsbInfoSpeed) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineUsbInfoSpeed(s string) (VirtualMachineUsbInfoSpeed, error) {
+	for _, e := range []VirtualMachineUsbInfoSpeed{
+		VirtualMachineUsbInfoSpeedLow,
+		VirtualMachineUsbInfoSpeedFull,
+		VirtualMachineUsbInfoSpeedHigh,
+		VirtualMachineUsbInfoSpeedSuperSpeed,
+		VirtualMachineUsbInfoSpeedSuperSpeedPlus,
+		VirtualMachineUsbInfoSpeedUnknownSpeed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineUsbInfoSpeed: %q", s)
+}
+
 func init() {
 	t["VirtualMachineUsbInfoSpeed"] = reflect.TypeOf((*VirtualMachineUsbInfoSpeed)(nil)).Elem()
 }
@@ -4907,6 +11583,22 @@ const (
 	VirtualMachineVMCIDeviceActionDeny  = VirtualMachineVMCIDeviceAction("deny")
 )
 
+func (e VirtualMachineVMCIDeviceAction) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineVMCIDeviceAction(s string) (VirtualMachineVMCIDeviceAction, error) {
+	for _, e := range []VirtualMachineVMCIDeviceAction{
+		VirtualMachineVMCIDeviceActionAllow,
+		VirtualMachineVMCIDeviceActionDeny,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineVMCIDeviceAction: %q", s)
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceAction"] = reflect.TypeOf((*VirtualMachineVMCIDeviceAction)(nil)).Elem()
 }
@@ -4919,6 +11611,23 @@ const (
 	VirtualMachineVMCIDeviceDirectionAnyDirection = VirtualMachineVMCIDeviceDirection("anyDirection")
 )
 
+func (e VirtualMachineVMCIDeviceDirection) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineVMCIDeviceDirection(s string) (VirtualMachineVMCIDeviceDirection, error) {
+	for _, e := range []VirtualMachineVMCIDeviceDirection{
+		VirtualMachineVMCIDeviceDirectionGuest,
+		VirtualMachineVMCIDeviceDirectionHost,
+		VirtualMachineVMCIDeviceDirectionAnyDirection,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineVMCIDeviceDirection: %q", s)
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceDirection"] = reflect.TypeOf((*VirtualMachineVMCIDeviceDirection)(nil)).Elem()
 }
@@ -4934,6 +11643,26 @@ const (
 	VirtualMachineVMCIDeviceProtocolAnyProtocol = VirtualMachineVMCIDeviceProtocol("anyProtocol")
 )
 
+func (e VirtualMachineVMCIDeviceProtocol) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineVMCIDeviceProtocol(s string) (VirtualMachineVMCIDeviceProtocol, error) {
+	for _, e := range []VirtualMachineVMCIDeviceProtocol{
+		VirtualMachineVMCIDeviceProtocolHypervisor,
+		VirtualMachineVMCIDeviceProtocolDoorbell,
+		VirtualMachineVMCIDeviceProtocolQueuepair,
+		VirtualMachineVMCIDeviceProtocolDatagram,
+		VirtualMachineVMCIDeviceProtocolStream,
+		VirtualMachineVMCIDeviceProtocolAnyProtocol,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineVMCIDeviceProtocol: %q", s)
+}
+
 func init() {
 	t["VirtualMachineVMCIDeviceProtocol"] = reflect.TypeOf((*VirtualMachineVMCIDeviceProtocol)(nil)).Elem()
 }
@@ -4946,6 +11675,23 @@ const (
 	VirtualMachineVideoCardUse3dRendererHardware  = VirtualMachineVideoCardUse3dRenderer("hardware")
 )
 
+func (e VirtualMachineVideoCardUse3dRenderer) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineVideoCardUse3dRenderer(s string) (VirtualMachineVideoCardUse3dRenderer, error) {
+	for _, e := range []VirtualMachineVideoCardUse3dRenderer{
+		VirtualMachineVideoCardUse3dRendererAutomatic,
+		VirtualMachineVideoCardUse3dRendererSoftware,
+		VirtualMachineVideoCardUse3dRendererHardware,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineVideoCardUse3dRenderer: %q", s)
+}
+
 func init() {
 	t["VirtualMachineVideoCardUse3dRenderer"] = reflect.TypeOf((*VirtualMachineVideoCardUse3dRenderer)(nil)).Elem()
 }
@@ -4958,6 +11704,23 @@ const (
 	VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_file_share_backup = VirtualMachineWindowsQuiesceSpecVssBackupContext("ctx_file_share_backup")
 )
 
+func (e VirtualMachineWindowsQuiesceSpecVssBackupContext) String() string {
+	return string(e)
+}
+
+func ParseVirtualMachineWindowsQuiesceSpecVssBackupContext(s string) (VirtualMachineWindowsQuiesceSpecVssBackupContext, error) {
+	for _, e := range []VirtualMachineWindowsQuiesceSpecVssBackupContext{
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_auto,
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_backup,
+		VirtualMachineWindowsQuiesceSpecVssBackupContextCtx_file_share_backup,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualMachineWindowsQuiesceSpecVssBackupContext: %q", s)
+}
+
 func init() {
 	t["VirtualMachineWindowsQuiesceSpecVssBackupContext"] = reflect.TypeOf((*VirtualMachineWindowsQuiesceSpecVssBackupContext)(nil)).Elem()
 }
@@ -4975,6 +11738,28 @@ const (
 	VirtualPointingDeviceHostChoicePs2                  = VirtualPointingDeviceHostChoice("ps2")
 )
 
+func (e VirtualPointingDeviceHostChoice) String() string {
+	return string(e)
+}
+
+func ParseVirtualPointingDeviceHostChoice(s string) (VirtualPointingDeviceHostChoice, error) {
+	for _, e := range []VirtualPointingDeviceHostChoice{
+		VirtualPointingDeviceHostChoiceAutodetect,
+		VirtualPointingDeviceHostChoiceIntellimouseExplorer,
+		VirtualPointingDeviceHostChoiceIntellimousePs2,
+		VirtualPointingDeviceHostChoiceLogitechMouseman,
+		VirtualPointingDeviceHostChoiceMicrosoft_serial,
+		VirtualPointingDeviceHostChoiceMouseSystems,
+		VirtualPointingDeviceHostChoiceMousemanSerial,
+		VirtualPointingDeviceHostChoicePs2,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualPointingDeviceHostChoice: %q", s)
+}
+
 func init() {
 	t["VirtualPointingDeviceHostChoice"] = reflect.TypeOf((*VirtualPointingDeviceHostChoice)(nil)).Elem()
 }
@@ -4987,6 +11772,23 @@ const (
 	VirtualSCSISharingPhysicalSharing = VirtualSCSISharing("physicalSharing")
 )
 
+func (e VirtualSCSISharing) String() string {
+	return string(e)
+}
+
+func ParseVirtualSCSISharing(s string) (VirtualSCSISharing, error) {
+	for _, e := range []VirtualSCSISharing{
+		VirtualSCSISharingNoSharing,
+		VirtualSCSISharingVirtualSharing,
+		VirtualSCSISharingPhysicalSharing,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualSCSISharing: %q", s)
+}
+
 func init() {
 	t["VirtualSCSISharing"] = reflect.TypeOf((*VirtualSCSISharing)(nil)).Elem()
 }
@@ -4998,6 +11800,22 @@ const (
 	VirtualSerialPortEndPointServer = VirtualSerialPortEndPoint("server")
 )
 
+func (e VirtualSerialPortEndPoint) String() string {
+	return string(e)
+}
+
+func ParseVirtualSerialPortEndPoint(s string) (VirtualSerialPortEndPoint, error) {
+	for _, e := range []VirtualSerialPortEndPoint{
+		VirtualSerialPortEndPointClient,
+		VirtualSerialPortEndPointServer,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualSerialPortEndPoint: %q", s)
+}
+
 func init() {
 	t["VirtualSerialPortEndPoint"] = reflect.TypeOf((*VirtualSerialPortEndPoint)(nil)).Elem()
 }
@@ -5009,6 +11827,22 @@ const (
 	VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev2 = VirtualVmxnet3VrdmaOptionDeviceProtocols("rocev2")
 )
 
+func (e VirtualVmxnet3VrdmaOptionDeviceProtocols) String() string {
+	return string(e)
+}
+
+func ParseVirtualVmxnet3VrdmaOptionDeviceProtocols(s string) (VirtualVmxnet3VrdmaOptionDeviceProtocols, error) {
+	for _, e := range []VirtualVmxnet3VrdmaOptionDeviceProtocols{
+		VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev1,
+		VirtualVmxnet3VrdmaOptionDeviceProtocolsRocev2,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VirtualVmxnet3VrdmaOptionDeviceProtocols: %q", s)
+}
+
 func init() {
 	t["VirtualVmxnet3VrdmaOptionDeviceProtocols"] = reflect.TypeOf((*VirtualVmxnet3VrdmaOptionDeviceProtocols)(nil)).Elem()
 }
@@ -5022,6 +11856,24 @@ const (
 	VmDasBeingResetEventReasonCodeVmcpResetApdCleared      = VmDasBeingResetEventReasonCode("vmcpResetApdCleared")
 )
 
+func (e VmDasBeingResetEventReasonCode) String() string {
+	return string(e)
+}
+
+func ParseVmDasBeingResetEventReasonCode(s string) (VmDasBeingResetEventReasonCode, error) {
+	for _, e := range []VmDasBeingResetEventReasonCode{
+		VmDasBeingResetEventReasonCodeVmtoolsHeartbeatFailure,
+		VmDasBeingResetEventReasonCodeAppHeartbeatFailure,
+		VmDasBeingResetEventReasonCodeAppImmediateResetRequest,
+		VmDasBeingResetEventReasonCodeVmcpResetApdCleared,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmDasBeingResetEventReasonCode: %q", s)
+}
+
 func init() {
 	t["VmDasBeingResetEventReasonCode"] = reflect.TypeOf((*VmDasBeingResetEventReasonCode)(nil)).Elem()
 }
@@ -5035,6 +11887,24 @@ const (
 	VmFailedStartingSecondaryEventFailureReasonMigrateFailed    = VmFailedStartingSecondaryEventFailureReason("migrateFailed")
 )
 
+func (e VmFailedStartingSecondaryEventFailureReason) String() string {
+	return string(e)
+}
+
+func ParseVmFailedStartingSecondaryEventFailureReason(s string) (VmFailedStartingSecondaryEventFailureReason, error) {
+	for _, e := range []VmFailedStartingSecondaryEventFailureReason{
+		VmFailedStartingSecondaryEventFailureReasonIncompatibleHost,
+		VmFailedStartingSecondaryEventFailureReasonLoginFailed,
+		VmFailedStartingSecondaryEventFailureReasonRegisterVmFailed,
+		VmFailedStartingSecondaryEventFailureReasonMigrateFailed,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmFailedStartingSecondaryEventFailureReason: %q", s)
+}
+
 func init() {
 	t["VmFailedStartingSecondaryEventFailureReason"] = reflect.TypeOf((*VmFailedStartingSecondaryEventFailureReason)(nil)).Elem()
 }
@@ -5075,6 +11945,51 @@ const (
 	VmFaultToleranceConfigIssueReasonForIssueUnsupportedPMemHAFailOver      = VmFaultToleranceConfigIssueReasonForIssue("unsupportedPMemHAFailOver")
 )
 
+func (e VmFaultToleranceConfigIssueReasonForIssue) String() string {
+	return string(e)
+}
+
+func ParseVmFaultToleranceConfigIssueReasonForIssue(s string) (VmFaultToleranceConfigIssueReasonForIssue, error) {
+	for _, e := range []VmFaultToleranceConfigIssueReasonForIssue{
+		VmFaultToleranceConfigIssueReasonForIssueHaNotEnabled,
+		VmFaultToleranceConfigIssueReasonForIssueMoreThanOneSecondary,
+		VmFaultToleranceConfigIssueReasonForIssueRecordReplayNotSupported,
+		VmFaultToleranceConfigIssueReasonForIssueReplayNotSupported,
+		VmFaultToleranceConfigIssueReasonForIssueTemplateVm,
+		VmFaultToleranceConfigIssueReasonForIssueMultipleVCPU,
+		VmFaultToleranceConfigIssueReasonForIssueHostInactive,
+		VmFaultToleranceConfigIssueReasonForIssueFtUnsupportedHardware,
+		VmFaultToleranceConfigIssueReasonForIssueFtUnsupportedProduct,
+		VmFaultToleranceConfigIssueReasonForIssueMissingVMotionNic,
+		VmFaultToleranceConfigIssueReasonForIssueMissingFTLoggingNic,
+		VmFaultToleranceConfigIssueReasonForIssueThinDisk,
+		VmFaultToleranceConfigIssueReasonForIssueVerifySSLCertificateFlagNotSet,
+		VmFaultToleranceConfigIssueReasonForIssueHasSnapshots,
+		VmFaultToleranceConfigIssueReasonForIssueNoConfig,
+		VmFaultToleranceConfigIssueReasonForIssueFtSecondaryVm,
+		VmFaultToleranceConfigIssueReasonForIssueHasLocalDisk,
+		VmFaultToleranceConfigIssueReasonForIssueEsxAgentVm,
+		VmFaultToleranceConfigIssueReasonForIssueVideo3dEnabled,
+		VmFaultToleranceConfigIssueReasonForIssueHasUnsupportedDisk,
+		VmFaultToleranceConfigIssueReasonForIssueInsufficientBandwidth,
+		VmFaultToleranceConfigIssueReasonForIssueHasNestedHVConfiguration,
+		VmFaultToleranceConfigIssueReasonForIssueHasVFlashConfiguration,
+		VmFaultToleranceConfigIssueReasonForIssueUnsupportedProduct,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHvUnsupported,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHwmmuUnsupported,
+		VmFaultToleranceConfigIssueReasonForIssueCpuHvDisabled,
+		VmFaultToleranceConfigIssueReasonForIssueHasEFIFirmware,
+		VmFaultToleranceConfigIssueReasonForIssueTooManyVCPUs,
+		VmFaultToleranceConfigIssueReasonForIssueTooMuchMemory,
+		VmFaultToleranceConfigIssueReasonForIssueUnsupportedPMemHAFailOver,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmFaultToleranceConfigIssueReasonForIssue: %q", s)
+}
+
 func init() {
 	t["VmFaultToleranceConfigIssueReasonForIssue"] = reflect.TypeOf((*VmFaultToleranceConfigIssueReasonForIssue)(nil)).Elem()
 }
@@ -5089,6 +12004,25 @@ const (
 	VmFaultToleranceInvalidFileBackingDeviceTypeVirtualDisk         = VmFaultToleranceInvalidFileBackingDeviceType("virtualDisk")
 )
 
+func (e VmFaultToleranceInvalidFileBackingDeviceType) String() string {
+	return string(e)
+}
+
+func ParseVmFaultToleranceInvalidFileBackingDeviceType(s string) (VmFaultToleranceInvalidFileBackingDeviceType, error) {
+	for _, e := range []VmFaultToleranceInvalidFileBackingDeviceType{
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualFloppy,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualCdrom,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualSerialPort,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualParallelPort,
+		VmFaultToleranceInvalidFileBackingDeviceTypeVirtualDisk,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmFaultToleranceInvalidFileBackingDeviceType: %q", s)
+}
+
 func init() {
 	t["VmFaultToleranceInvalidFileBackingDeviceType"] = reflect.TypeOf((*VmFaultToleranceInvalidFileBackingDeviceType)(nil)).Elem()
 }
@@ -5100,6 +12034,22 @@ const (
 	VmShutdownOnIsolationEventOperationPoweredOff = VmShutdownOnIsolationEventOperation("poweredOff")
 )
 
+func (e VmShutdownOnIsolationEventOperation) String() string {
+	return string(e)
+}
+
+func ParseVmShutdownOnIsolationEventOperation(s string) (VmShutdownOnIsolationEventOperation, error) {
+	for _, e := range []VmShutdownOnIsolationEventOperation{
+		VmShutdownOnIsolationEventOperationShutdown,
+		VmShutdownOnIsolationEventOperationPoweredOff,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmShutdownOnIsolationEventOperation: %q", s)
+}
+
 func init() {
 	t["VmShutdownOnIsolationEventOperation"] = reflect.TypeOf((*VmShutdownOnIsolationEventOperation)(nil)).Elem()
 }
@@ -5112,6 +12062,23 @@ const (
 	VmwareDistributedVirtualSwitchPvlanPortTypeCommunity   = VmwareDistributedVirtualSwitchPvlanPortType("community")
 )
 
+func (e VmwareDistributedVirtualSwitchPvlanPortType) String() string {
+	return string(e)
+}
+
+func ParseVmwareDistributedVirtualSwitchPvlanPortType(s string) (VmwareDistributedVirtualSwitchPvlanPortType, error) {
+	for _, e := range []VmwareDistributedVirtualSwitchPvlanPortType{
+		VmwareDistributedVirtualSwitchPvlanPortTypePromiscuous,
+		VmwareDistributedVirtualSwitchPvlanPortTypeIsolated,
+		VmwareDistributedVirtualSwitchPvlanPortTypeCommunity,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VmwareDistributedVirtualSwitchPvlanPortType: %q", s)
+}
+
 func init() {
 	t["VmwareDistributedVirtualSwitchPvlanPortType"] = reflect.TypeOf((*VmwareDistributedVirtualSwitchPvlanPortType)(nil)).Elem()
 }
@@ -5124,6 +12091,23 @@ const (
 	VsanDiskIssueTypeUnknown       = VsanDiskIssueType("unknown")
 )
 
+func (e VsanDiskIssueType) String() string {
+	return string(e)
+}
+
+func ParseVsanDiskIssueType(s string) (VsanDiskIssueType, error) {
+	for _, e := range []VsanDiskIssueType{
+		VsanDiskIssueTypeNonExist,
+		VsanDiskIssueTypeStampMismatch,
+		VsanDiskIssueTypeUnknown,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanDiskIssueType: %q", s)
+}
+
 func init() {
 	t["VsanDiskIssueType"] = reflect.TypeOf((*VsanDiskIssueType)(nil)).Elem()
 }
@@ -5136,6 +12120,23 @@ const (
 	VsanHostDecommissionModeObjectActionEvacuateAllData           = VsanHostDecommissionModeObjectAction("evacuateAllData")
 )
 
+func (e VsanHostDecommissionModeObjectAction) String() string {
+	return string(e)
+}
+
+func ParseVsanHostDecommissionModeObjectAction(s string) (VsanHostDecommissionModeObjectAction, error) {
+	for _, e := range []VsanHostDecommissionModeObjectAction{
+		VsanHostDecommissionModeObjectActionNoAction,
+		VsanHostDecommissionModeObjectActionEnsureObjectAccessibility,
+		VsanHostDecommissionModeObjectActionEvacuateAllData,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanHostDecommissionModeObjectAction: %q", s)
+}
+
 func init() {
 	t["VsanHostDecommissionModeObjectAction"] = reflect.TypeOf((*VsanHostDecommissionModeObjectAction)(nil)).Elem()
 }
@@ -5148,6 +12149,23 @@ const (
 	VsanHostDiskResultStateIneligible = VsanHostDiskResultState("ineligible")
 )
 
+func (e VsanHostDiskResultState) String() string {
+	return string(e)
+}
+
+func ParseVsanHostDiskResultState(s string) (VsanHostDiskResultState, error) {
+	for _, e := range []VsanHostDiskResultState{
+		VsanHostDiskResultStateInUse,
+		VsanHostDiskResultStateEligible,
+		VsanHostDiskResultStateIneligible,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanHostDiskResultState: %q", s)
+}
+
 func init() {
 	t["VsanHostDiskResultState"] = reflect.TypeOf((*VsanHostDiskResultState)(nil)).Elem()
 }
@@ -5160,6 +12178,23 @@ const (
 	VsanHostHealthStateUnhealthy = VsanHostHealthState("unhealthy")
 )
 
+func (e VsanHostHealthState) String() string {
+	return string(e)
+}
+
+func ParseVsanHostHealthState(s string) (VsanHostHealthState, error) {
+	for _, e := range []VsanHostHealthState{
+		VsanHostHealthStateUnknown,
+		VsanHostHealthStateHealthy,
+		VsanHostHealthStateUnhealthy,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanHostHealthState: %q", s)
+}
+
 func init() {
 	t["VsanHostHealthState"] = reflect.TypeOf((*VsanHostHealthState)(nil)).Elem()
 }
@@ -5179,6 +12214,30 @@ const (
 	VsanHostNodeStateDecommissioning         = VsanHostNodeState("decommissioning")
 )
 
+func (e VsanHostNodeState) String() string {
+	return string(e)
+}
+
+func ParseVsanHostNodeState(s string) (VsanHostNodeState, error) {
+	for _, e := range []VsanHostNodeState{
+		VsanHostNodeStateError,
+		VsanHostNodeStateDisabled,
+		VsanHostNodeStateAgent,
+		VsanHostNodeStateMaster,
+		VsanHostNodeStateBackup,
+		VsanHostNodeStateStarting,
+		VsanHostNodeStateStopping,
+		VsanHostNodeStateEnteringMaintenanceMode,
+		VsanHostNodeStateExitingMaintenanceMode,
+		VsanHostNodeStateDecommissioning,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanHostNodeState: %q", s)
+}
+
 func init() {
 	t["VsanHostNodeState"] = reflect.TypeOf((*VsanHostNodeState)(nil)).Elem()
 }
@@ -5190,6 +12249,22 @@ const (
 	VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeRemove = VsanUpgradeSystemUpgradeHistoryDiskGroupOpType("remove")
 )
 
+func (e VsanUpgradeSystemUpgradeHistoryDiskGroupOpType) String() string {
+	return string(e)
+}
+
+func ParseVsanUpgradeSystemUpgradeHistoryDiskGroupOpType(s string) (VsanUpgradeSystemUpgradeHistoryDiskGroupOpType, error) {
+	for _, e := range []VsanUpgradeSystemUpgradeHistoryDiskGroupOpType{
+		VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeAdd,
+		VsanUpgradeSystemUpgradeHistoryDiskGroupOpTypeRemove,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VsanUpgradeSystemUpgradeHistoryDiskGroupOpType: %q", s)
+}
+
 func init() {
 	t["VsanUpgradeSystemUpgradeHistoryDiskGroupOpType"] = reflect.TypeOf((*VsanUpgradeSystemUpgradeHistoryDiskGroupOpType)(nil)).Elem()
 }
@@ -5204,6 +12279,25 @@ const (
 	WeekOfMonthLast   = WeekOfMonth("last")
 )
 
+func (e WeekOfMonth) String() string {
+	return string(e)
+}
+
+func ParseWeekOfMonth(s string) (WeekOfMonth, error) {
+	for _, e := range []WeekOfMonth{
+		WeekOfMonthFirst,
+		WeekOfMonthSecond,
+		WeekOfMonthThird,
+		WeekOfMonthFourth,
+		WeekOfMonthLast,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid WeekOfMonth: %q", s)
+}
+
 func init() {
 	t["WeekOfMonth"] = reflect.TypeOf((*WeekOfMonth)(nil)).Elem()
 }
@@ -5215,6 +12309,22 @@ const (
 	WillLoseHAProtectionResolutionRelocate = WillLoseHAProtectionResolution("relocate")
 )
 
+func (e WillLoseHAProtectionResolution) String() string {
+	return string(e)
+}
+
+func ParseWillLoseHAProtectionResolution(s string) (WillLoseHAProtectionResolution, error) {
+	for _, e := range []WillLoseHAProtectionResolution{
+		WillLoseHAProtectionResolutionSvmotion,
+		WillLoseHAProtectionResolutionRelocate,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid WillLoseHAProtectionResolution: %q", s)
+}
+
 func init() {
 	t["WillLoseHAProtectionResolution"] = reflect.TypeOf((*WillLoseHAProtectionResolution)(nil)).Elem()
 }
@@ -5227,6 +12337,23 @@ const (
 	VslmVStorageObjectControlFlagEnableChangedBlockTracking = VslmVStorageObjectControlFlag("enableChangedBlockTracking")
 )
 
+func (e VslmVStorageObjectControlFlag) String() string {
+	return string(e)
+}
+
+func ParseVslmVStorageObjectControlFlag(s string) (VslmVStorageObjectControlFlag, error) {
+	for _, e := range []VslmVStorageObjectControlFlag{
+		VslmVStorageObjectControlFlagKeepAfterDeleteVm,
+		VslmVStorageObjectControlFlagDisableRelocation,
+		VslmVStorageObjectControlFlagEnableChangedBlockTracking,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmVStorageObjectControlFlag: %q", s)
+}
+
 func init() {
 	t["vslmVStorageObjectControlFlag"] = reflect.TypeOf((*VslmVStorageObjectControlFlag)(nil)).Elem()
 }
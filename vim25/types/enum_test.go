@@ -0,0 +1,55 @@
+/*
+Copyright (c) 2021 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package types
+
+import "testing"
+
+func TestParseEnum(t *testing.T) {
+	tests := []struct {
+		in     string
+		expect VirtualMachinePowerState
+	}{
+		{"poweredOn", VirtualMachinePowerStatePoweredOn},
+		{"POWEREDOFF", VirtualMachinePowerStatePoweredOff},
+		{"suspended", VirtualMachinePowerStateSuspended},
+	}
+
+	for _, test := range tests {
+		state, err := ParseVirtualMachinePowerState(test.in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state != test.expect {
+			t.Errorf("%s: expected %s, got %s", test.in, test.expect, state)
+		}
+		if state.String() != string(test.expect) {
+			t.Errorf("String()=%s", state.String())
+		}
+	}
+
+	if _, err := ParseVirtualMachinePowerState("running"); err == nil {
+		t.Error("expected error")
+	}
+
+	state, err := ParseHostSystemConnectionState(HostSystemConnectionStateNotResponding.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != HostSystemConnectionStateNotResponding {
+		t.Errorf("unexpected state: %s", state)
+	}
+}
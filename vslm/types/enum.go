@@ -17,7 +17,9 @@ limitations under the License.
 package types
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -29,6 +31,22 @@ const (
 	VslmEventTypePostFcdMigrateEvent = VslmEventType("postFcdMigrateEvent")
 )
 
+func (e VslmEventType) String() string {
+	return string(e)
+}
+
+func ParseVslmEventType(s string) (VslmEventType, error) {
+	for _, e := range []VslmEventType{
+		VslmEventTypePreFcdMigrateEvent,
+		VslmEventTypePostFcdMigrateEvent,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmEventType: %q", s)
+}
+
 func init() {
 	types.Add("vslm:VslmEventType", reflect.TypeOf((*VslmEventType)(nil)).Elem())
 }
@@ -40,6 +58,22 @@ const (
 	VslmEventVslmEventInfoStateError   = VslmEventVslmEventInfoState("error")
 )
 
+func (e VslmEventVslmEventInfoState) String() string {
+	return string(e)
+}
+
+func ParseVslmEventVslmEventInfoState(s string) (VslmEventVslmEventInfoState, error) {
+	for _, e := range []VslmEventVslmEventInfoState{
+		VslmEventVslmEventInfoStateSuccess,
+		VslmEventVslmEventInfoStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmEventVslmEventInfoState: %q", s)
+}
+
 func init() {
 	types.Add("vslm:VslmEventVslmEventInfoState", reflect.TypeOf((*VslmEventVslmEventInfoState)(nil)).Elem())
 }
@@ -53,6 +87,24 @@ const (
 	VslmTaskInfoStateError   = VslmTaskInfoState("error")
 )
 
+func (e VslmTaskInfoState) String() string {
+	return string(e)
+}
+
+func ParseVslmTaskInfoState(s string) (VslmTaskInfoState, error) {
+	for _, e := range []VslmTaskInfoState{
+		VslmTaskInfoStateQueued,
+		VslmTaskInfoStateRunning,
+		VslmTaskInfoStateSuccess,
+		VslmTaskInfoStateError,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmTaskInfoState: %q", s)
+}
+
 func init() {
 	types.Add("vslm:VslmTaskInfoState", reflect.TypeOf((*VslmTaskInfoState)(nil)).Elem())
 }
@@ -70,6 +122,28 @@ const (
 	VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue   = VslmVsoVStorageObjectQuerySpecQueryFieldEnum("metadataValue")
 )
 
+func (e VslmVsoVStorageObjectQuerySpecQueryFieldEnum) String() string {
+	return string(e)
+}
+
+func ParseVslmVsoVStorageObjectQuerySpecQueryFieldEnum(s string) (VslmVsoVStorageObjectQuerySpecQueryFieldEnum, error) {
+	for _, e := range []VslmVsoVStorageObjectQuerySpecQueryFieldEnum{
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumName,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumCapacity,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumCreateTime,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumBackingObjectId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumDatastoreMoId,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataKey,
+		VslmVsoVStorageObjectQuerySpecQueryFieldEnumMetadataValue,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmVsoVStorageObjectQuerySpecQueryFieldEnum: %q", s)
+}
+
 func init() {
 	types.Add("vslm:VslmVsoVStorageObjectQuerySpecQueryFieldEnum", reflect.TypeOf((*VslmVsoVStorageObjectQuerySpecQueryFieldEnum)(nil)).Elem())
 }
@@ -88,6 +162,29 @@ const (
 	VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith           = VslmVsoVStorageObjectQuerySpecQueryOperatorEnum("endsWith")
 )
 
+func (e VslmVsoVStorageObjectQuerySpecQueryOperatorEnum) String() string {
+	return string(e)
+}
+
+func ParseVslmVsoVStorageObjectQuerySpecQueryOperatorEnum(s string) (VslmVsoVStorageObjectQuerySpecQueryOperatorEnum, error) {
+	for _, e := range []VslmVsoVStorageObjectQuerySpecQueryOperatorEnum{
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEquals,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumNotEquals,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThan,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThan,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumLessThanOrEqual,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumGreaterThanOrEqual,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumContains,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumStartsWith,
+		VslmVsoVStorageObjectQuerySpecQueryOperatorEnumEndsWith,
+	} {
+		if strings.EqualFold(s, string(e)) {
+			return e, nil
+		}
+	}
+	return "", fmt.Errorf("invalid VslmVsoVStorageObjectQuerySpecQueryOperatorEnum: %q", s)
+}
+
 func init() {
 	types.Add("vslm:VslmVsoVStorageObjectQuerySpecQueryOperatorEnum", reflect.TypeOf((*VslmVsoVStorageObjectQuerySpecQueryOperatorEnum)(nil)).Elem())
 }